@@ -11,8 +11,10 @@ import (
 	"net/url"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/cli/cli/internal/ghinstance"
+	"github.com/cli/cli/internal/log"
 	"github.com/henvic/httpretty"
 	"github.com/shurcooL/graphql"
 )
@@ -53,6 +55,25 @@ func AddHeader(name, value string) ClientOption {
 	}
 }
 
+// AddHeadersFunc resolves a set of extra header name/value pairs per request,
+// adding each one unless the request already carries that header
+func AddHeadersFunc(getHeaders func(*http.Request) (map[string]string, error)) ClientOption {
+	return func(tr http.RoundTripper) http.RoundTripper {
+		return &funcTripper{roundTrip: func(req *http.Request) (*http.Response, error) {
+			headers, err := getHeaders(req)
+			if err != nil {
+				return nil, err
+			}
+			for name, value := range headers {
+				if value != "" && req.Header.Get(name) == "" {
+					req.Header.Add(name, value)
+				}
+			}
+			return tr.RoundTrip(req)
+		}}
+	}
+}
+
 // AddHeaderFunc is an AddHeader that gets the string value from a function
 func AddHeaderFunc(name string, getValue func(*http.Request) (string, error)) ClientOption {
 	return func(tr http.RoundTripper) http.RoundTripper {
@@ -92,6 +113,32 @@ func VerboseLog(out io.Writer, logTraffic bool, colorize bool) ClientOption {
 	return logger.RoundTripper
 }
 
+// LogRequests records request and response metadata with the structured logger
+func LogRequests(logger *log.Logger) ClientOption {
+	return func(tr http.RoundTripper) http.RoundTripper {
+		return &funcTripper{roundTrip: func(req *http.Request) (*http.Response, error) {
+			if !logger.Enabled(log.LevelDebug) {
+				return tr.RoundTrip(req)
+			}
+			start := time.Now()
+			resp, err := tr.RoundTrip(req)
+			fields := map[string]interface{}{
+				"method":   req.Method,
+				"url":      req.URL.String(),
+				"duration": time.Since(start).Round(time.Millisecond).String(),
+			}
+			if err != nil {
+				fields["error"] = err.Error()
+				logger.Error("http request failed", fields)
+			} else {
+				fields["status"] = resp.StatusCode
+				logger.Debug("http request", fields)
+			}
+			return resp, err
+		}}
+	}
+}
+
 // ReplaceTripper substitutes the underlying RoundTripper with a custom one
 func ReplaceTripper(tr http.RoundTripper) ClientOption {
 	return func(http.RoundTripper) http.RoundTripper {
@@ -99,6 +146,63 @@ func ReplaceTripper(tr http.RoundTripper) ClientOption {
 	}
 }
 
+// SkippedMutationError occurs when a mutating request was blocked in dry-run mode
+type SkippedMutationError struct {
+	Method string
+	URL    string
+}
+
+func (e SkippedMutationError) Error() string {
+	return fmt.Sprintf("skipped %s %s (dry-run mode)", e.Method, e.URL)
+}
+
+// DryRun blocks any request that would modify server state. The request that
+// would have been sent is printed to out instead.
+func DryRun(out io.Writer) ClientOption {
+	return func(tr http.RoundTripper) http.RoundTripper {
+		return &funcTripper{roundTrip: func(req *http.Request) (*http.Response, error) {
+			if !isMutatingRequest(req) {
+				return tr.RoundTrip(req)
+			}
+
+			fmt.Fprintf(out, "DRY-RUN: would send %s to %s\n", req.Method, req.URL)
+			if req.Body != nil {
+				body, err := ioutil.ReadAll(req.Body)
+				if err == nil && len(body) > 0 {
+					fmt.Fprintf(out, "%s\n", string(body))
+				}
+			}
+
+			return nil, SkippedMutationError{Method: req.Method, URL: req.URL.String()}
+		}}
+	}
+}
+
+func isMutatingRequest(req *http.Request) bool {
+	switch req.Method {
+	case "GET", "HEAD", "OPTIONS":
+		return false
+	}
+
+	// GraphQL requests are always POSTs; only treat actual mutations as mutating
+	if strings.HasSuffix(req.URL.Path, "/graphql") && req.Body != nil {
+		body, err := ioutil.ReadAll(req.Body)
+		if err != nil {
+			return true
+		}
+		req.Body = ioutil.NopCloser(bytes.NewBuffer(body))
+
+		parsed := struct {
+			Query string `json:"query"`
+		}{}
+		if err := json.Unmarshal(body, &parsed); err == nil {
+			return strings.HasPrefix(strings.TrimSpace(parsed.Query), "mutation")
+		}
+	}
+
+	return true
+}
+
 var issuedScopesWarning bool
 
 const (
@@ -315,12 +419,9 @@ func (c Client) REST(hostname string, method string, p string, body io.Reader, d
 		return nil
 	}
 
-	b, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return err
-	}
-
-	err = json.Unmarshal(b, &data)
+	// decode straight off the wire instead of buffering the whole body;
+	// large list responses never need to be held in memory twice
+	err = json.NewDecoder(resp.Body).Decode(&data)
 	if err != nil {
 		return err
 	}
@@ -335,13 +436,8 @@ func handleResponse(resp *http.Response, data interface{}) error {
 		return HandleHTTPError(resp)
 	}
 
-	body, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return err
-	}
-
 	gr := &graphQLResponse{Data: data}
-	err = json.Unmarshal(body, &gr)
+	err := json.NewDecoder(resp.Body).Decode(&gr)
 	if err != nil {
 		return err
 	}
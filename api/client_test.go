@@ -6,6 +6,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/cli/cli/pkg/httpmock"
@@ -105,6 +106,41 @@ func TestRESTError(t *testing.T) {
 	}
 }
 
+func TestDryRun(t *testing.T) {
+	fakehttp := &httpmock.Registry{}
+	out := &bytes.Buffer{}
+	client := NewClient(ReplaceTripper(fakehttp), DryRun(out))
+
+	fakehttp.StubResponse(200, bytes.NewBufferString(`{"data":{"viewer":{"login":"hubot"}}}`))
+
+	// queries pass through untouched
+	err := client.GraphQL("github.com", "query { viewer { login } }", nil, nil)
+	eq(t, err, nil)
+	eq(t, len(fakehttp.Requests), 1)
+
+	// mutations are blocked and printed instead
+	var skippedErr SkippedMutationError
+	err = client.GraphQL("github.com", "mutation M { closeIssue }", nil, nil)
+	if err == nil || !errors.As(err, &skippedErr) {
+		t.Fatalf("got %v", err)
+	}
+	eq(t, len(fakehttp.Requests), 1)
+	if !strings.Contains(out.String(), "DRY-RUN: would send POST to https://api.github.com/graphql") {
+		t.Errorf("got output %q", out.String())
+	}
+
+	out.Reset()
+	err = client.REST("github.com", "DELETE", "repos/OWNER/REPO", nil, nil)
+	if err == nil || !errors.As(err, &skippedErr) {
+		t.Fatalf("got %v", err)
+	}
+	eq(t, skippedErr.Method, "DELETE")
+	eq(t, len(fakehttp.Requests), 1)
+	if !strings.Contains(out.String(), "DRY-RUN: would send DELETE to https://api.github.com/repos/OWNER/REPO") {
+		t.Errorf("got output %q", out.String())
+	}
+}
+
 func Test_CheckScopes(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -0,0 +1,286 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/shurcooL/githubv4"
+)
+
+type Comment struct {
+	ID     string
+	Author struct {
+		Login string
+	}
+	AuthorAssociation   string
+	Body                string
+	CreatedAt           time.Time
+	IncludesCreatedEdit bool
+	ViewerDidAuthor     bool
+	ReactionGroups      []ReactionGroup
+
+	// ReviewState is set when the comment is the summary of a pull request review
+	ReviewState string
+}
+
+type ReactionGroup struct {
+	Content string
+	Users   struct {
+		TotalCount int
+	}
+}
+
+type CommentsAndTotalCount struct {
+	Comments   []Comment
+	TotalCount int
+}
+
+// CommentCreate adds a comment to an issue or pull request identified by its
+// GraphQL node ID
+func CommentCreate(client *Client, repo ghrepo.Interface, subjectID string, body string) error {
+	var mutation struct {
+		AddComment struct {
+			CommentEdge struct {
+				Node struct {
+					ID githubv4.ID
+				}
+			}
+		} `graphql:"addComment(input: $input)"`
+	}
+
+	variables := map[string]interface{}{
+		"input": githubv4.AddCommentInput{
+			SubjectID: githubv4.ID(subjectID),
+			Body:      githubv4.String(body),
+		},
+	}
+
+	gql := graphQLClient(client.http, repo.RepoHost())
+	return gql.MutateNamed(context.Background(), "CommentCreate", &mutation, variables)
+}
+
+// CommentReply adds a reply to an existing review comment thread on a pull
+// request, identified by the database ID of the comment being replied to
+func CommentReply(client *Client, repo ghrepo.Interface, prNumber int, commentID int, body string) error {
+	path := fmt.Sprintf("repos/%s/pulls/%d/comments/%d/replies", ghrepo.FullName(repo), prNumber, commentID)
+	payload, err := json.Marshal(map[string]interface{}{"body": body})
+	if err != nil {
+		return err
+	}
+	return client.REST(repo.RepoHost(), "POST", path, bytes.NewReader(payload), nil)
+}
+
+// CommentUpdate replaces the body of an existing comment identified by its
+// GraphQL node ID
+func CommentUpdate(client *Client, repo ghrepo.Interface, commentID string, body string) error {
+	var mutation struct {
+		UpdateIssueComment struct {
+			IssueComment struct {
+				ID githubv4.ID
+			}
+		} `graphql:"updateIssueComment(input: $input)"`
+	}
+
+	variables := map[string]interface{}{
+		"input": githubv4.UpdateIssueCommentInput{
+			ID:   githubv4.ID(commentID),
+			Body: githubv4.String(body),
+		},
+	}
+
+	gql := graphQLClient(client.http, repo.RepoHost())
+	return gql.MutateNamed(context.Background(), "CommentUpdate", &mutation, variables)
+}
+
+// IssueComments fetches all comments on an issue, ordered by creation time
+func IssueComments(client *Client, repo ghrepo.Interface, number int) (*CommentsAndTotalCount, error) {
+	type response struct {
+		Repository struct {
+			Issue struct {
+				Comments struct {
+					Nodes    []Comment
+					PageInfo struct {
+						HasNextPage bool
+						EndCursor   string
+					}
+					TotalCount int
+				}
+			}
+		}
+	}
+
+	query := `
+	query IssueComments($owner: String!, $repo: String!, $number: Int!, $endCursor: String) {
+		repository(owner: $owner, name: $repo) {
+			issue(number: $number) {
+				comments(first: 100, after: $endCursor) {
+					nodes {
+						id
+						author { login }
+						authorAssociation
+						body
+						createdAt
+						includesCreatedEdit
+						viewerDidAuthor
+						reactionGroups {
+							content
+							users { totalCount }
+						}
+					}
+					pageInfo {
+						hasNextPage
+						endCursor
+					}
+					totalCount
+				}
+			}
+		}
+	}`
+
+	variables := map[string]interface{}{
+		"owner":  repo.RepoOwner(),
+		"repo":   repo.RepoName(),
+		"number": number,
+	}
+
+	result := CommentsAndTotalCount{}
+	for {
+		var data response
+		err := client.GraphQL(repo.RepoHost(), query, variables, &data)
+		if err != nil {
+			return nil, err
+		}
+
+		comments := data.Repository.Issue.Comments
+		result.Comments = append(result.Comments, comments.Nodes...)
+		result.TotalCount = comments.TotalCount
+
+		if !comments.PageInfo.HasNextPage {
+			break
+		}
+		variables["endCursor"] = comments.PageInfo.EndCursor
+	}
+
+	sort.SliceStable(result.Comments, func(i, j int) bool {
+		return result.Comments[i].CreatedAt.Before(result.Comments[j].CreatedAt)
+	})
+
+	return &result, nil
+}
+
+// PullRequestComments fetches the issue comments and review summaries of a
+// pull request in chronological order
+func PullRequestComments(client *Client, repo ghrepo.Interface, number int) (*CommentsAndTotalCount, error) {
+	type commentsBlock struct {
+		Nodes    []Comment
+		PageInfo struct {
+			HasNextPage bool
+			EndCursor   string
+		}
+		TotalCount int
+	}
+	type response struct {
+		Repository struct {
+			PullRequest struct {
+				Comments commentsBlock
+				Reviews  struct {
+					Nodes []struct {
+						Author struct {
+							Login string
+						}
+						AuthorAssociation string
+						Body              string
+						SubmittedAt       time.Time
+						State             string
+					}
+				}
+			}
+		}
+	}
+
+	query := `
+	query PullRequestComments($owner: String!, $repo: String!, $number: Int!, $endCursor: String) {
+		repository(owner: $owner, name: $repo) {
+			pullRequest(number: $number) {
+				comments(first: 100, after: $endCursor) {
+					nodes {
+						id
+						author { login }
+						authorAssociation
+						body
+						createdAt
+						viewerDidAuthor
+					}
+					pageInfo {
+						hasNextPage
+						endCursor
+					}
+					totalCount
+				}
+				reviews(first: 100) {
+					nodes {
+						author { login }
+						authorAssociation
+						body
+						submittedAt
+						state
+					}
+				}
+			}
+		}
+	}`
+
+	variables := map[string]interface{}{
+		"owner":  repo.RepoOwner(),
+		"repo":   repo.RepoName(),
+		"number": number,
+	}
+
+	result := CommentsAndTotalCount{}
+	for {
+		var data response
+		err := client.GraphQL(repo.RepoHost(), query, variables, &data)
+		if err != nil {
+			return nil, err
+		}
+
+		prData := data.Repository.PullRequest
+		result.Comments = append(result.Comments, prData.Comments.Nodes...)
+		result.TotalCount = prData.Comments.TotalCount
+
+		if _, paged := variables["endCursor"]; !paged {
+			// review summaries only contribute on the first page; reviews with
+			// empty bodies are a tally entry, not a comment
+			for _, review := range prData.Reviews.Nodes {
+				if review.Body == "" {
+					continue
+				}
+				comment := Comment{
+					AuthorAssociation: review.AuthorAssociation,
+					Body:              review.Body,
+					CreatedAt:         review.SubmittedAt,
+					ReviewState:       review.State,
+				}
+				comment.Author.Login = review.Author.Login
+				result.Comments = append(result.Comments, comment)
+				result.TotalCount++
+			}
+		}
+
+		if !prData.Comments.PageInfo.HasNextPage {
+			break
+		}
+		variables["endCursor"] = prData.Comments.PageInfo.EndCursor
+	}
+
+	sort.SliceStable(result.Comments, func(i, j int) bool {
+		return result.Comments[i].CreatedAt.Before(result.Comments[j].CreatedAt)
+	})
+
+	return &result, nil
+}
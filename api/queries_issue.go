@@ -1,6 +1,7 @@
 package api
 
 import (
+	"bytes"
 	"context"
 	"encoding/base64"
 	"fmt"
@@ -25,16 +26,19 @@ type IssuesAndTotalCount struct {
 
 // Ref. https://developer.github.com/v4/object/issue/
 type Issue struct {
-	ID        string
-	Number    int
-	Title     string
-	URL       string
-	State     string
-	Closed    bool
-	Body      string
-	CreatedAt time.Time
-	UpdatedAt time.Time
-	Comments  struct {
+	ID               string
+	Number           int
+	Title            string
+	URL              string
+	State            string
+	StateReason      string
+	Closed           bool
+	Locked           bool
+	ActiveLockReason string
+	Body             string
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+	Comments         struct {
 		TotalCount int
 	}
 	Author struct {
@@ -74,17 +78,33 @@ type IssuesDisabledError struct {
 
 const fragments = `
 	fragment issue on Issue {
+		id
 		number
 		title
 		url
 		state
+		stateReason
+		body
+		createdAt
 		updatedAt
-		labels(first: 3) {
+		author {
+			login
+		}
+		labels(first: 100) {
 			nodes {
 				name
 			}
 			totalCount
 		}
+		assignees(first: 100) {
+			nodes {
+				login
+			}
+			totalCount
+		}
+		milestone {
+			title
+		}
 	}
 `
 
@@ -201,7 +221,24 @@ func IssueStatus(client *Client, repo ghrepo.Interface, currentUsername string)
 	return &payload, nil
 }
 
-func IssueList(client *Client, repo ghrepo.Interface, state string, labels []string, assigneeString string, limit int, authorString string, mentionString string, milestoneString string) (*IssuesAndTotalCount, error) {
+func IssueList(client *Client, repo ghrepo.Interface, state string, labels []string, assigneeString string, limit int, authorString string, mentionString string, milestoneString string, searchString string) (*IssuesAndTotalCount, error) {
+	res := IssuesAndTotalCount{}
+	err := IssueListPaged(client, repo, state, labels, assigneeString, limit, authorString, mentionString, milestoneString, searchString, func(page []Issue, totalCount int) error {
+		res.Issues = append(res.Issues, page...)
+		res.TotalCount = totalCount
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// IssueListPaged fetches issues one page at a time and invokes fn as each page
+// arrives, so callers can start rendering rows before the full result set has
+// been retrieved. fn receives the issues from one page along with the total
+// number of matching issues.
+func IssueListPaged(client *Client, repo ghrepo.Interface, state string, labels []string, assigneeString string, limit int, authorString string, mentionString string, milestoneString string, searchString string, fn func(page []Issue, totalCount int) error) error {
 	var states []string
 	switch state {
 	case "open", "":
@@ -211,7 +248,13 @@ func IssueList(client *Client, repo ghrepo.Interface, state string, labels []str
 	case "all":
 		states = []string{"OPEN", "CLOSED"}
 	default:
-		return nil, fmt.Errorf("invalid state: %s", state)
+		return fmt.Errorf("invalid state: %s", state)
+	}
+
+	// a search query can express filters the `Repository.issues` connection
+	// cannot, so its presence routes the whole listing through the search API
+	if searchString != "" {
+		return issueSearchPaged(client, repo, state, labels, assigneeString, limit, authorString, mentionString, milestoneString, searchString, fn)
 	}
 
 	query := fragments + `
@@ -255,18 +298,18 @@ func IssueList(client *Client, repo ghrepo.Interface, state string, labels []str
 		if milestoneNumber, err := strconv.Atoi(milestoneString); err == nil {
 			milestone, err = MilestoneByNumber(client, repo, milestoneNumber)
 			if err != nil {
-				return nil, err
+				return err
 			}
 		} else {
 			milestone, err = MilestoneByTitle(client, repo, milestoneString)
 			if err != nil {
-				return nil, err
+				return err
 			}
 		}
 
 		milestoneRESTID, err := milestoneNodeIdToDatabaseId(milestone.ID)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		variables["milestone"] = milestoneRESTID
 	}
@@ -285,39 +328,151 @@ func IssueList(client *Client, repo ghrepo.Interface, state string, labels []str
 		}
 	}
 
-	var issues []Issue
+	fetched := 0
 	pageLimit := min(limit, 100)
 
-loop:
 	for {
 		variables["limit"] = pageLimit
 		err := client.GraphQL(repo.RepoHost(), query, variables, &response)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		if !response.Repository.HasIssuesEnabled {
-			return nil, fmt.Errorf("the '%s' repository has disabled issues", ghrepo.FullName(repo))
+			return fmt.Errorf("the '%s' repository has disabled issues", ghrepo.FullName(repo))
 		}
 
-		for _, issue := range response.Repository.Issues.Nodes {
-			issues = append(issues, issue)
-			if len(issues) == limit {
-				break loop
-			}
+		page := response.Repository.Issues.Nodes
+		if fetched+len(page) > limit {
+			page = page[:limit-fetched]
+		}
+		fetched += len(page)
+		if err := fn(page, response.Repository.Issues.TotalCount); err != nil {
+			return err
 		}
 
-		if response.Repository.Issues.PageInfo.HasNextPage {
-			variables["endCursor"] = response.Repository.Issues.PageInfo.EndCursor
-			pageLimit = min(pageLimit, limit-len(issues))
-		} else {
+		if fetched == limit || !response.Repository.Issues.PageInfo.HasNextPage {
 			break
 		}
+		variables["endCursor"] = response.Repository.Issues.PageInfo.EndCursor
+		pageLimit = min(pageLimit, limit-fetched)
 	}
 
-	res := IssuesAndTotalCount{Issues: issues, TotalCount: response.Repository.Issues.TotalCount}
+	return nil
+}
+
+// IssueListForOwner lists issues across every repository of a user or
+// organization through the search API, applying the same filters as IssueList.
+func IssueListForOwner(client *Client, hostname string, owner string, state string, labels []string, assigneeString string, limit int, authorString string, mentionString string, milestoneString string, searchString string) (*IssuesAndTotalCount, error) {
+	res := IssuesAndTotalCount{}
+	scope := fmt.Sprintf("user:%s", owner)
+	err := issueSearchScopedPaged(client, hostname, scope, state, labels, assigneeString, limit, authorString, mentionString, milestoneString, searchString, func(page []Issue, totalCount int) error {
+		res.Issues = append(res.Issues, page...)
+		res.TotalCount = totalCount
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
 	return &res, nil
 }
 
+// issueSearchPaged lists issues through the search API, combining the list
+// filters with a free-form search query
+func issueSearchPaged(client *Client, repo ghrepo.Interface, state string, labels []string, assigneeString string, limit int, authorString string, mentionString string, milestoneString string, searchString string, fn func(page []Issue, totalCount int) error) error {
+	scope := fmt.Sprintf("repo:%s/%s", repo.RepoOwner(), repo.RepoName())
+	return issueSearchScopedPaged(client, repo.RepoHost(), scope, state, labels, assigneeString, limit, authorString, mentionString, milestoneString, searchString, fn)
+}
+
+// issueSearchScopedPaged runs an issue search limited to scope (a search
+// qualifier such as "repo:owner/name" or "user:owner") and invokes fn per page
+func issueSearchScopedPaged(client *Client, hostname string, scope string, state string, labels []string, assigneeString string, limit int, authorString string, mentionString string, milestoneString string, searchString string, fn func(page []Issue, totalCount int) error) error {
+	query := fragments + `
+	query IssueSearch($q: String!, $limit: Int, $endCursor: String) {
+		search(query: $q, type: ISSUE, first: $limit, after: $endCursor) {
+			issueCount
+			nodes {
+				...issue
+			}
+			pageInfo {
+				hasNextPage
+				endCursor
+			}
+		}
+	}
+	`
+
+	search := []string{
+		scope,
+		"is:issue",
+		"sort:created-desc",
+	}
+	switch state {
+	case "open", "":
+		search = append(search, "state:open")
+	case "closed":
+		search = append(search, "state:closed")
+	}
+	for _, label := range labels {
+		search = append(search, fmt.Sprintf(`label:"%s"`, label))
+	}
+	if assigneeString != "" {
+		search = append(search, fmt.Sprintf("assignee:%s", assigneeString))
+	}
+	if authorString != "" {
+		search = append(search, fmt.Sprintf("author:%s", authorString))
+	}
+	if mentionString != "" {
+		search = append(search, fmt.Sprintf("mentions:%s", mentionString))
+	}
+	if milestoneString != "" {
+		search = append(search, fmt.Sprintf(`milestone:"%s"`, milestoneString))
+	}
+	search = append(search, searchString)
+
+	variables := map[string]interface{}{
+		"q": strings.Join(search, " "),
+	}
+
+	var response struct {
+		Search struct {
+			IssueCount int
+			Nodes      []Issue
+			PageInfo   struct {
+				HasNextPage bool
+				EndCursor   string
+			}
+		}
+	}
+
+	fetched := 0
+	pageLimit := min(limit, 100)
+
+	for {
+		variables["limit"] = pageLimit
+		err := client.GraphQL(hostname, query, variables, &response)
+		if err != nil {
+			return err
+		}
+
+		page := response.Search.Nodes
+		if fetched+len(page) > limit {
+			page = page[:limit-fetched]
+		}
+		fetched += len(page)
+		if err := fn(page, response.Search.IssueCount); err != nil {
+			return err
+		}
+
+		if fetched == limit || !response.Search.PageInfo.HasNextPage {
+			break
+		}
+		variables["endCursor"] = response.Search.PageInfo.EndCursor
+		pageLimit = min(pageLimit, limit-fetched)
+	}
+
+	return nil
+}
+
 func IssueByNumber(client *Client, repo ghrepo.Interface, number int) (*Issue, error) {
 	type response struct {
 		Repository struct {
@@ -334,7 +489,10 @@ func IssueByNumber(client *Client, repo ghrepo.Interface, number int) (*Issue, e
 				id
 				title
 				state
+				stateReason
 				closed
+				locked
+				activeLockReason
 				body
 				author {
 					login
@@ -345,6 +503,7 @@ func IssueByNumber(client *Client, repo ghrepo.Interface, number int) (*Issue, e
 				number
 				url
 				createdAt
+				updatedAt
 				assignees(first: 100) {
 					nodes {
 						login
@@ -395,29 +554,85 @@ func IssueByNumber(client *Client, repo ghrepo.Interface, number int) (*Issue, e
 	return &resp.Repository.Issue, nil
 }
 
-func IssueClose(client *Client, repo ghrepo.Interface, issue Issue) error {
+// IssueTransfer moves an issue to another repository and returns the URL of
+// the transferred issue.
+func IssueTransfer(client *Client, repo ghrepo.Interface, issue *Issue, destRepoID string) (string, error) {
 	var mutation struct {
-		CloseIssue struct {
+		TransferIssue struct {
 			Issue struct {
-				ID githubv4.ID
+				URL string
 			}
-		} `graphql:"closeIssue(input: $input)"`
+		} `graphql:"transferIssue(input: $input)"`
 	}
 
 	variables := map[string]interface{}{
-		"input": githubv4.CloseIssueInput{
-			IssueID: issue.ID,
+		"input": githubv4.TransferIssueInput{
+			IssueID:      githubv4.ID(issue.ID),
+			RepositoryID: githubv4.ID(destRepoID),
 		},
 	}
 
 	gql := graphQLClient(client.http, repo.RepoHost())
-	err := gql.MutateNamed(context.Background(), "IssueClose", &mutation, variables)
-
+	err := gql.MutateNamed(context.Background(), "IssueTransfer", &mutation, variables)
 	if err != nil {
-		return err
+		return "", err
 	}
 
-	return nil
+	return mutation.TransferIssue.Issue.URL, nil
+}
+
+// IssueClose closes an issue. An empty stateReason closes the issue without
+// recording one; otherwise it is the API value, e.g. "COMPLETED" or
+// "NOT_PLANNED".
+func IssueClose(client *Client, repo ghrepo.Interface, issue Issue, stateReason string) error {
+	query := `
+	mutation IssueClose($input: CloseIssueInput!) {
+		closeIssue(input: $input) {
+			issue {
+				id
+			}
+		}
+	}`
+
+	inputParams := map[string]interface{}{
+		"issueId": issue.ID,
+	}
+	if stateReason != "" {
+		inputParams["stateReason"] = stateReason
+	}
+	variables := map[string]interface{}{
+		"input": inputParams,
+	}
+
+	result := struct {
+		CloseIssue struct {
+			Issue struct {
+				ID string
+			}
+		}
+	}{}
+
+	return client.GraphQL(repo.RepoHost(), query, variables, &result)
+}
+
+// IssueUpdate modifies the labels, assignees, or milestone of an issue.
+func IssueUpdate(client *Client, repo ghrepo.Interface, params githubv4.UpdateIssueInput) error {
+	var mutation struct {
+		UpdateIssue struct {
+			Issue struct {
+				ID githubv4.ID
+			}
+		} `graphql:"updateIssue(input: $input)"`
+	}
+
+	variables := map[string]interface{}{
+		"input": params,
+	}
+
+	gql := graphQLClient(client.http, repo.RepoHost())
+	err := gql.MutateNamed(context.Background(), "IssueUpdate", &mutation, variables)
+
+	return err
 }
 
 func IssueReopen(client *Client, repo ghrepo.Interface, issue Issue) error {
@@ -441,6 +656,149 @@ func IssueReopen(client *Client, repo ghrepo.Interface, issue Issue) error {
 	return err
 }
 
+// IssueLock locks the conversation on an issue. An empty reason locks the
+// issue without recording one.
+func IssueLock(client *Client, repo ghrepo.Interface, issue Issue, reason githubv4.LockReason) error {
+	var mutation struct {
+		LockLockable struct {
+			LockedRecord struct {
+				Locked githubv4.Boolean
+			}
+		} `graphql:"lockLockable(input: $input)"`
+	}
+
+	input := githubv4.LockLockableInput{
+		LockableID: issue.ID,
+	}
+	if reason != "" {
+		input.LockReason = &reason
+	}
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	gql := graphQLClient(client.http, repo.RepoHost())
+	err := gql.MutateNamed(context.Background(), "IssueLock", &mutation, variables)
+
+	return err
+}
+
+// IssueUnlock unlocks the conversation on an issue.
+func IssueUnlock(client *Client, repo ghrepo.Interface, issue Issue) error {
+	var mutation struct {
+		UnlockLockable struct {
+			UnlockedRecord struct {
+				Locked githubv4.Boolean
+			}
+		} `graphql:"unlockLockable(input: $input)"`
+	}
+
+	variables := map[string]interface{}{
+		"input": githubv4.UnlockLockableInput{
+			LockableID: issue.ID,
+		},
+	}
+
+	gql := graphQLClient(client.http, repo.RepoHost())
+	err := gql.MutateNamed(context.Background(), "IssueUnlock", &mutation, variables)
+
+	return err
+}
+
+// IssueSubscribe subscribes the current user to notifications for an issue
+// or pull request.
+func IssueSubscribe(client *Client, repo ghrepo.Interface, issue Issue) error {
+	path := fmt.Sprintf("repos/%s/issues/%d/subscription", ghrepo.FullName(repo), issue.Number)
+	body := bytes.NewBufferString(`{"subscribed":true}`)
+	return client.REST(repo.RepoHost(), "PUT", path, body, nil)
+}
+
+// IssueUnsubscribe mutes notifications for an issue or pull request.
+func IssueUnsubscribe(client *Client, repo ghrepo.Interface, issue Issue) error {
+	path := fmt.Sprintf("repos/%s/issues/%d/subscription", ghrepo.FullName(repo), issue.Number)
+	return client.REST(repo.RepoHost(), "DELETE", path, nil, nil)
+}
+
+// IssueDelete permanently deletes the issue. This action cannot be undone.
+func IssueDelete(client *Client, repo ghrepo.Interface, issue Issue) error {
+	var mutation struct {
+		DeleteIssue struct {
+			Repository struct {
+				ID githubv4.ID
+			}
+		} `graphql:"deleteIssue(input: $input)"`
+	}
+
+	variables := map[string]interface{}{
+		"input": githubv4.DeleteIssueInput{
+			IssueID: issue.ID,
+		},
+	}
+
+	gql := graphQLClient(client.http, repo.RepoHost())
+	err := gql.MutateNamed(context.Background(), "IssueDelete", &mutation, variables)
+
+	return err
+}
+
+// IssuePin pins the issue to its repository. A repository can have at most
+// three pinned issues.
+func IssuePin(client *Client, repo ghrepo.Interface, issue *Issue) error {
+	var response struct {
+		PinIssue struct {
+			Issue struct {
+				ID string
+			}
+		}
+	}
+
+	query := `
+	mutation IssuePin($input: PinIssueInput!) {
+		pinIssue(input: $input) {
+			issue {
+				id
+			}
+		}
+	}`
+
+	variables := map[string]interface{}{
+		"input": map[string]interface{}{
+			"issueId": issue.ID,
+		},
+	}
+
+	return client.GraphQL(repo.RepoHost(), query, variables, &response)
+}
+
+// IssueUnpin unpins the issue from its repository
+func IssueUnpin(client *Client, repo ghrepo.Interface, issue *Issue) error {
+	var response struct {
+		UnpinIssue struct {
+			Issue struct {
+				ID string
+			}
+		}
+	}
+
+	query := `
+	mutation IssueUnpin($input: UnpinIssueInput!) {
+		unpinIssue(input: $input) {
+			issue {
+				id
+			}
+		}
+	}`
+
+	variables := map[string]interface{}{
+		"input": map[string]interface{}{
+			"issueId": issue.ID,
+		},
+	}
+
+	return client.GraphQL(repo.RepoHost(), query, variables, &response)
+}
+
 // milestoneNodeIdToDatabaseId extracts the REST Database ID from the GraphQL Node ID
 // This conversion is necessary since the GraphQL API requires the use of the milestone's database ID
 // for querying the related issues.
@@ -86,6 +86,7 @@ type PullRequest struct {
 							CompletedAt time.Time
 							DetailsURL  string
 							TargetURL   string
+							IsRequired  bool `json:"isRequired"`
 						}
 					}
 				}
@@ -98,6 +99,7 @@ type PullRequest struct {
 				TypeName string `json:"__typename"`
 				Login    string
 				Name     string
+				Slug     string
 			}
 		}
 		TotalCount int
@@ -217,7 +219,9 @@ func (pr *PullRequest) ChecksStatus() (summary PullRequestChecksStatus) {
 	return
 }
 
-func (c Client) PullRequestDiff(baseRepo ghrepo.Interface, prNumber int) (io.ReadCloser, error) {
+// PullRequestDiff fetches the textual diff of a pull request. The format
+// argument selects between "diff" and "patch" media types.
+func (c Client) PullRequestDiff(baseRepo ghrepo.Interface, prNumber int, format string) (io.ReadCloser, error) {
 	url := fmt.Sprintf("%srepos/%s/pulls/%d",
 		ghinstance.RESTPrefix(baseRepo.RepoHost()), ghrepo.FullName(baseRepo), prNumber)
 	req, err := http.NewRequest("GET", url, nil)
@@ -225,7 +229,7 @@ func (c Client) PullRequestDiff(baseRepo ghrepo.Interface, prNumber int) (io.Rea
 		return nil, err
 	}
 
-	req.Header.Set("Accept", "application/vnd.github.v3.diff; charset=utf-8")
+	req.Header.Set("Accept", fmt.Sprintf("application/vnd.github.v3.%s; charset=utf-8", format))
 
 	resp, err := c.http.Do(req)
 	if err != nil {
@@ -438,6 +442,7 @@ func PullRequestByNumber(client *Client, repo ghrepo.Interface, number int) (*Pu
 						  			  context
 						  				state
 											targetUrl
+											isRequired(pullRequestNumber: $pr_number)
 						  			}
 						  			...on CheckRun {
 											name
@@ -446,6 +451,7 @@ func PullRequestByNumber(client *Client, repo ghrepo.Interface, number int) (*Pu
 											startedAt
 											completedAt
 											detailsUrl
+											isRequired(pullRequestNumber: $pr_number)
 						  			}
 						  		}
 						  	}
@@ -464,6 +470,7 @@ func PullRequestByNumber(client *Client, repo ghrepo.Interface, number int) (*Pu
 				isCrossRepository
 				isDraft
 				maintainerCanModify
+				reviewDecision
 				reviewRequests(first: 100) {
 					nodes {
 						requestedReviewer {
@@ -473,6 +480,7 @@ func PullRequestByNumber(client *Client, repo ghrepo.Interface, number int) (*Pu
 							}
 							...on Team {
 								name
+								slug
 							}
 						}
 					}
@@ -595,6 +603,7 @@ func PullRequestForBranch(client *Client, repo ghrepo.Interface, baseBranch, hea
 					isCrossRepository
 					isDraft
 					maintainerCanModify
+					reviewDecision
 					reviewRequests(first: 100) {
 						nodes {
 							requestedReviewer {
@@ -697,7 +706,7 @@ func CreatePullRequest(client *Client, repo *Repository, params map[string]inter
 	}
 	for key, val := range params {
 		switch key {
-		case "title", "body", "draft", "baseRefName", "headRefName":
+		case "title", "body", "draft", "baseRefName", "headRefName", "maintainerCanModify":
 			inputParams[key] = val
 		}
 	}
@@ -811,6 +820,23 @@ func AddReview(client *Client, repo ghrepo.Interface, pr *PullRequest, input *Pu
 }
 
 func PullRequestList(client *Client, repo ghrepo.Interface, vars map[string]interface{}, limit int) (*PullRequestAndTotalCount, error) {
+	res := PullRequestAndTotalCount{}
+	err := PullRequestListPaged(client, repo, vars, limit, func(page []PullRequest, totalCount int) error {
+		res.PullRequests = append(res.PullRequests, page...)
+		res.TotalCount = totalCount
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// PullRequestListPaged fetches pull requests one page at a time and invokes fn
+// as each page arrives, so callers can start rendering rows before the full
+// result set has been retrieved. fn receives the pull requests from one page
+// along with the total number of matching pull requests.
+func PullRequestListPaged(client *Client, repo ghrepo.Interface, vars map[string]interface{}, limit int, fn func(page []PullRequest, totalCount int) error) error {
 	type prBlock struct {
 		Edges []struct {
 			Node PullRequest
@@ -835,12 +861,43 @@ func PullRequestList(client *Client, repo ghrepo.Interface, vars map[string]inte
 		title
 		state
 		url
+		baseRefName
 		headRefName
 		headRepositoryOwner {
 			login
 		}
 		isCrossRepository
 		isDraft
+		author {
+			login
+		}
+		labels(first: 100) {
+			nodes {
+				name
+			}
+		}
+		reviewDecision
+		commits(last: 1) {
+			nodes {
+				commit {
+					statusCheckRollup {
+						contexts(last: 100) {
+							nodes {
+								...on StatusContext {
+									context
+									state
+								}
+								...on CheckRun {
+									name
+									status
+									conclusion
+								}
+							}
+						}
+					}
+				}
+			}
+		}
 	}
 	`
 
@@ -853,6 +910,7 @@ func PullRequestList(client *Client, repo ghrepo.Interface, vars map[string]inte
 		$limit: Int!,
 		$endCursor: String,
 		$baseBranch: String,
+		$headBranch: String,
 		$labels: [String!],
 		$state: [PullRequestState!] = OPEN
 	) {
@@ -860,6 +918,7 @@ func PullRequestList(client *Client, repo ghrepo.Interface, vars map[string]inte
         pullRequests(
 			states: $state,
 			baseRefName: $baseBranch,
+			headRefName: $headBranch,
 			labels: $labels,
 			first: $limit,
 			after: $endCursor,
@@ -880,14 +939,19 @@ func PullRequestList(client *Client, repo ghrepo.Interface, vars map[string]inte
 	}`
 
 	var check = make(map[int]struct{})
-	var prs []PullRequest
+	fetched := 0
 	pageLimit := min(limit, 100)
 	variables := map[string]interface{}{}
-	res := PullRequestAndTotalCount{}
 
-	// If assignee was specified, use the `search` API rather than
-	// `Repository.pullRequests`, but this mode doesn't support multiple labels
-	if assignee, ok := vars["assignee"].(string); ok {
+	// Filters that the `Repository.pullRequests` connection doesn't support are
+	// routed through the `search` API instead, but this mode doesn't support
+	// multiple labels
+	_, hasAssignee := vars["assignee"]
+	_, hasAuthor := vars["author"]
+	_, hasSearch := vars["search"]
+	_, hasDraft := vars["draft"]
+	_, hasMergedAt := vars["mergedAt"]
+	if hasAssignee || hasAuthor || hasSearch || hasDraft || hasMergedAt {
 		query = fragment + `
 		query PullRequestList(
 			$q: String!,
@@ -909,10 +973,18 @@ func PullRequestList(client *Client, repo ghrepo.Interface, vars map[string]inte
 		}`
 		search := []string{
 			fmt.Sprintf("repo:%s/%s", repo.RepoOwner(), repo.RepoName()),
-			fmt.Sprintf("assignee:%s", assignee),
 			"is:pr",
 			"sort:created-desc",
 		}
+		if assignee, ok := vars["assignee"].(string); ok {
+			search = append(search, fmt.Sprintf("assignee:%s", assignee))
+		}
+		if author, ok := vars["author"].(string); ok {
+			search = append(search, fmt.Sprintf("author:%s", author))
+		}
+		if isDraft, ok := vars["draft"].(bool); ok {
+			search = append(search, fmt.Sprintf("draft:%v", isDraft))
+		}
 		if states, ok := vars["state"].([]string); ok && len(states) == 1 {
 			switch states[0] {
 			case "OPEN":
@@ -923,15 +995,24 @@ func PullRequestList(client *Client, repo ghrepo.Interface, vars map[string]inte
 				search = append(search, "is:merged")
 			}
 		}
+		if mergedAt, ok := vars["mergedAt"].(string); ok {
+			search = append(search, fmt.Sprintf("merged:%s", mergedAt))
+		}
 		if labels, ok := vars["labels"].([]string); ok && len(labels) > 0 {
 			if len(labels) > 1 {
-				return nil, fmt.Errorf("multiple labels with --assignee are not supported")
+				return fmt.Errorf("multiple labels are not supported with this filter combination")
 			}
 			search = append(search, fmt.Sprintf(`label:"%s"`, labels[0]))
 		}
 		if baseBranch, ok := vars["baseBranch"].(string); ok {
 			search = append(search, fmt.Sprintf(`base:"%s"`, baseBranch))
 		}
+		if headBranch, ok := vars["headBranch"].(string); ok {
+			search = append(search, fmt.Sprintf(`head:"%s"`, headBranch))
+		}
+		if extra, ok := vars["search"].(string); ok {
+			search = append(search, extra)
+		}
 		variables["q"] = strings.Join(search, " ")
 	} else {
 		variables["owner"] = repo.RepoOwner()
@@ -940,42 +1021,45 @@ func PullRequestList(client *Client, repo ghrepo.Interface, vars map[string]inte
 			variables[name] = val
 		}
 	}
-loop:
 	for {
 		variables["limit"] = pageLimit
 		var data response
 		err := client.GraphQL(repo.RepoHost(), query, variables, &data)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		prData := data.Repository.PullRequests
-		res.TotalCount = prData.TotalCount
+		totalCount := prData.TotalCount
 		if _, ok := variables["q"]; ok {
 			prData = data.Search
-			res.TotalCount = prData.IssueCount
+			totalCount = prData.IssueCount
 		}
 
+		var page []PullRequest
 		for _, edge := range prData.Edges {
 			if _, exists := check[edge.Node.Number]; exists {
 				continue
 			}
 
-			prs = append(prs, edge.Node)
+			page = append(page, edge.Node)
 			check[edge.Node.Number] = struct{}{}
-			if len(prs) == limit {
-				break loop
+			if fetched+len(page) == limit {
+				break
 			}
 		}
+		fetched += len(page)
+		if err := fn(page, totalCount); err != nil {
+			return err
+		}
 
-		if prData.PageInfo.HasNextPage {
-			variables["endCursor"] = prData.PageInfo.EndCursor
-			pageLimit = min(pageLimit, limit-len(prs))
-		} else {
+		if fetched == limit || !prData.PageInfo.HasNextPage {
 			break
 		}
+		variables["endCursor"] = prData.PageInfo.EndCursor
+		pageLimit = min(pageLimit, limit-fetched)
 	}
-	res.PullRequests = prs
-	return &res, nil
+
+	return nil
 }
 
 func PullRequestClose(client *Client, repo ghrepo.Interface, pr *PullRequest) error {
@@ -1020,7 +1104,114 @@ func PullRequestReopen(client *Client, repo ghrepo.Interface, pr *PullRequest) e
 	return err
 }
 
-func PullRequestMerge(client *Client, repo ghrepo.Interface, pr *PullRequest, m PullRequestMergeMethod) error {
+// CommitAuthor names one of the people who authored the commits of a pull
+// request
+type CommitAuthor struct {
+	Name  string
+	Email string
+	Login string
+}
+
+// PullRequestCommitAuthors returns the distinct authors of the commits on a
+// pull request, in the order they first appear in the commit list
+func PullRequestCommitAuthors(client *Client, repo ghrepo.Interface, pr *PullRequest) ([]CommitAuthor, error) {
+	type responseData struct {
+		Repository struct {
+			PullRequest struct {
+				Commits struct {
+					Nodes []struct {
+						Commit struct {
+							Authors struct {
+								Nodes []struct {
+									Name  string
+									Email string
+									User  struct {
+										Login string
+									}
+								}
+							}
+						}
+					}
+					PageInfo struct {
+						HasNextPage bool
+						EndCursor   string
+					}
+				}
+			}
+		}
+	}
+
+	query := `
+	query PullRequestCommitAuthors($owner: String!, $repo: String!, $number: Int!, $endCursor: String) {
+		repository(owner: $owner, name: $repo) {
+			pullRequest(number: $number) {
+				commits(first: 100, after: $endCursor) {
+					nodes {
+						commit {
+							authors(first: 10) {
+								nodes {
+									name
+									email
+									user {
+										login
+									}
+								}
+							}
+						}
+					}
+					pageInfo {
+						hasNextPage
+						endCursor
+					}
+				}
+			}
+		}
+	}`
+
+	variables := map[string]interface{}{
+		"owner":  repo.RepoOwner(),
+		"repo":   repo.RepoName(),
+		"number": pr.Number,
+	}
+
+	var authors []CommitAuthor
+	seen := map[string]struct{}{}
+	for {
+		var response responseData
+		err := client.GraphQL(repo.RepoHost(), query, variables, &response)
+		if err != nil {
+			return nil, err
+		}
+
+		commits := response.Repository.PullRequest.Commits
+		for _, node := range commits.Nodes {
+			for _, author := range node.Commit.Authors.Nodes {
+				key := author.Email
+				if key == "" {
+					key = author.Name
+				}
+				if _, exists := seen[key]; exists {
+					continue
+				}
+				seen[key] = struct{}{}
+				authors = append(authors, CommitAuthor{
+					Name:  author.Name,
+					Email: author.Email,
+					Login: author.User.Login,
+				})
+			}
+		}
+
+		if !commits.PageInfo.HasNextPage {
+			break
+		}
+		variables["endCursor"] = commits.PageInfo.EndCursor
+	}
+
+	return authors, nil
+}
+
+func PullRequestMerge(client *Client, repo ghrepo.Interface, pr *PullRequest, m PullRequestMergeMethod, commitBody string) error {
 	mergeMethod := githubv4.PullRequestMergeMethodMerge
 	switch m {
 	case PullRequestMergeMethodRebase:
@@ -1045,6 +1236,10 @@ func PullRequestMerge(client *Client, repo ghrepo.Interface, pr *PullRequest, m
 	if m == PullRequestMergeMethodSquash {
 		commitHeadline := githubv4.String(fmt.Sprintf("%s (#%d)", pr.Title, pr.Number))
 		input.CommitHeadline = &commitHeadline
+		if commitBody != "" {
+			body := githubv4.String(commitBody)
+			input.CommitBody = &body
+		}
 	}
 
 	variables := map[string]interface{}{
@@ -1057,6 +1252,112 @@ func PullRequestMerge(client *Client, repo ghrepo.Interface, pr *PullRequest, m
 	return err
 }
 
+func PullRequestUpdate(client *Client, repo ghrepo.Interface, params githubv4.UpdatePullRequestInput) error {
+	var mutation struct {
+		UpdatePullRequest struct {
+			PullRequest struct {
+				ID githubv4.ID
+			}
+		} `graphql:"updatePullRequest(input: $input)"`
+	}
+
+	variables := map[string]interface{}{
+		"input": params,
+	}
+
+	gql := graphQLClient(client.http, repo.RepoHost())
+	return gql.MutateNamed(context.Background(), "PullRequestUpdate", &mutation, variables)
+}
+
+// PullRequestSetReviewers replaces the set of requested reviewers on a pull request
+func PullRequestSetReviewers(client *Client, repo ghrepo.Interface, pr *PullRequest, userIDs, teamIDs []string) error {
+	var mutation struct {
+		RequestReviews struct {
+			PullRequest struct {
+				ID githubv4.ID
+			}
+		} `graphql:"requestReviews(input: $input)"`
+	}
+
+	users := make([]githubv4.ID, 0, len(userIDs))
+	for _, id := range userIDs {
+		users = append(users, githubv4.ID(id))
+	}
+	teams := make([]githubv4.ID, 0, len(teamIDs))
+	for _, id := range teamIDs {
+		teams = append(teams, githubv4.ID(id))
+	}
+
+	union := githubv4.Boolean(false)
+	variables := map[string]interface{}{
+		"input": githubv4.RequestReviewsInput{
+			PullRequestID: pr.ID,
+			UserIDs:       &users,
+			TeamIDs:       &teams,
+			Union:         &union,
+		},
+	}
+
+	gql := graphQLClient(client.http, repo.RepoHost())
+	return gql.MutateNamed(context.Background(), "PullRequestSetReviewers", &mutation, variables)
+}
+
+func PullRequestAutoMergeEnable(client *Client, repo ghrepo.Interface, pr *PullRequest, m PullRequestMergeMethod) error {
+	mergeMethod := "MERGE"
+	switch m {
+	case PullRequestMergeMethodRebase:
+		mergeMethod = "REBASE"
+	case PullRequestMergeMethodSquash:
+		mergeMethod = "SQUASH"
+	}
+
+	var result struct {
+		EnablePullRequestAutoMerge struct {
+			PullRequest struct {
+				ID string
+			}
+		}
+	}
+
+	// the githubv4 package does not know about this mutation yet
+	query := `
+	mutation PullRequestAutoMergeEnable($id: ID!, $method: PullRequestMergeMethod!) {
+		enablePullRequestAutoMerge(input: {pullRequestId: $id, mergeMethod: $method}) {
+			pullRequest { id }
+		}
+	}`
+
+	variables := map[string]interface{}{
+		"id":     pr.ID,
+		"method": mergeMethod,
+	}
+
+	return client.GraphQL(repo.RepoHost(), query, variables, &result)
+}
+
+func PullRequestAutoMergeDisable(client *Client, repo ghrepo.Interface, pr *PullRequest) error {
+	var result struct {
+		DisablePullRequestAutoMerge struct {
+			PullRequest struct {
+				ID string
+			}
+		}
+	}
+
+	query := `
+	mutation PullRequestAutoMergeDisable($id: ID!) {
+		disablePullRequestAutoMerge(input: {pullRequestId: $id}) {
+			pullRequest { id }
+		}
+	}`
+
+	variables := map[string]interface{}{
+		"id": pr.ID,
+	}
+
+	return client.GraphQL(repo.RepoHost(), query, variables, &result)
+}
+
 func PullRequestReady(client *Client, repo ghrepo.Interface, pr *PullRequest) error {
 	var mutation struct {
 		MarkPullRequestReadyForReview struct {
@@ -1076,6 +1377,30 @@ func PullRequestReady(client *Client, repo ghrepo.Interface, pr *PullRequest) er
 	return gql.MutateNamed(context.Background(), "PullRequestReadyForReview", &mutation, variables)
 }
 
+func PullRequestConvertToDraft(client *Client, repo ghrepo.Interface, pr *PullRequest) error {
+	var result struct {
+		ConvertPullRequestToDraft struct {
+			PullRequest struct {
+				ID string
+			}
+		}
+	}
+
+	// the githubv4 package does not know about this mutation yet
+	query := `
+	mutation PullRequestConvertToDraft($id: ID!) {
+		convertPullRequestToDraft(input: {pullRequestId: $id}) {
+			pullRequest { id }
+		}
+	}`
+
+	variables := map[string]interface{}{
+		"id": pr.ID,
+	}
+
+	return client.GraphQL(repo.RepoHost(), query, variables, &result)
+}
+
 func BranchDeleteRemote(client *Client, repo ghrepo.Interface, branch string) error {
 	path := fmt.Sprintf("repos/%s/%s/git/refs/heads/%s", repo.RepoOwner(), repo.RepoName(), branch)
 	return client.REST(repo.RepoHost(), "DELETE", path, nil, nil)
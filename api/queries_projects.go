@@ -0,0 +1,293 @@
+package api
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cli/cli/internal/ghrepo"
+)
+
+// ProjectV2 is a next-generation project that an issue or pull request can be
+// added to
+type ProjectV2 struct {
+	ID    string
+	Title string
+}
+
+// ProjectV2Field describes one field of a ProjectV2, along with the values it
+// accepts for single-select and iteration fields
+type ProjectV2Field struct {
+	ID       string
+	Name     string
+	DataType string
+	Options  []struct {
+		ID   string
+		Name string
+	}
+	Configuration struct {
+		Iterations []struct {
+			ID    string
+			Title string
+		}
+	}
+}
+
+// ProjectV2AddItemWithFields adds an issue or pull request to the ProjectV2
+// with the given title and sets field values such as "Status=Todo" on the new
+// item. Single-select and iteration fields are matched against their option
+// names; other fields receive the raw value.
+func ProjectV2AddItemWithFields(client *Client, repo ghrepo.Interface, projectTitle string, contentID string, fieldPairs []string) error {
+	project, err := projectV2ByTitle(client, repo, projectTitle)
+	if err != nil {
+		return err
+	}
+
+	itemID, err := projectV2AddItem(client, repo, project.ID, contentID)
+	if err != nil {
+		return err
+	}
+
+	if len(fieldPairs) == 0 {
+		return nil
+	}
+
+	fields, err := projectV2Fields(client, repo, project.ID)
+	if err != nil {
+		return err
+	}
+
+	for _, pair := range fieldPairs {
+		parts := strings.SplitN(pair, "=", 2)
+		if len(parts) < 2 {
+			return fmt.Errorf("invalid project field %q: expected the \"name=value\" format", pair)
+		}
+		name, rawValue := parts[0], parts[1]
+
+		var field *ProjectV2Field
+		for i, f := range fields {
+			if strings.EqualFold(f.Name, name) {
+				field = &fields[i]
+				break
+			}
+		}
+		if field == nil {
+			return fmt.Errorf("project %q has no field named %q", project.Title, name)
+		}
+
+		value, err := projectV2FieldValue(field, rawValue)
+		if err != nil {
+			return err
+		}
+
+		err = projectV2UpdateItemField(client, repo, project.ID, itemID, field.ID, value)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// projectV2FieldValue translates a raw value into the value input expected by
+// the updateProjectV2ItemFieldValue mutation for the field's data type
+func projectV2FieldValue(field *ProjectV2Field, rawValue string) (map[string]interface{}, error) {
+	switch field.DataType {
+	case "SINGLE_SELECT":
+		for _, option := range field.Options {
+			if strings.EqualFold(option.Name, rawValue) {
+				return map[string]interface{}{"singleSelectOptionId": option.ID}, nil
+			}
+		}
+		return nil, fmt.Errorf("field %q has no option named %q", field.Name, rawValue)
+	case "ITERATION":
+		for _, iteration := range field.Configuration.Iterations {
+			if strings.EqualFold(iteration.Title, rawValue) {
+				return map[string]interface{}{"iterationId": iteration.ID}, nil
+			}
+		}
+		return nil, fmt.Errorf("field %q has no iteration named %q", field.Name, rawValue)
+	case "NUMBER":
+		number, err := strconv.ParseFloat(rawValue, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number for field %q: %q", field.Name, rawValue)
+		}
+		return map[string]interface{}{"number": number}, nil
+	case "DATE":
+		return map[string]interface{}{"date": rawValue}, nil
+	default:
+		return map[string]interface{}{"text": rawValue}, nil
+	}
+}
+
+// projectV2ByTitle finds a ProjectV2 by title among the repository's projects
+// and those of its owner
+func projectV2ByTitle(client *Client, repo ghrepo.Interface, title string) (*ProjectV2, error) {
+	type projectsBlock struct {
+		Nodes []ProjectV2
+	}
+	var response struct {
+		Repository struct {
+			ProjectsV2 projectsBlock
+		}
+		RepositoryOwner struct {
+			ProjectsV2 projectsBlock
+		}
+	}
+
+	query := `
+	query ProjectV2ByTitle($owner: String!, $repo: String!) {
+		repository(owner: $owner, name: $repo) {
+			projectsV2(first: 100) {
+				nodes {
+					id
+					title
+				}
+			}
+		}
+		repositoryOwner(login: $owner) {
+			... on ProjectV2Owner {
+				projectsV2(first: 100) {
+					nodes {
+						id
+						title
+					}
+				}
+			}
+		}
+	}`
+
+	variables := map[string]interface{}{
+		"owner": repo.RepoOwner(),
+		"repo":  repo.RepoName(),
+	}
+
+	err := client.GraphQL(repo.RepoHost(), query, variables, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := append(response.Repository.ProjectsV2.Nodes, response.RepositoryOwner.ProjectsV2.Nodes...)
+	for _, project := range candidates {
+		if strings.EqualFold(project.Title, title) {
+			return &project, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no project found with title %q", title)
+}
+
+func projectV2Fields(client *Client, repo ghrepo.Interface, projectID string) ([]ProjectV2Field, error) {
+	var response struct {
+		Node struct {
+			Fields struct {
+				Nodes []ProjectV2Field
+			}
+		}
+	}
+
+	query := `
+	query ProjectV2Fields($project: ID!) {
+		node(id: $project) {
+			... on ProjectV2 {
+				fields(first: 100) {
+					nodes {
+						... on ProjectV2FieldCommon {
+							id
+							name
+							dataType
+						}
+						... on ProjectV2SingleSelectField {
+							options {
+								id
+								name
+							}
+						}
+						... on ProjectV2IterationField {
+							configuration {
+								iterations {
+									id
+									title
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}`
+
+	variables := map[string]interface{}{
+		"project": projectID,
+	}
+
+	err := client.GraphQL(repo.RepoHost(), query, variables, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Node.Fields.Nodes, nil
+}
+
+func projectV2AddItem(client *Client, repo ghrepo.Interface, projectID string, contentID string) (string, error) {
+	var response struct {
+		AddProjectV2ItemByID struct {
+			Item struct {
+				ID string
+			}
+		} `json:"addProjectV2ItemById"`
+	}
+
+	query := `
+	mutation ProjectV2ItemAdd($input: AddProjectV2ItemByIdInput!) {
+		addProjectV2ItemById(input: $input) {
+			item {
+				id
+			}
+		}
+	}`
+
+	variables := map[string]interface{}{
+		"input": map[string]interface{}{
+			"projectId": projectID,
+			"contentId": contentID,
+		},
+	}
+
+	err := client.GraphQL(repo.RepoHost(), query, variables, &response)
+	if err != nil {
+		return "", err
+	}
+
+	return response.AddProjectV2ItemByID.Item.ID, nil
+}
+
+func projectV2UpdateItemField(client *Client, repo ghrepo.Interface, projectID, itemID, fieldID string, value map[string]interface{}) error {
+	var response struct {
+		UpdateProjectV2ItemFieldValue struct {
+			ProjectV2Item struct {
+				ID string
+			}
+		}
+	}
+
+	query := `
+	mutation ProjectV2ItemFieldUpdate($input: UpdateProjectV2ItemFieldValueInput!) {
+		updateProjectV2ItemFieldValue(input: $input) {
+			projectV2Item {
+				id
+			}
+		}
+	}`
+
+	variables := map[string]interface{}{
+		"input": map[string]interface{}{
+			"projectId": projectID,
+			"itemId":    itemID,
+			"fieldId":   fieldID,
+			"value":     value,
+		},
+	}
+
+	return client.GraphQL(repo.RepoHost(), query, variables, &response)
+}
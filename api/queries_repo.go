@@ -26,10 +26,22 @@ type Repository struct {
 	Owner       RepositoryOwner
 
 	IsPrivate        bool
+	IsArchived       bool
 	HasIssuesEnabled bool
 	ViewerPermission string
 	DefaultBranchRef BranchRef
 
+	Stargazers struct {
+		TotalCount int
+	}
+	RepositoryTopics struct {
+		Nodes []struct {
+			Topic struct {
+				Name string
+			}
+		}
+	}
+
 	Parent *Repository
 
 	// pseudo-field that keeps track of host name of this repo
@@ -91,12 +103,23 @@ func GitHubRepo(client *Client, repo ghrepo.Interface) (*Repository, error) {
 	query RepositoryInfo($owner: String!, $name: String!) {
 		repository(owner: $owner, name: $name) {
 			id
+			isArchived
 			hasIssuesEnabled
 			description
 			viewerPermission
 			defaultBranchRef {
 				name
 			}
+			stargazers {
+				totalCount
+			}
+			repositoryTopics(first: 100) {
+				nodes {
+					topic {
+						name
+					}
+				}
+			}
 		}
 	}`
 	variables := map[string]interface{}{
@@ -142,7 +165,7 @@ func CanPushToRepo(httpClient *http.Client, repo ghrepo.Interface) (bool, error)
 }
 
 // RepoParent finds out the parent repository of a fork
-func RepoParent(client *Client, repo ghrepo.Interface) (ghrepo.Interface, error) {
+func RepoParent(client *Client, repo ghrepo.Interface) (*Repository, error) {
 	var query struct {
 		Repository struct {
 			Parent *struct {
@@ -150,6 +173,9 @@ func RepoParent(client *Client, repo ghrepo.Interface) (ghrepo.Interface, error)
 				Owner struct {
 					Login string
 				}
+				DefaultBranchRef struct {
+					Name string
+				}
 			}
 		} `graphql:"repository(owner: $owner, name: $name)"`
 	}
@@ -168,8 +194,12 @@ func RepoParent(client *Client, repo ghrepo.Interface) (ghrepo.Interface, error)
 		return nil, nil
 	}
 
-	parent := ghrepo.NewWithHost(query.Repository.Parent.Owner.Login, query.Repository.Parent.Name, repo.RepoHost())
-	return parent, nil
+	parent := &Repository{
+		Name:             query.Repository.Parent.Name,
+		Owner:            RepositoryOwner{Login: query.Repository.Parent.Owner.Login},
+		DefaultBranchRef: BranchRef{Name: query.Repository.Parent.DefaultBranchRef.Name},
+	}
+	return InitRepoHostname(parent, repo.RepoHost()), nil
 }
 
 // RepoNetworkResult describes the relationship between related repositories
@@ -294,10 +324,21 @@ type repositoryV3 struct {
 	}
 }
 
-// ForkRepo forks the repository on GitHub and returns the new repository
-func ForkRepo(client *Client, repo ghrepo.Interface) (*Repository, error) {
+// ForkRepo forks the repository on GitHub and returns the new repository.
+// When org is non-empty, the fork is created in that organization instead of
+// under the authenticated user.
+func ForkRepo(client *Client, repo ghrepo.Interface, org string) (*Repository, error) {
 	path := fmt.Sprintf("repos/%s/forks", ghrepo.FullName(repo))
-	body := bytes.NewBufferString(`{}`)
+
+	params := map[string]interface{}{}
+	if org != "" {
+		params["organization"] = org
+	}
+	body := &bytes.Buffer{}
+	if err := json.NewEncoder(body).Encode(params); err != nil {
+		return nil, err
+	}
+
 	result := repositoryV3{}
 	err := client.REST(repo.RepoHost(), "POST", path, body, &result)
 	if err != nil {
@@ -317,6 +358,96 @@ func ForkRepo(client *Client, repo ghrepo.Interface) (*Repository, error) {
 	}, nil
 }
 
+// RenameRepo renames the repository on GitHub and returns the renamed repository
+func RenameRepo(client *Client, repo ghrepo.Interface, newRepoName string) (*Repository, error) {
+	body := &bytes.Buffer{}
+	if err := json.NewEncoder(body).Encode(map[string]string{"name": newRepoName}); err != nil {
+		return nil, err
+	}
+
+	result := repositoryV3{}
+	err := client.REST(repo.RepoHost(), "PATCH", fmt.Sprintf("repos/%s", ghrepo.FullName(repo)), body, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Repository{
+		ID:        result.NodeID,
+		Name:      result.Name,
+		CloneURL:  result.CloneURL,
+		CreatedAt: result.CreatedAt,
+		Owner: RepositoryOwner{
+			Login: result.Owner.Login,
+		},
+		ViewerPermission: "WRITE",
+		hostname:         repo.RepoHost(),
+	}, nil
+}
+
+// RepoArchive archives a repository, making it read-only
+func RepoArchive(client *Client, repo *Repository) error {
+	var mutation struct {
+		ArchiveRepository struct {
+			Repository struct {
+				ID githubv4.ID
+			}
+		} `graphql:"archiveRepository(input: $input)"`
+	}
+
+	variables := map[string]interface{}{
+		"input": githubv4.ArchiveRepositoryInput{
+			RepositoryID: repo.ID,
+		},
+	}
+
+	gql := graphQLClient(client.http, repo.RepoHost())
+	err := gql.MutateNamed(context.Background(), "RepositoryArchive", &mutation, variables)
+
+	return err
+}
+
+// UnarchiveRepositoryInput is the input to the unarchiveRepository mutation,
+// which the vendored githubv4 schema predates
+type UnarchiveRepositoryInput struct {
+	RepositoryID githubv4.ID `json:"repositoryId"`
+}
+
+// RepoUnarchive unarchives a repository, making it writable again
+func RepoUnarchive(client *Client, repo *Repository) error {
+	var mutation struct {
+		UnarchiveRepository struct {
+			Repository struct {
+				ID githubv4.ID
+			}
+		} `graphql:"unarchiveRepository(input: $input)"`
+	}
+
+	variables := map[string]interface{}{
+		"input": UnarchiveRepositoryInput{
+			RepositoryID: repo.ID,
+		},
+	}
+
+	gql := graphQLClient(client.http, repo.RepoHost())
+	err := gql.MutateNamed(context.Background(), "RepositoryUnarchive", &mutation, variables)
+
+	return err
+}
+
+// RepoExists reports whether a repository exists on the host
+func RepoExists(client *Client, repo ghrepo.Interface) (bool, error) {
+	err := client.REST(repo.RepoHost(), "GET", fmt.Sprintf("repos/%s", ghrepo.FullName(repo)), nil, nil)
+	if err == nil {
+		return true, nil
+	}
+
+	var httpError HTTPError
+	if errors.As(err, &httpError) && httpError.StatusCode == 404 {
+		return false, nil
+	}
+	return false, err
+}
+
 // RepoFindFork finds a fork of repo affiliated with the viewer
 func RepoFindFork(client *Client, repo ghrepo.Interface) (*Repository, error) {
 	result := struct {
@@ -368,6 +499,50 @@ type RepoMetadataResult struct {
 	Teams           []OrgTeam
 }
 
+// notFoundError builds a "not found" error for a metadata lookup, suggesting
+// the closest-matching candidate when the name looks like a typo
+func notFoundError(name string, candidates []string) error {
+	if suggestion := closestMatch(name, candidates); suggestion != "" {
+		return fmt.Errorf("'%s' not found; did you mean '%s'?", name, suggestion)
+	}
+	return fmt.Errorf("'%s' not found", name)
+}
+
+func closestMatch(name string, candidates []string) string {
+	best := ""
+	bestDistance := 3 // tolerate at most 2 edits
+	for _, candidate := range candidates {
+		if d := editDistance(strings.ToLower(name), strings.ToLower(candidate)); d < bestDistance {
+			best = candidate
+			bestDistance = d
+		}
+	}
+	return best
+}
+
+// editDistance computes the Levenshtein distance between two strings
+func editDistance(a, b string) int {
+	ar, br := []rune(a), []rune(b)
+	row := make([]int, len(br)+1)
+	for j := range row {
+		row[j] = j
+	}
+	for i := 1; i <= len(ar); i++ {
+		prev := row[0]
+		row[0] = i
+		for j := 1; j <= len(br); j++ {
+			current := row[j]
+			cost := 1
+			if ar[i-1] == br[j-1] {
+				cost = 0
+			}
+			row[j] = min(min(row[j]+1, row[j-1]+1), prev+cost)
+			prev = current
+		}
+	}
+	return row[len(br)]
+}
+
 func (m *RepoMetadataResult) MembersToIDs(names []string) ([]string, error) {
 	var ids []string
 	for _, assigneeLogin := range names {
@@ -380,7 +555,11 @@ func (m *RepoMetadataResult) MembersToIDs(names []string) ([]string, error) {
 			}
 		}
 		if !found {
-			return nil, fmt.Errorf("'%s' not found", assigneeLogin)
+			candidates := make([]string, 0, len(m.AssignableUsers))
+			for _, u := range m.AssignableUsers {
+				candidates = append(candidates, u.Login)
+			}
+			return nil, notFoundError(assigneeLogin, candidates)
 		}
 	}
 	return ids, nil
@@ -399,7 +578,11 @@ func (m *RepoMetadataResult) TeamsToIDs(names []string) ([]string, error) {
 			}
 		}
 		if !found {
-			return nil, fmt.Errorf("'%s' not found", teamSlug)
+			candidates := make([]string, 0, len(m.Teams))
+			for _, t := range m.Teams {
+				candidates = append(candidates, t.Slug)
+			}
+			return nil, notFoundError(slug, candidates)
 		}
 	}
 	return ids, nil
@@ -417,7 +600,11 @@ func (m *RepoMetadataResult) LabelsToIDs(names []string) ([]string, error) {
 			}
 		}
 		if !found {
-			return nil, fmt.Errorf("'%s' not found", labelName)
+			candidates := make([]string, 0, len(m.Labels))
+			for _, l := range m.Labels {
+				candidates = append(candidates, l.Name)
+			}
+			return nil, notFoundError(labelName, candidates)
 		}
 	}
 	return ids, nil
@@ -435,19 +622,27 @@ func (m *RepoMetadataResult) ProjectsToIDs(names []string) ([]string, error) {
 			}
 		}
 		if !found {
-			return nil, fmt.Errorf("'%s' not found", projectName)
+			candidates := make([]string, 0, len(m.Projects))
+			for _, p := range m.Projects {
+				candidates = append(candidates, p.Name)
+			}
+			return nil, notFoundError(projectName, candidates)
 		}
 	}
 	return ids, nil
 }
 
 func (m *RepoMetadataResult) MilestoneToID(title string) (string, error) {
-	for _, m := range m.Milestones {
-		if strings.EqualFold(title, m.Title) {
-			return m.ID, nil
+	for _, milestone := range m.Milestones {
+		if strings.EqualFold(title, milestone.Title) {
+			return milestone.ID, nil
 		}
 	}
-	return "", errors.New("not found")
+	candidates := make([]string, 0, len(m.Milestones))
+	for _, milestone := range m.Milestones {
+		candidates = append(candidates, milestone.Title)
+	}
+	return "", notFoundError(title, candidates)
 }
 
 type RepoMetadataInput struct {
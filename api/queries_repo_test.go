@@ -233,3 +233,38 @@ func sliceEqual(a, b []string) bool {
 
 	return true
 }
+
+func Test_RepoMetadata_notFoundSuggestions(t *testing.T) {
+	result := RepoMetadataResult{
+		Labels: []RepoLabel{
+			{Name: "bug", ID: "BUGID"},
+			{Name: "help wanted", ID: "HELPID"},
+		},
+		AssignableUsers: []RepoAssignee{
+			{Login: "monalisa", ID: "MONAID"},
+		},
+		Milestones: []RepoMilestone{
+			{Title: "1.0", ID: "V1ID"},
+		},
+	}
+
+	_, err := result.LabelsToIDs([]string{"bugg"})
+	if err == nil || err.Error() != "'bugg' not found; did you mean 'bug'?" {
+		t.Errorf("got error %v", err)
+	}
+
+	_, err = result.MembersToIDs([]string{"monalisaa"})
+	if err == nil || err.Error() != "'monalisaa' not found; did you mean 'monalisa'?" {
+		t.Errorf("got error %v", err)
+	}
+
+	_, err = result.LabelsToIDs([]string{"completely different"})
+	if err == nil || err.Error() != "'completely different' not found" {
+		t.Errorf("got error %v", err)
+	}
+
+	_, err = result.MilestoneToID("1.1")
+	if err == nil || err.Error() != "'1.1' not found; did you mean '1.0'?" {
+		t.Errorf("got error %v", err)
+	}
+}
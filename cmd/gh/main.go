@@ -14,7 +14,9 @@ import (
 	"github.com/cli/cli/command"
 	"github.com/cli/cli/internal/config"
 	"github.com/cli/cli/internal/ghinstance"
+	"github.com/cli/cli/internal/log"
 	"github.com/cli/cli/internal/run"
+	"github.com/cli/cli/pkg/browser"
 	"github.com/cli/cli/pkg/cmd/alias/expand"
 	"github.com/cli/cli/pkg/cmd/factory"
 	"github.com/cli/cli/pkg/cmd/root"
@@ -31,7 +33,10 @@ func main() {
 	currentVersion := command.Version
 	updateMessageChan := make(chan *update.ReleaseInfo)
 	go func() {
-		rel, _ := checkForUpdate(currentVersion)
+		rel, err := checkForUpdate(currentVersion)
+		if err != nil {
+			log.Warn("checking for update failed", map[string]interface{}{"error": err.Error()})
+		}
 		updateMessageChan <- rel
 	}()
 
@@ -57,6 +62,10 @@ func main() {
 		cmdFactory.IOStreams.SetNeverPrompt(true)
 	}
 
+	if browserLauncher, _ := cfg.Get(ghinstance.OverridableDefault(), "browser"); browserLauncher != "" {
+		browser.SetConfigLauncher(browserLauncher)
+	}
+
 	expandedArgs := []string{}
 	if len(os.Args) > 0 {
 		expandedArgs = os.Args[1:]
@@ -114,6 +123,12 @@ func main() {
 	rootCmd.SetArgs(expandedArgs)
 
 	if cmd, err := rootCmd.ExecuteC(); err != nil {
+		var skippedErr api.SkippedMutationError
+		if errors.As(err, &skippedErr) {
+			// in --dry-run mode, a blocked mutation is a successful rehearsal
+			os.Exit(0)
+		}
+
 		printError(stderr, err, cmd, hasDebug)
 
 		var httpErr api.HTTPError
@@ -213,6 +213,14 @@ func DeleteLocalBranch(branch string) error {
 	return err
 }
 
+// PruneRemoteTrackingRef deletes the local tracking ref for a branch on a
+// remote, e.g. refs/remotes/origin/feature
+func PruneRemoteTrackingRef(remote, branch string) error {
+	refCmd := GitCommand("update-ref", "-d", fmt.Sprintf("refs/remotes/%s/%s", remote, branch))
+	err := run.PrepareCmd(refCmd).Run()
+	return err
+}
+
 func HasLocalBranch(branch string) bool {
 	configCmd := GitCommand("rev-parse", "--verify", "refs/heads/"+branch)
 	_, err := run.PrepareCmd(configCmd).Output()
@@ -260,6 +268,19 @@ func RunClone(cloneURL string, args []string) (target string, err error) {
 	return
 }
 
+// Fetch downloads refs and objects from a remote without merging
+func Fetch(remote string, refspec string) error {
+	fetchCmd := GitCommand("fetch", remote, refspec)
+	return run.PrepareCmd(fetchCmd).Run()
+}
+
+// IsAncestor reports whether the first commit is an ancestor of the second
+func IsAncestor(a, b string) bool {
+	mergeBaseCmd := GitCommand("merge-base", "--is-ancestor", a, b)
+	err := run.PrepareCmd(mergeBaseCmd).Run()
+	return err == nil
+}
+
 func AddUpstreamRemote(upstreamURL, cloneDir string) error {
 	cloneCmd := GitCommand("-C", cloneDir, "remote", "add", "-f", "upstream", upstreamURL)
 	cloneCmd.Stdout = os.Stdout
@@ -267,6 +288,21 @@ func AddUpstreamRemote(upstreamURL, cloneDir string) error {
 	return run.PrepareCmd(cloneCmd).Run()
 }
 
+// SetBranchUpstream makes the local branch merge from the same-named branch
+// on the given remote when pulling
+func SetBranchUpstream(cloneDir, remote, branch string) error {
+	for _, args := range [][]string{
+		{"-C", cloneDir, "config", fmt.Sprintf("branch.%s.remote", branch), remote},
+		{"-C", cloneDir, "config", fmt.Sprintf("branch.%s.merge", branch), "refs/heads/" + branch},
+	} {
+		configCmd := GitCommand(args...)
+		if err := run.PrepareCmd(configCmd).Run(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func isFilesystemPath(p string) bool {
 	return p == "." || strings.HasPrefix(p, "./") || strings.HasPrefix(p, "/")
 }
@@ -1,6 +1,7 @@
 package git
 
 import (
+	"fmt"
 	"net/url"
 	"os/exec"
 	"regexp"
@@ -26,6 +27,7 @@ func NewRemote(name string, u string) *Remote {
 // Remote is a parsed git remote
 type Remote struct {
 	Name     string
+	Resolved string
 	FetchURL *url.URL
 	PushURL  *url.URL
 }
@@ -40,7 +42,27 @@ func Remotes() (RemoteSet, error) {
 	if err != nil {
 		return nil, err
 	}
-	return parseRemotes(list), nil
+	remotes := parseRemotes(list)
+
+	// this configuration value is set by `gh repo set-default`
+	configCmd := exec.Command("git", "config", "--get-regexp", `^remote\..*\.gh-resolved$`)
+	output, _ := run.PrepareCmd(configCmd).Output()
+	for _, l := range outputLines(output) {
+		parts := strings.SplitN(l, " ", 2)
+		if len(parts) < 2 {
+			continue
+		}
+		rp := strings.SplitN(parts[0], ".", 3)
+		name := rp[1]
+		for _, r := range remotes {
+			if r.Name == name {
+				r.Resolved = parts[1]
+				break
+			}
+		}
+	}
+
+	return remotes, nil
 }
 
 func parseRemotes(gitRemotes []string) (remotes RemoteSet) {
@@ -109,3 +131,21 @@ func AddRemote(name, u string) (*Remote, error) {
 		PushURL:  urlParsed,
 	}, nil
 }
+
+// UpdateRemoteURL reconfigures an existing git remote to point at a new URL
+func UpdateRemoteURL(name, u string) error {
+	setCmd := exec.Command("git", "remote", "set-url", name, u)
+	return run.PrepareCmd(setCmd).Run()
+}
+
+// SetRemoteResolution records that a git remote is the resolved base repository
+func SetRemoteResolution(name, resolution string) error {
+	configCmd := exec.Command("git", "config", "--add", fmt.Sprintf("remote.%s.gh-resolved", name), resolution)
+	return run.PrepareCmd(configCmd).Run()
+}
+
+// UnsetRemoteResolution removes the resolution recorded for a git remote
+func UnsetRemoteResolution(name string) error {
+	configCmd := exec.Command("git", "config", "--unset-all", fmt.Sprintf("remote.%s.gh-resolved", name))
+	return run.PrepareCmd(configCmd).Run()
+}
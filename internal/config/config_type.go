@@ -171,6 +171,24 @@ func NewBlankRoot() *yaml.Node {
 						Kind:  yaml.ScalarNode,
 						Value: "",
 					},
+					{
+						HeadComment: "What pager program to pipe long output through. If blank, will refer to environment. Set to `cat` to disable the pager.",
+						Kind:        yaml.ScalarNode,
+						Value:       "pager",
+					},
+					{
+						Kind:  yaml.ScalarNode,
+						Value: "",
+					},
+					{
+						HeadComment: "What web browser gh should use when opening URLs. If blank, will refer to environment.",
+						Kind:        yaml.ScalarNode,
+						Value:       "browser",
+					},
+					{
+						Kind:  yaml.ScalarNode,
+						Value: "",
+					},
 					{
 						HeadComment: "When to interactively prompt. This is a global config that cannot be overriden by hostname. Supported values: enabled, disabled",
 						Kind:        yaml.ScalarNode,
@@ -0,0 +1,159 @@
+// Package log provides a leveled logger configured entirely from the
+// environment, so that diagnostic output can be captured from a user's
+// machine without code changes:
+//
+//	GH_LOG_LEVEL  enables logging at "debug", "info", "warn", or "error"
+//	GH_LOG_FORMAT set to "json" to emit one JSON object per line
+//	GH_LOG_FILE   append log lines to a file instead of standard error
+package log
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+type Level int
+
+const (
+	LevelDebug Level = iota
+	LevelInfo
+	LevelWarn
+	LevelError
+	// LevelNone disables logging entirely
+	LevelNone
+)
+
+func (l Level) String() string {
+	switch l {
+	case LevelDebug:
+		return "debug"
+	case LevelInfo:
+		return "info"
+	case LevelWarn:
+		return "warn"
+	case LevelError:
+		return "error"
+	}
+	return "none"
+}
+
+// ParseLevel interprets a level name such as "debug"; blank means disabled
+func ParseLevel(name string) (Level, error) {
+	switch strings.ToLower(name) {
+	case "":
+		return LevelNone, nil
+	case "debug":
+		return LevelDebug, nil
+	case "info":
+		return LevelInfo, nil
+	case "warn", "warning":
+		return LevelWarn, nil
+	case "error":
+		return LevelError, nil
+	}
+	return LevelNone, fmt.Errorf("invalid log level: %q", name)
+}
+
+// Logger writes timestamped, leveled log lines to a single destination. The
+// zero value discards everything.
+type Logger struct {
+	mu     sync.Mutex
+	out    io.Writer
+	level  Level
+	asJSON bool
+
+	// stubbed in tests
+	now func() time.Time
+}
+
+func New(out io.Writer, level Level, asJSON bool) *Logger {
+	return &Logger{
+		out:    out,
+		level:  level,
+		asJSON: asJSON,
+		now:    time.Now,
+	}
+}
+
+// FromEnv builds a logger from GH_LOG_LEVEL, GH_LOG_FORMAT, and GH_LOG_FILE.
+// Logging is disabled unless GH_LOG_LEVEL names a valid level, and falls back
+// to standard error when the log file cannot be opened.
+func FromEnv() *Logger {
+	level, err := ParseLevel(os.Getenv("GH_LOG_LEVEL"))
+	if err != nil || level == LevelNone {
+		return New(nil, LevelNone, false)
+	}
+
+	out := io.Writer(os.Stderr)
+	if logFile := os.Getenv("GH_LOG_FILE"); logFile != "" {
+		if f, err := os.OpenFile(logFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600); err == nil {
+			out = f
+		}
+	}
+
+	return New(out, level, strings.EqualFold(os.Getenv("GH_LOG_FORMAT"), "json"))
+}
+
+// Default is the process-wide logger used by the package-level helpers
+var Default = FromEnv()
+
+func Debug(msg string, fields map[string]interface{}) { Default.Log(LevelDebug, msg, fields) }
+func Info(msg string, fields map[string]interface{})  { Default.Log(LevelInfo, msg, fields) }
+func Warn(msg string, fields map[string]interface{})  { Default.Log(LevelWarn, msg, fields) }
+func Error(msg string, fields map[string]interface{}) { Default.Log(LevelError, msg, fields) }
+
+// Enabled reports whether a message at level would be written
+func (l *Logger) Enabled(level Level) bool {
+	return l.out != nil && level >= l.level && level < LevelNone
+}
+
+func (l *Logger) Debug(msg string, fields map[string]interface{}) { l.Log(LevelDebug, msg, fields) }
+func (l *Logger) Info(msg string, fields map[string]interface{})  { l.Log(LevelInfo, msg, fields) }
+func (l *Logger) Warn(msg string, fields map[string]interface{})  { l.Log(LevelWarn, msg, fields) }
+func (l *Logger) Error(msg string, fields map[string]interface{}) { l.Log(LevelError, msg, fields) }
+
+func (l *Logger) Log(level Level, msg string, fields map[string]interface{}) {
+	if !l.Enabled(level) {
+		return
+	}
+
+	timestamp := l.now().Format(time.RFC3339)
+
+	var line string
+	if l.asJSON {
+		entry := make(map[string]interface{}, len(fields)+3)
+		for k, v := range fields {
+			entry[k] = v
+		}
+		entry["time"] = timestamp
+		entry["level"] = level.String()
+		entry["msg"] = msg
+		b, err := json.Marshal(entry)
+		if err != nil {
+			return
+		}
+		line = string(b)
+	} else {
+		var sb strings.Builder
+		fmt.Fprintf(&sb, "time=%s level=%s msg=%q", timestamp, level, msg)
+		keys := make([]string, 0, len(fields))
+		for k := range fields {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			fmt.Fprintf(&sb, " %s=%v", k, fields[k])
+		}
+		line = sb.String()
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	fmt.Fprintln(l.out, line)
+}
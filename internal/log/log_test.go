@@ -0,0 +1,103 @@
+package log
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseLevel(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    Level
+		wantErr bool
+	}{
+		{"", LevelNone, false},
+		{"debug", LevelDebug, false},
+		{"INFO", LevelInfo, false},
+		{"warning", LevelWarn, false},
+		{"error", LevelError, false},
+		{"loud", LevelNone, true},
+	}
+	for _, tt := range tests {
+		got, err := ParseLevel(tt.input)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("ParseLevel(%q): expected error", tt.input)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ParseLevel(%q): unexpected error: %v", tt.input, err)
+		} else if got != tt.want {
+			t.Errorf("ParseLevel(%q): expected %v, got %v", tt.input, tt.want, got)
+		}
+	}
+}
+
+func TestLogger_levelFiltering(t *testing.T) {
+	out := &bytes.Buffer{}
+	logger := New(out, LevelWarn, false)
+
+	logger.Debug("too quiet", nil)
+	logger.Info("still too quiet", nil)
+	logger.Error("loud enough", nil)
+
+	lines := strings.Split(strings.TrimSuffix(out.String(), "\n"), "\n")
+	if len(lines) != 1 {
+		t.Fatalf("expected 1 line, got %d: %q", len(lines), out.String())
+	}
+	if !strings.Contains(lines[0], `level=error msg="loud enough"`) {
+		t.Errorf("got line %q", lines[0])
+	}
+}
+
+func TestLogger_textFormat(t *testing.T) {
+	out := &bytes.Buffer{}
+	logger := New(out, LevelDebug, false)
+	logger.now = func() time.Time {
+		return time.Date(2020, 8, 1, 12, 30, 0, 0, time.UTC)
+	}
+
+	logger.Debug("http request", map[string]interface{}{
+		"status": 200,
+		"method": "GET",
+	})
+
+	want := "time=2020-08-01T12:30:00Z level=debug msg=\"http request\" method=GET status=200\n"
+	if out.String() != want {
+		t.Errorf("expected %q, got %q", want, out.String())
+	}
+}
+
+func TestLogger_jsonFormat(t *testing.T) {
+	out := &bytes.Buffer{}
+	logger := New(out, LevelDebug, true)
+
+	logger.Info("hello", map[string]interface{}{"name": "world"})
+
+	var entry map[string]interface{}
+	if err := json.Unmarshal(out.Bytes(), &entry); err != nil {
+		t.Fatalf("invalid JSON output: %v", err)
+	}
+	if entry["level"] != "info" || entry["msg"] != "hello" || entry["name"] != "world" {
+		t.Errorf("got entry %v", entry)
+	}
+	if _, ok := entry["time"]; !ok {
+		t.Error("expected a time field")
+	}
+}
+
+func TestLogger_disabled(t *testing.T) {
+	logger := &Logger{}
+	logger.Error("into the void", nil)
+
+	out := &bytes.Buffer{}
+	logger = New(out, LevelNone, false)
+	logger.Error("also into the void", nil)
+	if out.Len() > 0 {
+		t.Errorf("expected no output, got %q", out.String())
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"os"
 	"os/exec"
 	"strings"
+
+	"github.com/cli/cli/internal/log"
 )
 
 // Runnable is typically an exec.Cmd or its stub in tests
@@ -34,10 +36,17 @@ type cmdWithStderr struct {
 	*exec.Cmd
 }
 
-func (c cmdWithStderr) Output() ([]byte, error) {
+func (c cmdWithStderr) logCmd() {
 	if os.Getenv("DEBUG") != "" {
 		fmt.Fprintf(os.Stderr, "%v\n", c.Cmd.Args)
 	}
+	log.Debug("running command", map[string]interface{}{
+		"args": strings.Join(c.Cmd.Args, " "),
+	})
+}
+
+func (c cmdWithStderr) Output() ([]byte, error) {
+	c.logCmd()
 	if c.Cmd.Stderr != nil {
 		return c.Cmd.Output()
 	}
@@ -51,9 +60,7 @@ func (c cmdWithStderr) Output() ([]byte, error) {
 }
 
 func (c cmdWithStderr) Run() error {
-	if os.Getenv("DEBUG") != "" {
-		fmt.Fprintf(os.Stderr, "%v\n", c.Cmd.Args)
-	}
+	c.logCmd()
 	if c.Cmd.Stderr != nil {
 		return c.Cmd.Run()
 	}
@@ -9,15 +9,35 @@ import (
 	"github.com/google/shlex"
 )
 
-// Command produces an exec.Cmd respecting runtime.GOOS and $BROWSER environment variable
+// the launcher from gh configuration, consulted when $BROWSER is unset
+var configLauncher string
+
+// SetConfigLauncher records the browser launcher configured for the current host
+func SetConfigLauncher(launcher string) {
+	configLauncher = launcher
+}
+
+// Command produces an exec.Cmd respecting runtime.GOOS, the $BROWSER environment
+// variable, and the `browser` configuration value, in that order
 func Command(url string) (*exec.Cmd, error) {
 	launcher := os.Getenv("BROWSER")
+	if launcher == "" {
+		launcher = configLauncher
+	}
 	if launcher != "" {
 		return FromLauncher(launcher, url)
 	}
 	return ForOS(runtime.GOOS, url), nil
 }
 
+// IsHeadless reports whether there is no graphical session to open a browser in
+func IsHeadless() bool {
+	if runtime.GOOS != "linux" {
+		return false
+	}
+	return os.Getenv("DISPLAY") == "" && os.Getenv("WAYLAND_DISPLAY") == ""
+}
+
 // ForOS produces an exec.Cmd to open the web browser for different OS
 func ForOS(goos, url string) *exec.Cmd {
 	exe := "open"
@@ -39,14 +59,26 @@ func ForOS(goos, url string) *exec.Cmd {
 	return cmd
 }
 
-// FromLauncher parses the launcher string based on shell splitting rules
+// FromLauncher parses the launcher string based on shell splitting rules. A
+// `%s` placeholder in any argument is replaced with the URL; without a
+// placeholder, the URL is appended as the last argument.
 func FromLauncher(launcher, url string) (*exec.Cmd, error) {
 	args, err := shlex.Split(launcher)
 	if err != nil {
 		return nil, err
 	}
 
-	args = append(args, url)
+	hasPlaceholder := false
+	for i, arg := range args {
+		if strings.Contains(arg, "%s") {
+			args[i] = strings.ReplaceAll(arg, "%s", url)
+			hasPlaceholder = true
+		}
+	}
+	if !hasPlaceholder {
+		args = append(args, url)
+	}
+
 	cmd := exec.Command(args[0], args[1:]...)
 	cmd.Stderr = os.Stderr
 	return cmd, nil
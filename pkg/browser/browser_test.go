@@ -1,6 +1,7 @@
 package browser
 
 import (
+	"os"
 	"reflect"
 	"testing"
 )
@@ -48,3 +49,66 @@ func TestForOS(t *testing.T) {
 		})
 	}
 }
+
+func TestFromLauncher(t *testing.T) {
+	tests := []struct {
+		name     string
+		launcher string
+		url      string
+		want     []string
+	}{
+		{
+			name:     "plain command",
+			launcher: "firefox",
+			url:      "https://example.com",
+			want:     []string{"firefox", "https://example.com"},
+		},
+		{
+			name:     "command with arguments",
+			launcher: "firefox --new-window",
+			url:      "https://example.com",
+			want:     []string{"firefox", "--new-window", "https://example.com"},
+		},
+		{
+			name:     "command with placeholder",
+			launcher: `open -a "Google Chrome" %s --args`,
+			url:      "https://example.com",
+			want:     []string{"open", "-a", "Google Chrome", "https://example.com", "--args"},
+		},
+		{
+			name:     "placeholder inside an argument",
+			launcher: "kioclient exec %s?x=1",
+			url:      "https://example.com",
+			want:     []string{"kioclient", "exec", "https://example.com?x=1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd, err := FromLauncher(tt.launcher, tt.url)
+			if err != nil {
+				t.Fatalf("FromLauncher() error: %v", err)
+			}
+			if !reflect.DeepEqual(cmd.Args, tt.want) {
+				t.Errorf("FromLauncher() = %v, want %v", cmd.Args, tt.want)
+			}
+		})
+	}
+}
+
+func TestCommand_configLauncher(t *testing.T) {
+	oldBrowserEnv := os.Getenv("BROWSER")
+	os.Setenv("BROWSER", "")
+	defer func() {
+		os.Setenv("BROWSER", oldBrowserEnv)
+		SetConfigLauncher("")
+	}()
+
+	SetConfigLauncher("lynx")
+	cmd, err := Command("https://example.com")
+	if err != nil {
+		t.Fatalf("Command() error: %v", err)
+	}
+	if want := []string{"lynx", "https://example.com"}; !reflect.DeepEqual(cmd.Args, want) {
+		t.Errorf("Command() = %v, want %v", cmd.Args, want)
+	}
+}
@@ -0,0 +1,48 @@
+// Package clipboard copies text to the system clipboard by shelling out to
+// the platform's native clipboard tool.
+package clipboard
+
+import (
+	"errors"
+	"os/exec"
+	"runtime"
+	"strings"
+
+	"github.com/cli/cli/internal/run"
+)
+
+// stubbed in tests
+var lookPath = exec.LookPath
+
+// Copy writes text to the system clipboard
+func Copy(text string) error {
+	cmd, err := ForOS(runtime.GOOS)
+	if err != nil {
+		return err
+	}
+	cmd.Stdin = strings.NewReader(text)
+	return run.PrepareCmd(cmd).Run()
+}
+
+// ForOS produces an exec.Cmd that reads clipboard contents from standard
+// input for different OS
+func ForOS(goos string) (*exec.Cmd, error) {
+	switch goos {
+	case "darwin":
+		return exec.Command("pbcopy"), nil
+	case "windows":
+		return exec.Command("clip"), nil
+	default:
+		candidates := [][]string{
+			{"wl-copy"},
+			{"xclip", "-selection", "clipboard"},
+			{"xsel", "--input", "--clipboard"},
+		}
+		for _, candidate := range candidates {
+			if exe, err := lookPath(candidate[0]); err == nil {
+				return exec.Command(exe, candidate[1:]...), nil
+			}
+		}
+		return nil, errors.New("no clipboard tool found; install wl-copy, xclip, or xsel")
+	}
+}
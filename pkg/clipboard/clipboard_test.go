@@ -0,0 +1,55 @@
+package clipboard
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestForOS(t *testing.T) {
+	cmd, err := ForOS("darwin")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cmd.Args[0] != "pbcopy" {
+		t.Errorf("got args %v", cmd.Args)
+	}
+
+	cmd, err = ForOS("windows")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cmd.Args[0] != "clip" {
+		t.Errorf("got args %v", cmd.Args)
+	}
+}
+
+func TestForOS_linux(t *testing.T) {
+	origLookPath := lookPath
+	defer func() {
+		lookPath = origLookPath
+	}()
+
+	lookPath = func(file string) (string, error) {
+		if file == "xclip" {
+			return "/usr/bin/xclip", nil
+		}
+		return "", errors.New("not found")
+	}
+
+	cmd, err := ForOS("linux")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cmd.Args[0] != "/usr/bin/xclip" || cmd.Args[1] != "-selection" || cmd.Args[2] != "clipboard" {
+		t.Errorf("got args %v", cmd.Args)
+	}
+
+	lookPath = func(file string) (string, error) {
+		return "", errors.New("not found")
+	}
+
+	_, err = ForOS("linux")
+	if err == nil || err.Error() != "no clipboard tool found; install wl-copy, xclip, or xsel" {
+		t.Errorf("got error %v", err)
+	}
+}
@@ -277,6 +277,13 @@ func processResponse(resp *http.Response, opts *ApiOptions, headersOutputStream
 
 	if serverError != "" {
 		fmt.Fprintf(opts.IO.ErrOut, "gh: %s\n", serverError)
+		if opts.RequestPath == "graphql" && unknownFieldRE.MatchString(serverError) {
+			if client, clientErr := opts.HttpClient(); clientErr == nil {
+				for _, hint := range fieldSuggestions(client, ghinstance.OverridableDefault(), serverError) {
+					fmt.Fprintf(opts.IO.ErrOut, "hint: %s\n", hint)
+				}
+			}
+		}
 		err = cmdutil.SilentError
 		return
 	} else if resp.StatusCode > 299 {
@@ -0,0 +1,178 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/cli/cli/internal/config"
+)
+
+// how long cached schema information for a host stays fresh
+const schemaCacheTTL = 24 * time.Hour
+
+var unknownFieldRE = regexp.MustCompile(`Field '([^']+)' doesn't exist on type '([^']+)'`)
+
+// schemaCacheFile names the file where field names introspected from a host's
+// GraphQL schema are cached between invocations. It is a variable so tests
+// can redirect the cache to a temporary directory.
+var schemaCacheFile = func(hostname string) string {
+	return filepath.Join(config.ConfigDir(), fmt.Sprintf("schema-cache-%s.json", hostname))
+}
+
+type schemaCacheEntry struct {
+	FetchedAt time.Time `json:"fetched_at"`
+	Fields    []string  `json:"fields"`
+}
+
+// fieldSuggestions scans GraphQL error messages for unknown-field errors and
+// returns a hint for each one naming the closest field that does exist on the
+// type, based on the host's schema
+func fieldSuggestions(client *http.Client, hostname string, serverError string) []string {
+	var hints []string
+	for _, match := range unknownFieldRE.FindAllStringSubmatch(serverError, -1) {
+		badField, typeName := match[1], match[2]
+		fields, err := typeFields(client, hostname, typeName)
+		if err != nil {
+			continue
+		}
+		if suggestion := closestField(badField, fields); suggestion != "" {
+			hints = append(hints, fmt.Sprintf("type '%s' has no field '%s'; did you mean '%s'?", typeName, badField, suggestion))
+		}
+	}
+	return hints
+}
+
+// typeFields returns the names of the fields of a GraphQL type, preferring
+// the on-disk cache for the host and falling back to schema introspection
+func typeFields(client *http.Client, hostname string, typeName string) ([]string, error) {
+	cache := loadSchemaCache(hostname)
+	if entry, ok := cache[typeName]; ok && time.Since(entry.FetchedAt) < schemaCacheTTL {
+		return entry.Fields, nil
+	}
+
+	fields, err := introspectTypeFields(client, hostname, typeName)
+	if err != nil {
+		return nil, err
+	}
+
+	cache[typeName] = schemaCacheEntry{FetchedAt: time.Now(), Fields: fields}
+	saveSchemaCache(hostname, cache)
+	return fields, nil
+}
+
+func introspectTypeFields(client *http.Client, hostname string, typeName string) ([]string, error) {
+	params := map[string]interface{}{
+		"query": `query TypeFields($name: String!) { __type(name: $name) { fields { name } } }`,
+		"name":  typeName,
+	}
+
+	resp, err := httpRequest(client, hostname, "POST", "graphql", params, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode > 299 {
+		return nil, fmt.Errorf("HTTP %d introspecting schema", resp.StatusCode)
+	}
+
+	var response struct {
+		Data struct {
+			Type struct {
+				Fields []struct {
+					Name string
+				}
+			} `json:"__type"`
+		}
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return nil, err
+	}
+
+	fields := make([]string, 0, len(response.Data.Type.Fields))
+	for _, f := range response.Data.Type.Fields {
+		fields = append(fields, f.Name)
+	}
+	return fields, nil
+}
+
+func loadSchemaCache(hostname string) map[string]schemaCacheEntry {
+	cache := map[string]schemaCacheEntry{}
+	data, err := ioutil.ReadFile(schemaCacheFile(hostname))
+	if err != nil {
+		return cache
+	}
+	_ = json.Unmarshal(data, &cache)
+	return cache
+}
+
+// saveSchemaCache writes the cache back to disk; failing to do so only costs
+// an extra introspection request next time, so errors are ignored
+func saveSchemaCache(hostname string, cache map[string]schemaCacheEntry) {
+	data, err := json.Marshal(cache)
+	if err != nil {
+		return
+	}
+	_ = os.MkdirAll(filepath.Dir(schemaCacheFile(hostname)), 0771)
+	_ = ioutil.WriteFile(schemaCacheFile(hostname), data, 0600)
+}
+
+// closestField returns the field whose name is most similar to the unknown
+// one, or the empty string when nothing is close enough to suggest
+func closestField(badField string, fields []string) string {
+	best := ""
+	bestDistance := 0
+	for _, field := range fields {
+		distance := levenshtein(strings.ToLower(badField), strings.ToLower(field))
+		if best == "" || distance < bestDistance {
+			best = field
+			bestDistance = distance
+		}
+	}
+	maxDistance := len(badField) / 2
+	if maxDistance < 2 {
+		maxDistance = 2
+	}
+	if bestDistance > maxDistance {
+		return ""
+	}
+	return best
+}
+
+func levenshtein(a, b string) int {
+	ar, br := []rune(a), []rune(b)
+	row := make([]int, len(br)+1)
+	for j := range row {
+		row[j] = j
+	}
+	for i := 1; i <= len(ar); i++ {
+		previous := row[0]
+		row[0] = i
+		for j := 1; j <= len(br); j++ {
+			current := row[j]
+			cost := 1
+			if ar[i-1] == br[j-1] {
+				cost = 0
+			}
+			row[j] = min3(row[j]+1, row[j-1]+1, previous+cost)
+			previous = current
+		}
+	}
+	return row[len(br)]
+}
+
+func min3(a, b, c int) int {
+	if b < a {
+		a = b
+	}
+	if c < a {
+		a = c
+	}
+	return a
+}
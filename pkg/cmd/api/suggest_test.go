@@ -0,0 +1,69 @@
+package api
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cli/cli/pkg/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_closestField(t *testing.T) {
+	fields := []string{"issues", "pullRequests", "labels", "nameWithOwner"}
+
+	tests := []struct {
+		badField string
+		want     string
+	}{
+		{"issuse", "issues"},
+		{"pullrequests", "pullRequests"},
+		{"label", "labels"},
+		{"zzzzzz", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.badField, func(t *testing.T) {
+			assert.Equal(t, tt.want, closestField(tt.badField, fields))
+		})
+	}
+}
+
+func Test_fieldSuggestions(t *testing.T) {
+	cacheDir, err := ioutil.TempDir("", "schema-cache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(cacheDir)
+	origCacheFile := schemaCacheFile
+	schemaCacheFile = func(hostname string) string {
+		return filepath.Join(cacheDir, fmt.Sprintf("schema-cache-%s.json", hostname))
+	}
+	defer func() {
+		schemaCacheFile = origCacheFile
+	}()
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.GraphQL(`query TypeFields\b`),
+		httpmock.StringResponse(`
+		{ "data": { "__type": { "fields": [
+			{"name": "issues"},
+			{"name": "pullRequests"},
+			{"name": "labels"}
+		] } } }`))
+
+	client := &http.Client{Transport: reg}
+	serverError := `Field 'issuse' doesn't exist on type 'Repository'`
+
+	hints := fieldSuggestions(client, "github.com", serverError)
+	assert.Equal(t, []string{`type 'Repository' has no field 'issuse'; did you mean 'issues'?`}, hints)
+
+	// a second lookup is answered from the on-disk cache without another
+	// introspection request
+	hints = fieldSuggestions(client, "github.com", serverError)
+	assert.Equal(t, []string{`type 'Repository' has no field 'issuse'; did you mean 'issues'?`}, hints)
+}
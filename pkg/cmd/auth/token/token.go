@@ -0,0 +1,267 @@
+package token
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/internal/config"
+	"github.com/cli/cli/internal/ghinstance"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/cli/cli/utils"
+	"github.com/spf13/cobra"
+)
+
+type TokenOptions struct {
+	IO         *iostreams.IOStreams
+	Config     func() (config.Config, error)
+	HttpClient func() (*http.Client, error)
+
+	Hostname string
+	Scopes   []string
+	TTL      time.Duration
+	TTLSet   bool
+}
+
+// scopePermissions maps the OAuth-style scopes this command accepts to the
+// installation token permissions that implement them
+var scopePermissions = map[string]map[string]string{
+	"repo":          {"contents": "write", "issues": "write", "pull_requests": "write", "metadata": "read"},
+	"read:repo":     {"contents": "read", "metadata": "read"},
+	"issues":        {"issues": "write", "metadata": "read"},
+	"pull_requests": {"pull_requests": "write", "metadata": "read"},
+	"workflow":      {"actions": "write", "metadata": "read"},
+}
+
+func NewCmdToken(f *cmdutil.Factory, runF func(*TokenOptions) error) *cobra.Command {
+	opts := &TokenOptions{
+		IO:     f.IOStreams,
+		Config: f.Config,
+		// requests are authenticated with a GitHub App JWT rather than the
+		// stored credential, so the plain client is used on purpose
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{}, nil
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "token",
+		Args:  cobra.ExactArgs(0),
+		Short: "Print a token for use by other tools",
+		Long: heredoc.Doc(`Print an authentication token for passing to subprocesses and CI steps.
+
+			Without flags, the stored credential is printed as-is.
+
+			With --scopes, a short-lived installation token limited to those scopes is
+			minted instead, so the primary credential never leaves gh. Minting requires
+			GitHub App credentials in the configuration: set the app_id,
+			app_private_key, and app_installation_id keys with 'gh config set'.
+			Installation tokens expire after at most one hour regardless of --ttl.
+		`),
+		Example: heredoc.Doc(`
+			$ export GITHUB_TOKEN=$(gh auth token --scopes repo --ttl 1h)
+			$ gh auth token --scopes read:repo --ttl 10m
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.TTLSet = cmd.Flags().Changed("ttl")
+			if opts.TTLSet {
+				if len(opts.Scopes) == 0 {
+					return &cmdutil.FlagError{Err: errors.New("--ttl requires --scopes")}
+				}
+				if opts.TTL <= 0 || opts.TTL > time.Hour {
+					return &cmdutil.FlagError{Err: errors.New("--ttl must be between 1 second and 1 hour")}
+				}
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return tokenRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Hostname, "hostname", "h", "", "The GitHub host the token is for")
+	cmd.Flags().StringSliceVarP(&opts.Scopes, "scopes", "s", nil, "Mint a short-lived token limited to these scopes")
+	cmd.Flags().DurationVar(&opts.TTL, "ttl", time.Hour, "Desired lifetime of a minted token; warns when the granted token lives longer")
+
+	return cmd
+}
+
+func tokenRun(opts *TokenOptions) error {
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	hostname := opts.Hostname
+	if hostname == "" {
+		hostname = ghinstance.OverridableDefault()
+	}
+
+	if len(opts.Scopes) == 0 {
+		token, err := cfg.Get(hostname, "oauth_token")
+		if err != nil || token == "" {
+			return fmt.Errorf("no oauth token found for %s; run 'gh auth login' to authenticate", hostname)
+		}
+		fmt.Fprintln(opts.IO.Out, token)
+		return nil
+	}
+
+	return mintScopedToken(opts, cfg, hostname)
+}
+
+func mintScopedToken(opts *TokenOptions, cfg config.Config, hostname string) error {
+	appID, _ := cfg.Get(hostname, "app_id")
+	keyPath, _ := cfg.Get(hostname, "app_private_key")
+	installationID, _ := cfg.Get(hostname, "app_installation_id")
+	if appID == "" || keyPath == "" || installationID == "" {
+		return errors.New(heredoc.Doc(`
+			minting scoped tokens requires GitHub App credentials
+			Set the app_id, app_private_key, and app_installation_id config keys with 'gh config set'`))
+	}
+
+	permissions, err := permissionsForScopes(opts.Scopes)
+	if err != nil {
+		return err
+	}
+
+	key, err := loadPrivateKey(keyPath)
+	if err != nil {
+		return fmt.Errorf("could not read the app private key: %w", err)
+	}
+	jwt, err := appJWT(appID, key, time.Now())
+	if err != nil {
+		return fmt.Errorf("could not sign the app token: %w", err)
+	}
+
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	requestBody, err := json.Marshal(map[string]interface{}{"permissions": permissions})
+	if err != nil {
+		return err
+	}
+	url := fmt.Sprintf("%sapp/installations/%s/access_tokens", ghinstance.RESTPrefix(hostname), installationID)
+	req, err := http.NewRequest("POST", url, bytes.NewReader(requestBody))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", jwt))
+	req.Header.Set("Accept", "application/vnd.github.v3+json")
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode > 299 {
+		return fmt.Errorf("could not mint a token (HTTP %d)", resp.StatusCode)
+	}
+
+	var result struct {
+		Token     string    `json:"token"`
+		ExpiresAt time.Time `json:"expires_at"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return err
+	}
+
+	fmt.Fprintln(opts.IO.Out, result.Token)
+	if opts.IO.IsStderrTTY() {
+		fmt.Fprintf(opts.IO.ErrOut, "%s Minted a token scoped to %s; it expires at %s\n",
+			utils.GreenCheck(), strings.Join(opts.Scopes, ", "), result.ExpiresAt.Format(time.RFC3339))
+	}
+
+	// the installation-token API cannot issue shorter-lived tokens, so a TTL
+	// below the granted lifetime must not pass silently
+	if opts.TTLSet {
+		if granted := time.Until(result.ExpiresAt); granted-opts.TTL > time.Minute {
+			fmt.Fprintf(opts.IO.ErrOut, "%s Requested a %s lifetime, but the API cannot issue shorter-lived tokens; this token stays valid until %s\n",
+				utils.Yellow("!"), opts.TTL, result.ExpiresAt.Format(time.RFC3339))
+		}
+	}
+	return nil
+}
+
+func permissionsForScopes(scopes []string) (map[string]string, error) {
+	permissions := map[string]string{}
+	for _, scope := range scopes {
+		scopePerms, ok := scopePermissions[scope]
+		if !ok {
+			supported := make([]string, 0, len(scopePermissions))
+			for name := range scopePermissions {
+				supported = append(supported, name)
+			}
+			sort.Strings(supported)
+			return nil, fmt.Errorf("unsupported scope %q; supported scopes: %s", scope, strings.Join(supported, ", "))
+		}
+		for name, level := range scopePerms {
+			// write wins when scopes overlap
+			if permissions[name] != "write" {
+				permissions[name] = level
+			}
+		}
+	}
+	return permissions, nil
+}
+
+func loadPrivateKey(path string) (*rsa.PrivateKey, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM data found in %s", path)
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	key, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("the private key is not an RSA key")
+	}
+	return key, nil
+}
+
+// appJWT builds the signed RS256 token a GitHub App uses to authenticate as
+// itself when requesting installation tokens
+func appJWT(appID string, key *rsa.PrivateKey, now time.Time) (string, error) {
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"RS256","typ":"JWT"}`))
+	// issued-at is backdated a minute to allow for clock drift
+	claims := fmt.Sprintf(`{"iat":%d,"exp":%d,"iss":%q}`,
+		now.Add(-time.Minute).Unix(), now.Add(10*time.Minute).Unix(), appID)
+	payload := base64.RawURLEncoding.EncodeToString([]byte(claims))
+
+	signingInput := header + "." + payload
+	digest := sha256.Sum256([]byte(signingInput))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", err
+	}
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
@@ -0,0 +1,202 @@
+package token
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cli/cli/internal/config"
+	"github.com/cli/cli/pkg/httpmock"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+func writeTestPrivateKey(t *testing.T) string {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pemData := pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(key),
+	})
+	dir, err := ioutil.TempDir("", "gh-cli")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	keyPath := filepath.Join(dir, "app.pem")
+	if err := ioutil.WriteFile(keyPath, pemData, 0600); err != nil {
+		t.Fatal(err)
+	}
+	return keyPath
+}
+
+func TestTokenRun_storedToken(t *testing.T) {
+	io, _, stdout, _ := iostreams.Test()
+
+	opts := &TokenOptions{
+		IO: io,
+		Config: func() (config.Config, error) {
+			return config.NewFromString(`hosts:
+  github.com:
+    oauth_token: OTOKEN
+`), nil
+		},
+	}
+
+	err := tokenRun(opts)
+	assert.NoError(t, err)
+	assert.Equal(t, "OTOKEN\n", stdout.String())
+}
+
+func TestTokenRun_noToken(t *testing.T) {
+	io, _, _, _ := iostreams.Test()
+
+	opts := &TokenOptions{
+		IO: io,
+		Config: func() (config.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+	}
+
+	err := tokenRun(opts)
+	assert.EqualError(t, err, "no oauth token found for github.com; run 'gh auth login' to authenticate")
+}
+
+func TestTokenRun_mintScoped(t *testing.T) {
+	io, _, stdout, stderr := iostreams.Test()
+	io.SetStderrTTY(true)
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("POST", "app/installations/42/access_tokens"),
+		httpmock.StringResponse(`{
+			"token": "ghs_SCOPED",
+			"expires_at": "2020-09-22T15:04:05Z"
+		}`))
+
+	keyPath := writeTestPrivateKey(t)
+	opts := &TokenOptions{
+		IO: io,
+		Config: func() (config.Config, error) {
+			return config.NewFromString(fmt.Sprintf(`hosts:
+  github.com:
+    oauth_token: OTOKEN
+    app_id: "1234"
+    app_private_key: %s
+    app_installation_id: "42"
+`, keyPath)), nil
+		},
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Scopes: []string{"read:repo"},
+	}
+
+	err := tokenRun(opts)
+	assert.NoError(t, err)
+	assert.Equal(t, "ghs_SCOPED\n", stdout.String())
+	assert.Equal(t, "✓ Minted a token scoped to read:repo; it expires at 2020-09-22T15:04:05Z\n", stderr.String())
+
+	authorization := reg.Requests[0].Header.Get("Authorization")
+	if !strings.HasPrefix(authorization, "Bearer ") {
+		t.Errorf("expected a Bearer JWT, got %q", authorization)
+	}
+	if strings.Contains(authorization, "OTOKEN") {
+		t.Error("the stored credential leaked into the minting request")
+	}
+}
+
+func TestTokenRun_ttlNotGrantable(t *testing.T) {
+	io, _, stdout, stderr := iostreams.Test()
+
+	expiresAt := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("POST", "app/installations/42/access_tokens"),
+		httpmock.StringResponse(fmt.Sprintf(`{
+			"token": "ghs_SCOPED",
+			"expires_at": "%s"
+		}`, expiresAt.Format(time.RFC3339))))
+
+	keyPath := writeTestPrivateKey(t)
+	opts := &TokenOptions{
+		IO: io,
+		Config: func() (config.Config, error) {
+			return config.NewFromString(fmt.Sprintf(`hosts:
+  github.com:
+    app_id: "1234"
+    app_private_key: %s
+    app_installation_id: "42"
+`, keyPath)), nil
+		},
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Scopes: []string{"read:repo"},
+		TTL:    10 * time.Minute,
+		TTLSet: true,
+	}
+
+	err := tokenRun(opts)
+	assert.NoError(t, err)
+	assert.Equal(t, "ghs_SCOPED\n", stdout.String())
+	expectedWarning := fmt.Sprintf("! Requested a 10m0s lifetime, but the API cannot issue shorter-lived tokens; this token stays valid until %s\n",
+		expiresAt.Format(time.RFC3339))
+	assert.Equal(t, expectedWarning, stderr.String())
+}
+
+func TestTokenRun_missingAppConfig(t *testing.T) {
+	io, _, _, _ := iostreams.Test()
+
+	opts := &TokenOptions{
+		IO: io,
+		Config: func() (config.Config, error) {
+			return config.NewFromString(`hosts:
+  github.com:
+    oauth_token: OTOKEN
+`), nil
+		},
+		Scopes: []string{"repo"},
+	}
+
+	err := tokenRun(opts)
+	if err == nil || !strings.Contains(err.Error(), "requires GitHub App credentials") {
+		t.Errorf("expected app credentials error, got %v", err)
+	}
+}
+
+func TestTokenRun_unsupportedScope(t *testing.T) {
+	io, _, _, _ := iostreams.Test()
+
+	keyPath := writeTestPrivateKey(t)
+	opts := &TokenOptions{
+		IO: io,
+		Config: func() (config.Config, error) {
+			return config.NewFromString(fmt.Sprintf(`hosts:
+  github.com:
+    app_id: "1234"
+    app_private_key: %s
+    app_installation_id: "42"
+`, keyPath)), nil
+		},
+		Scopes: []string{"sudo"},
+	}
+
+	err := tokenRun(opts)
+	assert.EqualError(t, err, `unsupported scope "sudo"; supported scopes: issues, pull_requests, read:repo, repo, workflow`)
+}
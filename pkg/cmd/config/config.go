@@ -19,6 +19,8 @@ func NewCmdConfig(f *cmdutil.Factory) *cobra.Command {
 			- git_protocol: "https" or "ssh". Default is "https".
 			- editor: if unset, defaults to environment variables.
 			- prompt: "enabled" or "disabled". Toggles interactive prompting.
+			- clone_depth: if set, the default "--depth" for "gh repo clone".
+			- clone_filter: if set, the default "--filter" for "gh repo clone".
 		`),
 	}
 
@@ -6,11 +6,15 @@ import (
 	"net/http"
 	"os"
 
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/cli/cli/context"
 	"github.com/cli/cli/git"
 	"github.com/cli/cli/internal/config"
 	"github.com/cli/cli/internal/ghrepo"
 	"github.com/cli/cli/pkg/cmdutil"
 	"github.com/cli/cli/pkg/iostreams"
+	"github.com/cli/cli/pkg/prompt"
+	"github.com/cli/cli/utils"
 )
 
 func New(appVersion string) *cmdutil.Factory {
@@ -54,6 +58,16 @@ func New(appVersion string) *cmdutil.Factory {
 			if err != nil {
 				return nil, err
 			}
+			if len(remotes) > 1 {
+				for _, r := range remotes {
+					if r.Resolved == "base" {
+						return r, nil
+					}
+				}
+				if io.CanPrompt() {
+					return promptForDefaultRepo(io, remotes)
+				}
+			}
 			return remotes[0], nil
 		},
 		Branch: func() (string, error) {
@@ -65,3 +79,29 @@ func New(appVersion string) *cmdutil.Factory {
 		},
 	}
 }
+
+// promptForDefaultRepo asks which of several git remotes API operations should
+// target and records the choice so that future invocations skip the prompt.
+func promptForDefaultRepo(io *iostreams.IOStreams, remotes context.Remotes) (ghrepo.Interface, error) {
+	options := make([]string, len(remotes))
+	for i, r := range remotes {
+		options[i] = ghrepo.FullName(r)
+	}
+
+	var selected int
+	err := prompt.SurveyAskOne(&survey.Select{
+		Message: "Which repository should be the default for this directory?",
+		Options: options,
+	}, &selected)
+	if err != nil {
+		return nil, fmt.Errorf("could not prompt: %w", err)
+	}
+
+	if err := git.SetRemoteResolution(remotes[selected].Name, "base"); err != nil {
+		return nil, err
+	}
+	fmt.Fprintf(io.ErrOut, "%s Set %s as the default repository; to change, run `gh repo set-default`\n",
+		utils.GreenCheck(), ghrepo.FullName(remotes[selected]))
+
+	return remotes[selected], nil
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/cli/cli/api"
 	"github.com/cli/cli/internal/config"
 	"github.com/cli/cli/internal/ghinstance"
+	"github.com/cli/cli/internal/log"
 	"github.com/cli/cli/pkg/iostreams"
 )
 
@@ -19,9 +20,27 @@ func NewHTTPClient(io *iostreams.IOStreams, cfg config.Config, appVersion string
 		logTraffic := strings.Contains(verbose, "api")
 		opts = append(opts, api.VerboseLog(io.ErrOut, logTraffic, io.IsStderrTTY()))
 	}
+	opts = append(opts, api.LogRequests(log.Default))
 
 	opts = append(opts,
-		api.AddHeader("User-Agent", fmt.Sprintf("GitHub CLI %s", appVersion)),
+		api.AddHeaderFunc("User-Agent", func(req *http.Request) (string, error) {
+			userAgent := fmt.Sprintf("GitHub CLI %s", appVersion)
+			hostname := ghinstance.NormalizeHostname(req.URL.Hostname())
+			// hosts behind API gateways may require identifying the install,
+			// e.g. "user_agent_suffix: ACME-automation"
+			if suffix, err := cfg.Get(hostname, "user_agent_suffix"); err == nil && suffix != "" {
+				userAgent = fmt.Sprintf("%s %s", userAgent, suffix)
+			}
+			return userAgent, nil
+		}),
+		api.AddHeadersFunc(func(req *http.Request) (map[string]string, error) {
+			hostname := ghinstance.NormalizeHostname(req.URL.Hostname())
+			raw, err := cfg.Get(hostname, "api_headers")
+			if err != nil || raw == "" {
+				return nil, nil
+			}
+			return parseExtraHeaders(raw), nil
+		}),
 		api.AddHeaderFunc("Authorization", func(req *http.Request) (string, error) {
 			hostname := ghinstance.NormalizeHostname(req.URL.Hostname())
 			if token, err := cfg.Get(hostname, "oauth_token"); err == nil && token != "" {
@@ -47,3 +66,25 @@ func NewHTTPClient(io *iostreams.IOStreams, cfg config.Config, appVersion string
 
 	return api.NewHTTPClient(opts...)
 }
+
+// parseExtraHeaders interprets the "api_headers" config value for a host: a
+// list of "Name: value" pairs separated by semicolons or newlines, e.g.
+// "X-Trace-Id: abc123; X-Audit-User: hubot"
+func parseExtraHeaders(raw string) map[string]string {
+	headers := map[string]string{}
+	entries := strings.FieldsFunc(raw, func(r rune) bool {
+		return r == ';' || r == '\n'
+	})
+	for _, entry := range entries {
+		parts := strings.SplitN(entry, ":", 2)
+		if len(parts) < 2 {
+			continue
+		}
+		name := strings.TrimSpace(parts[0])
+		value := strings.TrimSpace(parts[1])
+		if name != "" && value != "" {
+			headers[name] = value
+		}
+	}
+	return headers
+}
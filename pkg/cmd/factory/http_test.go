@@ -0,0 +1,46 @@
+package factory
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_parseExtraHeaders(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+		want map[string]string
+	}{
+		{
+			name: "single header",
+			raw:  "X-Trace-Id: abc123",
+			want: map[string]string{"X-Trace-Id": "abc123"},
+		},
+		{
+			name: "semicolon separated",
+			raw:  "X-Trace-Id: abc123; X-Audit-User: hubot",
+			want: map[string]string{"X-Trace-Id": "abc123", "X-Audit-User": "hubot"},
+		},
+		{
+			name: "newline separated",
+			raw:  "X-Trace-Id: abc123\nX-Audit-User: hubot",
+			want: map[string]string{"X-Trace-Id": "abc123", "X-Audit-User": "hubot"},
+		},
+		{
+			name: "malformed entries skipped",
+			raw:  "not-a-header; : blank name; X-Ok: yes",
+			want: map[string]string{"X-Ok": "yes"},
+		},
+		{
+			name: "blank",
+			raw:  "",
+			want: map[string]string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, parseExtraHeaders(tt.raw))
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package fields
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/cli/cli/utils"
+	"github.com/spf13/cobra"
+)
+
+type FieldsOptions struct {
+	IO *iostreams.IOStreams
+
+	CommandPath string
+	Fields      []cmdutil.JSONField
+}
+
+func NewCmdFields(f *cmdutil.Factory, runF func(*FieldsOptions) error) *cobra.Command {
+	opts := &FieldsOptions{
+		IO: f.IOStreams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "fields <command>",
+		Short: "List the JSON fields a command can output",
+		Long: heredoc.Doc(`
+			List the fields a command's --json flag accepts, along with their types
+			and example values.
+
+			The listing comes from the same definitions that power --json, so it is
+			always in sync with what the command accepts.
+		`),
+		Example: heredoc.Doc(`
+			$ gh fields pr list
+			$ gh fields run artifacts
+		`),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			target := cmd.Root()
+			for _, name := range args {
+				next := findSubcommand(target, name)
+				if next == nil {
+					return fmt.Errorf("unknown command: %s", strings.Join(args, " "))
+				}
+				target = next
+			}
+
+			opts.CommandPath = target.CommandPath()
+			opts.Fields = cmdutil.JSONFieldsForCommand(target)
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return fieldsRun(opts)
+		},
+	}
+
+	return cmd
+}
+
+func findSubcommand(cmd *cobra.Command, name string) *cobra.Command {
+	for _, c := range cmd.Commands() {
+		if c.Name() == name || c.HasAlias(name) {
+			return c
+		}
+	}
+	return nil
+}
+
+func fieldsRun(opts *FieldsOptions) error {
+	if len(opts.Fields) == 0 {
+		return fmt.Errorf("%s does not support --json output", opts.CommandPath)
+	}
+
+	table := utils.NewTablePrinter(opts.IO)
+	for _, field := range opts.Fields {
+		table.AddField(field.Name, nil, nil)
+		table.AddField(field.Type, nil, utils.Gray)
+		table.AddField(field.Example, nil, nil)
+		table.EndRow()
+	}
+	return table.Render()
+}
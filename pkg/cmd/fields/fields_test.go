@@ -0,0 +1,69 @@
+package fields
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+func runCommand(args []string) (*bytes.Buffer, error) {
+	io, _, stdout, _ := iostreams.Test()
+	factory := &cmdutil.Factory{
+		IOStreams: io,
+	}
+
+	root := &cobra.Command{Use: "gh"}
+	root.SilenceErrors = true
+	root.SilenceUsage = true
+
+	frobGroup := &cobra.Command{Use: "frob"}
+	frobList := &cobra.Command{
+		Use:  "list",
+		RunE: func(*cobra.Command, []string) error { return nil },
+	}
+	var exporter *cmdutil.Exporter
+	cmdutil.AddJSONFlags(frobList, &exporter, []cmdutil.JSONField{
+		{Name: "number", Type: "number", Example: "123"},
+		{Name: "title", Type: "string", Example: `"A title"`},
+	})
+	frobGroup.AddCommand(frobList)
+	frobGroup.AddCommand(&cobra.Command{
+		Use:  "view",
+		RunE: func(*cobra.Command, []string) error { return nil },
+	})
+	root.AddCommand(frobGroup)
+	root.AddCommand(NewCmdFields(factory, nil))
+
+	root.SetArgs(append([]string{"fields"}, args...))
+	_, err := root.ExecuteC()
+	return stdout, err
+}
+
+func TestFields(t *testing.T) {
+	stdout, err := runCommand([]string{"frob", "list"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := "number\tnumber\t123\ntitle\tstring\t\"A title\"\n"
+	if got := stdout.String(); got != expected {
+		t.Errorf("expected output %q, got %q", expected, got)
+	}
+}
+
+func TestFields_noJSONSupport(t *testing.T) {
+	_, err := runCommand([]string{"frob", "view"})
+	if err == nil || err.Error() != "gh frob view does not support --json output" {
+		t.Errorf("expected JSON support error, got %v", err)
+	}
+}
+
+func TestFields_unknownCommand(t *testing.T) {
+	_, err := runCommand([]string{"frob", "nonexistent"})
+	if err == nil || err.Error() != "unknown command: frob nonexistent" {
+		t.Errorf("expected unknown command error, got %v", err)
+	}
+}
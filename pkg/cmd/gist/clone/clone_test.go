@@ -0,0 +1,115 @@
+package clone
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cli/cli/internal/config"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/cli/cli/test"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func runCloneCommand(cfg config.Config, cli string) (*test.CmdOut, error) {
+	io, stdin, stdout, stderr := iostreams.Test()
+	fac := &cmdutil.Factory{
+		IOStreams: io,
+		Config: func() (config.Config, error) {
+			return cfg, nil
+		},
+	}
+
+	cmd := NewCmdClone(fac, nil)
+
+	argv, err := shlex.Split(cli)
+	cmd.SetArgs(argv)
+
+	cmd.SetIn(stdin)
+	cmd.SetOut(stdout)
+	cmd.SetErr(stderr)
+
+	if err != nil {
+		panic(err)
+	}
+
+	_, err = cmd.ExecuteC()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &test.CmdOut{OutBuf: stdout, ErrBuf: stderr}, nil
+}
+
+func Test_GistClone(t *testing.T) {
+	tests := []struct {
+		name string
+		args string
+		cfg  string
+		want string
+	}{
+		{
+			name: "shorthand",
+			args: "GIST",
+			want: "git clone https://gist.github.com/GIST.git",
+		},
+		{
+			name: "shorthand with directory",
+			args: "GIST target_directory",
+			want: "git clone https://gist.github.com/GIST.git target_directory",
+		},
+		{
+			name: "clone arguments",
+			args: "GIST -- -o upstream --depth 1",
+			want: "git clone -o upstream --depth 1 https://gist.github.com/GIST.git",
+		},
+		{
+			name: "ssh protocol",
+			args: "GIST",
+			cfg:  "git_protocol: ssh",
+			want: "git clone git@gist.github.com:GIST.git",
+		},
+		{
+			name: "HTTPS URL",
+			args: "https://gist.github.com/OWNER/GIST",
+			want: "git clone https://gist.github.com/OWNER/GIST",
+		},
+		{
+			name: "SSH URL",
+			args: "git@gist.github.com:GIST.git",
+			want: "git clone git@gist.github.com:GIST.git",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.NewBlankConfig()
+			if tt.cfg != "" {
+				cfg = config.NewFromString(tt.cfg)
+			}
+
+			cs, restore := test.InitCmdStubber()
+			defer restore()
+
+			cs.Stub("") // git clone
+
+			output, err := runCloneCommand(cfg, tt.args)
+			if err != nil {
+				t.Fatalf("error running command `gist clone`: %v", err)
+			}
+
+			assert.Equal(t, "", output.String())
+			assert.Equal(t, "", output.Stderr())
+			assert.Equal(t, 1, cs.Count)
+			assert.Equal(t, tt.want, strings.Join(cs.Calls[0].Args, " "))
+		})
+	}
+}
+
+func Test_GistClone_flagError(t *testing.T) {
+	_, err := runCloneCommand(config.NewBlankConfig(), "--depth 1 GIST")
+	if err == nil || err.Error() != "unknown flag: --depth\nSeparate git clone flags with '--'." {
+		t.Errorf("unexpected error %v", err)
+	}
+}
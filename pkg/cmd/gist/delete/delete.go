@@ -0,0 +1,101 @@
+package delete
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/internal/ghinstance"
+	"github.com/cli/cli/pkg/cmd/gist/shared"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/cli/cli/pkg/prompt"
+	"github.com/spf13/cobra"
+)
+
+type DeleteOptions struct {
+	IO         *iostreams.IOStreams
+	HttpClient func() (*http.Client, error)
+
+	Selector    string
+	SkipConfirm bool
+}
+
+func NewCmdDelete(f *cmdutil.Factory, runF func(*DeleteOptions) error) *cobra.Command {
+	opts := DeleteOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "delete {<id> | <url>}",
+		Short: "Delete a gist",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(c *cobra.Command, args []string) error {
+			opts.Selector = args[0]
+
+			if !opts.SkipConfirm && !opts.IO.CanPrompt() {
+				return &cmdutil.FlagError{Err: errors.New("could not prompt: confirmation with prompt or --yes flag required")}
+			}
+
+			if runF != nil {
+				return runF(&opts)
+			}
+			return deleteRun(&opts)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&opts.SkipConfirm, "yes", "y", false, "Skip the confirmation prompt")
+
+	return cmd
+}
+
+func deleteRun(opts *DeleteOptions) error {
+	gistID := opts.Selector
+	if strings.Contains(gistID, "/") {
+		id, err := shared.GistIDFromURL(gistID)
+		if err != nil {
+			return err
+		}
+		gistID = id
+	}
+
+	client, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	hostname := ghinstance.OverridableDefault()
+
+	gist, err := shared.GetGist(client, hostname, gistID)
+	if err != nil {
+		return err
+	}
+
+	if !opts.SkipConfirm {
+		var confirmed bool
+		err := prompt.Confirm(fmt.Sprintf("Delete gist %s?", gistID), &confirmed)
+		if err != nil {
+			return fmt.Errorf("could not prompt: %w", err)
+		}
+
+		if !confirmed {
+			return cmdutil.SilentError
+		}
+	}
+
+	apiClient := api.NewClientFromHTTP(client)
+	err = apiClient.REST(hostname, "DELETE", "gists/"+gist.ID, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		iofmt := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.ErrOut, "%s Deleted gist %s\n", iofmt.SuccessIcon(), gistID)
+	}
+
+	return nil
+}
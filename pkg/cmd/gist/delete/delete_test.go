@@ -0,0 +1,167 @@
+package delete
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/httpmock"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/cli/cli/pkg/prompt"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCmdDelete(t *testing.T) {
+	tests := []struct {
+		name    string
+		cli     string
+		isTTY   bool
+		want    DeleteOptions
+		wantErr string
+	}{
+		{
+			name:  "id argument",
+			cli:   "1234",
+			isTTY: true,
+			want: DeleteOptions{
+				Selector:    "1234",
+				SkipConfirm: false,
+			},
+		},
+		{
+			name:  "skip confirm",
+			cli:   "1234 -y",
+			isTTY: true,
+			want: DeleteOptions{
+				Selector:    "1234",
+				SkipConfirm: true,
+			},
+		},
+		{
+			name:    "no arguments",
+			cli:     "",
+			isTTY:   true,
+			wantErr: "accepts 1 arg(s), received 0",
+		},
+		{
+			name:    "non-interactive without yes",
+			cli:     "1234",
+			isTTY:   false,
+			wantErr: "could not prompt: confirmation with prompt or --yes flag required",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, _, _ := iostreams.Test()
+			io.SetStdoutTTY(tt.isTTY)
+			io.SetStdinTTY(tt.isTTY)
+			io.SetStderrTTY(tt.isTTY)
+
+			f := &cmdutil.Factory{
+				IOStreams: io,
+			}
+
+			var opts *DeleteOptions
+			cmd := NewCmdDelete(f, func(o *DeleteOptions) error {
+				opts = o
+				return nil
+			})
+
+			argv, err := shlex.Split(tt.cli)
+			require.NoError(t, err)
+			cmd.SetArgs(argv)
+
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(ioutil.Discard)
+			cmd.SetErr(ioutil.Discard)
+
+			_, err = cmd.ExecuteC()
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.want.Selector, opts.Selector)
+			assert.Equal(t, tt.want.SkipConfirm, opts.SkipConfirm)
+		})
+	}
+}
+
+func Test_deleteRun(t *testing.T) {
+	tests := []struct {
+		name       string
+		opts       DeleteOptions
+		confirm    bool
+		wantErr    string
+		wantStderr string
+		wantDelete bool
+	}{
+		{
+			name: "confirmed",
+			opts: DeleteOptions{
+				Selector: "1234",
+			},
+			confirm:    true,
+			wantStderr: "✓ Deleted gist 1234\n",
+			wantDelete: true,
+		},
+		{
+			name: "declined",
+			opts: DeleteOptions{
+				Selector: "1234",
+			},
+			confirm: false,
+			wantErr: "SilentError",
+		},
+		{
+			name: "by URL, skipping confirmation",
+			opts: DeleteOptions{
+				Selector:    "https://gist.github.com/OWNER/1234",
+				SkipConfirm: true,
+			},
+			wantStderr: "✓ Deleted gist 1234\n",
+			wantDelete: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			reg.Register(httpmock.REST("GET", "gists/1234"), httpmock.StringResponse(`{ "id": "1234" }`))
+			reg.Register(httpmock.REST("DELETE", "gists/1234"), httpmock.StatusStringResponse(204, ""))
+
+			defer prompt.StubConfirm(tt.confirm)()
+
+			io, _, stdout, stderr := iostreams.Test()
+			io.SetStdoutTTY(true)
+			io.SetStdinTTY(true)
+			io.SetStderrTTY(true)
+
+			tt.opts.IO = io
+			tt.opts.HttpClient = func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			}
+
+			err := deleteRun(&tt.opts)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			lastRequest := reg.Requests[len(reg.Requests)-1]
+			if tt.wantDelete {
+				assert.Equal(t, "DELETE", lastRequest.Method)
+			} else {
+				assert.NotEqual(t, "DELETE", lastRequest.Method)
+			}
+
+			assert.Equal(t, "", stdout.String())
+			assert.Equal(t, tt.wantStderr, stderr.String())
+		})
+	}
+}
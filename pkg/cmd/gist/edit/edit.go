@@ -0,0 +1,245 @@
+package edit
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/internal/config"
+	"github.com/cli/cli/internal/ghinstance"
+	"github.com/cli/cli/pkg/cmd/gist/shared"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/cli/cli/pkg/prompt"
+	"github.com/cli/cli/pkg/surveyext"
+	"github.com/spf13/cobra"
+)
+
+type EditOptions struct {
+	IO         *iostreams.IOStreams
+	HttpClient func() (*http.Client, error)
+	Config     func() (config.Config, error)
+
+	Edit func(editorCmd, filename, defaultContent string, io *iostreams.IOStreams) (string, error)
+
+	Selector       string
+	EditFilename   string
+	AddFilename    string
+	RemoveFilename string
+}
+
+func NewCmdEdit(f *cmdutil.Factory, runF func(*EditOptions) error) *cobra.Command {
+	opts := EditOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		Config:     f.Config,
+		Edit: func(editorCmd, filename, defaultContent string, io *iostreams.IOStreams) (string, error) {
+			return surveyext.Edit(editorCmd, filename, defaultContent, io.In, io.Out, io.ErrOut, nil)
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "edit {<id> | <url>}",
+		Short: "Edit one of your gists",
+		Long: heredoc.Doc(`
+			Edit one of your gists.
+
+			A file is opened in your editor by default; when standard input is not a
+			terminal, its contents replace the file selected with '--filename' instead.
+		`),
+		Example: heredoc.Doc(`
+			# select a file to edit interactively
+			$ gh gist edit 1a2b3c
+
+			# replace the contents of a file from standard input
+			$ cat hello.py | gh gist edit 1a2b3c -f hello.py
+
+			# add a file to the gist
+			$ gh gist edit 1a2b3c --add cool.txt
+
+			# remove a file from the gist
+			$ gh gist edit 1a2b3c --remove hello.py
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(c *cobra.Command, args []string) error {
+			opts.Selector = args[0]
+
+			if runF != nil {
+				return runF(&opts)
+			}
+			return editRun(&opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.EditFilename, "filename", "f", "", "Select a file to edit")
+	cmd.Flags().StringVarP(&opts.AddFilename, "add", "a", "", "Add a new file to the gist")
+	cmd.Flags().StringVar(&opts.RemoveFilename, "remove", "", "Remove a file from the gist")
+
+	return cmd
+}
+
+func editRun(opts *EditOptions) error {
+	gistID := opts.Selector
+	if strings.Contains(gistID, "/") {
+		id, err := shared.GistIDFromURL(gistID)
+		if err != nil {
+			return err
+		}
+		gistID = id
+	}
+
+	client, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	hostname := ghinstance.OverridableDefault()
+
+	gist, err := shared.GetGist(client, hostname, gistID)
+	if err != nil {
+		return err
+	}
+
+	apiClient := api.NewClientFromHTTP(client)
+	username, err := api.CurrentLoginName(apiClient, hostname)
+	if err != nil {
+		return err
+	}
+
+	if gist.Owner != nil && username != gist.Owner.Login {
+		return fmt.Errorf("you do not own this gist")
+	}
+
+	shouldUpdate := false
+
+	if opts.RemoveFilename != "" {
+		if _, ok := gist.Files[opts.RemoveFilename]; !ok {
+			return fmt.Errorf("gist has no file %q", opts.RemoveFilename)
+		}
+		// a null entry in the payload removes the file from the gist
+		gist.Files[opts.RemoveFilename] = nil
+		shouldUpdate = true
+	}
+
+	if opts.AddFilename != "" {
+		filename, content, err := fileToAdd(opts.AddFilename)
+		if err != nil {
+			return err
+		}
+		gist.Files[filename] = &shared.GistFile{
+			Filename: filename,
+			Content:  content,
+		}
+		shouldUpdate = true
+	}
+
+	if opts.AddFilename == "" && opts.RemoveFilename == "" {
+		candidates := []string{}
+		for filename := range gist.Files {
+			candidates = append(candidates, filename)
+		}
+		sort.Strings(candidates)
+
+		filename := opts.EditFilename
+		if filename == "" {
+			switch len(candidates) {
+			case 0:
+				return errors.New("gist has no files")
+			case 1:
+				filename = candidates[0]
+			default:
+				if !opts.IO.CanPrompt() {
+					return errors.New("unsure what file to edit; pass the `--filename` flag")
+				}
+				err = prompt.SurveyAskOne(&survey.Select{
+					Message: "Edit which file?",
+					Options: candidates,
+				}, &filename)
+				if err != nil {
+					return fmt.Errorf("could not prompt: %w", err)
+				}
+			}
+		}
+
+		gistFile, ok := gist.Files[filename]
+		if !ok {
+			return fmt.Errorf("gist has no file %q", filename)
+		}
+
+		var text string
+		if opts.IO.IsStdinTTY() {
+			editorCommand, err := cmdutil.DetermineEditor(opts.Config)
+			if err != nil {
+				return err
+			}
+			text, err = opts.Edit(editorCommand, filename, gistFile.Content, opts.IO)
+			if err != nil {
+				return err
+			}
+		} else {
+			b, err := ioutil.ReadAll(opts.IO.In)
+			if err != nil {
+				return fmt.Errorf("failed to read from stdin: %w", err)
+			}
+			text = string(b)
+		}
+
+		if text != gistFile.Content {
+			gistFile.Content = text
+			gist.Files[filename] = gistFile
+			shouldUpdate = true
+		}
+	}
+
+	if !shouldUpdate {
+		return nil
+	}
+
+	return updateGist(client, hostname, gist)
+}
+
+func fileToAdd(file string) (string, string, error) {
+	content, err := ioutil.ReadFile(file)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to read file %s: %w", file, err)
+	}
+
+	if len(content) == 0 {
+		return "", "", errors.New("file contents cannot be empty")
+	}
+
+	return path.Base(file), string(content), nil
+}
+
+func updateGist(client *http.Client, hostname string, gist *shared.Gist) error {
+	body := struct {
+		Description string                      `json:"description"`
+		Files       map[string]*shared.GistFile `json:"files"`
+	}{
+		Description: gist.Description,
+		Files:       gist.Files,
+	}
+
+	path := "gists/" + gist.ID
+
+	requestByte, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	requestBody := bytes.NewReader(requestByte)
+
+	result := shared.Gist{}
+
+	apiClient := api.NewClientFromHTTP(client)
+	return apiClient.REST(hostname, "POST", path, requestBody, &result)
+}
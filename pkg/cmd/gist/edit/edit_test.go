@@ -0,0 +1,325 @@
+package edit
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com/cli/cli/internal/config"
+	"github.com/cli/cli/pkg/cmd/gist/shared"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/httpmock"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/cli/cli/pkg/prompt"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCmdEdit(t *testing.T) {
+	tests := []struct {
+		name    string
+		cli     string
+		want    EditOptions
+		wantErr string
+	}{
+		{
+			name: "no flags",
+			cli:  "1234",
+			want: EditOptions{
+				Selector: "1234",
+			},
+		},
+		{
+			name: "filename",
+			cli:  "1234 --filename cool.md",
+			want: EditOptions{
+				Selector:     "1234",
+				EditFilename: "cool.md",
+			},
+		},
+		{
+			name: "add file",
+			cli:  "1234 --add cool.md",
+			want: EditOptions{
+				Selector:    "1234",
+				AddFilename: "cool.md",
+			},
+		},
+		{
+			name: "remove file",
+			cli:  "1234 --remove cool.md",
+			want: EditOptions{
+				Selector:       "1234",
+				RemoveFilename: "cool.md",
+			},
+		},
+		{
+			name:    "no arguments",
+			cli:     "",
+			wantErr: "accepts 1 arg(s), received 0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &cmdutil.Factory{}
+
+			argv, err := shlex.Split(tt.cli)
+			require.NoError(t, err)
+
+			var gotOpts *EditOptions
+			cmd := NewCmdEdit(f, func(opts *EditOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(ioutil.Discard)
+			cmd.SetErr(ioutil.Discard)
+
+			_, err = cmd.ExecuteC()
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.want.Selector, gotOpts.Selector)
+			assert.Equal(t, tt.want.EditFilename, gotOpts.EditFilename)
+			assert.Equal(t, tt.want.AddFilename, gotOpts.AddFilename)
+			assert.Equal(t, tt.want.RemoveFilename, gotOpts.RemoveFilename)
+		})
+	}
+}
+
+func Test_editRun(t *testing.T) {
+	addedFile := filepath.Join(t.TempDir(), "cool.md")
+	require.NoError(t, ioutil.WriteFile(addedFile, []byte("so cool"), 0644))
+
+	gistJSON := `{
+		"id": "1234",
+		"description": "catbug",
+		"files": {
+			"cicada.txt": {
+				"filename": "cicada.txt",
+				"content": "bwhiizzzbwhuiiizzzz",
+				"type": "text/plain"
+			},
+			"unix.md": {
+				"filename": "unix.md",
+				"content": "meow",
+				"type": "application/markdown"
+			}
+		},
+		"owner": { "login": "octocat" }
+	}`
+
+	tests := []struct {
+		name      string
+		opts      EditOptions
+		stdin     string
+		stdinTTY  bool
+		askStubs  func(*prompt.AskStubber)
+		wantErr   string
+		wantFiles map[string]*shared.GistFile
+	}{
+		{
+			name: "edit the selected file",
+			opts: EditOptions{
+				EditFilename: "cicada.txt",
+			},
+			stdinTTY: true,
+			wantFiles: map[string]*shared.GistFile{
+				"cicada.txt": {
+					Filename: "cicada.txt",
+					Type:     "text/plain",
+					Content:  "new file content",
+				},
+				"unix.md": {
+					Filename: "unix.md",
+					Type:     "application/markdown",
+					Content:  "meow",
+				},
+			},
+		},
+		{
+			name: "prompt for file to edit",
+			opts: EditOptions{},
+			askStubs: func(as *prompt.AskStubber) {
+				as.StubOne("unix.md")
+			},
+			stdinTTY: true,
+			wantFiles: map[string]*shared.GistFile{
+				"cicada.txt": {
+					Filename: "cicada.txt",
+					Type:     "text/plain",
+					Content:  "bwhiizzzbwhuiiizzzz",
+				},
+				"unix.md": {
+					Filename: "unix.md",
+					Type:     "application/markdown",
+					Content:  "new file content",
+				},
+			},
+		},
+		{
+			name: "content from stdin",
+			opts: EditOptions{
+				EditFilename: "cicada.txt",
+			},
+			stdin: "piped content",
+			wantFiles: map[string]*shared.GistFile{
+				"cicada.txt": {
+					Filename: "cicada.txt",
+					Type:     "text/plain",
+					Content:  "piped content",
+				},
+				"unix.md": {
+					Filename: "unix.md",
+					Type:     "application/markdown",
+					Content:  "meow",
+				},
+			},
+		},
+		{
+			name: "add a file",
+			opts: EditOptions{
+				AddFilename: addedFile,
+			},
+			stdinTTY: true,
+			wantFiles: map[string]*shared.GistFile{
+				"cicada.txt": {
+					Filename: "cicada.txt",
+					Type:     "text/plain",
+					Content:  "bwhiizzzbwhuiiizzzz",
+				},
+				"unix.md": {
+					Filename: "unix.md",
+					Type:     "application/markdown",
+					Content:  "meow",
+				},
+				"cool.md": {
+					Filename: "cool.md",
+					Content:  "so cool",
+				},
+			},
+		},
+		{
+			name: "remove a file",
+			opts: EditOptions{
+				RemoveFilename: "unix.md",
+			},
+			stdinTTY: true,
+			wantFiles: map[string]*shared.GistFile{
+				"cicada.txt": {
+					Filename: "cicada.txt",
+					Type:     "text/plain",
+					Content:  "bwhiizzzbwhuiiizzzz",
+				},
+				"unix.md": nil,
+			},
+		},
+		{
+			name: "remove a missing file",
+			opts: EditOptions{
+				RemoveFilename: "nope.md",
+			},
+			stdinTTY: true,
+			wantErr:  `gist has no file "nope.md"`,
+		},
+		{
+			name: "edit a missing file",
+			opts: EditOptions{
+				EditFilename: "nope.md",
+			},
+			stdinTTY: true,
+			wantErr:  `gist has no file "nope.md"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			reg.Register(httpmock.REST("GET", "gists/1234"), httpmock.StringResponse(gistJSON))
+			reg.Register(httpmock.GraphQL(`query UserCurrent\b`), httpmock.StringResponse(`{"data":{"viewer":{"login":"octocat"}}}`))
+			reg.Register(httpmock.REST("POST", "gists/1234"), httpmock.StringResponse(`{}`))
+
+			as, teardown := prompt.InitAskStubber()
+			defer teardown()
+			if tt.askStubs != nil {
+				tt.askStubs(as)
+			}
+
+			io, stdin, _, _ := iostreams.Test()
+			io.SetStdoutTTY(true)
+			io.SetStdinTTY(tt.stdinTTY)
+			if tt.stdin != "" {
+				fmt.Fprint(stdin, tt.stdin)
+			}
+
+			tt.opts.IO = io
+			tt.opts.Selector = "1234"
+			tt.opts.HttpClient = func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			}
+			tt.opts.Config = func() (config.Config, error) {
+				return config.NewBlankConfig(), nil
+			}
+			tt.opts.Edit = func(_, _, _ string, _ *iostreams.IOStreams) (string, error) {
+				return "new file content", nil
+			}
+
+			err := editRun(&tt.opts)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			lastRequest := reg.Requests[len(reg.Requests)-1]
+			require.Equal(t, "POST", lastRequest.Method)
+
+			bb, err := ioutil.ReadAll(lastRequest.Body)
+			require.NoError(t, err)
+			var payload struct {
+				Description string
+				Files       map[string]*shared.GistFile
+			}
+			err = json.Unmarshal(bb, &payload)
+			require.NoError(t, err)
+			assert.Equal(t, "catbug", payload.Description)
+			assert.Equal(t, tt.wantFiles, payload.Files)
+		})
+	}
+}
+
+func Test_editRun_notOwned(t *testing.T) {
+	reg := &httpmock.Registry{}
+	reg.Register(httpmock.REST("GET", "gists/1234"), httpmock.StringResponse(`{
+		"id": "1234",
+		"files": { "cicada.txt": { "filename": "cicada.txt", "content": "meow" } },
+		"owner": { "login": "octocat2" }
+	}`))
+	reg.Register(httpmock.GraphQL(`query UserCurrent\b`), httpmock.StringResponse(`{"data":{"viewer":{"login":"octocat"}}}`))
+
+	io, _, _, _ := iostreams.Test()
+	io.SetStdinTTY(true)
+
+	opts := EditOptions{
+		IO:       io,
+		Selector: "1234",
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Config: func() (config.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+	}
+
+	err := editRun(&opts)
+	require.EqualError(t, err, "you do not own this gist")
+}
@@ -1,7 +1,10 @@
 package gist
 
 import (
+	gistCloneCmd "github.com/cli/cli/pkg/cmd/gist/clone"
 	gistCreateCmd "github.com/cli/cli/pkg/cmd/gist/create"
+	gistDeleteCmd "github.com/cli/cli/pkg/cmd/gist/delete"
+	gistEditCmd "github.com/cli/cli/pkg/cmd/gist/edit"
 	"github.com/cli/cli/pkg/cmdutil"
 	"github.com/spf13/cobra"
 )
@@ -9,11 +12,14 @@ import (
 func NewCmdGist(f *cmdutil.Factory) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "gist",
-		Short: "Create gists",
+		Short: "Manage gists",
 		Long:  `Work with GitHub gists.`,
 	}
 
+	cmd.AddCommand(gistCloneCmd.NewCmdClone(f, nil))
 	cmd.AddCommand(gistCreateCmd.NewCmdCreate(f, nil))
+	cmd.AddCommand(gistDeleteCmd.NewCmdDelete(f, nil))
+	cmd.AddCommand(gistEditCmd.NewCmdEdit(f, nil))
 
 	return cmd
 }
@@ -14,13 +14,21 @@ import (
 	"github.com/spf13/cobra"
 )
 
+var closeReasons = map[string]string{
+	"completed":   "COMPLETED",
+	"not-planned": "NOT_PLANNED",
+}
+
 type CloseOptions struct {
 	HttpClient func() (*http.Client, error)
 	Config     func() (config.Config, error)
 	IO         *iostreams.IOStreams
 	BaseRepo   func() (ghrepo.Interface, error)
 
-	SelectorArg string
+	Selectors []string
+	Search    string
+	Reason    string
+	Comment   string
 }
 
 func NewCmdClose(f *cmdutil.Factory, runF func(*CloseOptions) error) *cobra.Command {
@@ -31,15 +39,26 @@ func NewCmdClose(f *cmdutil.Factory, runF func(*CloseOptions) error) *cobra.Comm
 	}
 
 	cmd := &cobra.Command{
-		Use:   "close {<number> | <url>}",
+		Use:   "close {<number> | <url>}...",
 		Short: "Close issue",
-		Args:  cobra.ExactArgs(1),
+		Args:  cobra.ArbitraryArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// support `-R, --repo` override
 			opts.BaseRepo = f.BaseRepo
 
-			if len(args) > 0 {
-				opts.SelectorArg = args[0]
+			opts.Selectors = args
+
+			if len(args) == 0 && opts.Search == "" {
+				return &cmdutil.FlagError{Err: fmt.Errorf("specify issues by number or URL, or select them with --search")}
+			}
+			if len(args) > 0 && opts.Search != "" {
+				return &cmdutil.FlagError{Err: fmt.Errorf("--search cannot be combined with issue arguments")}
+			}
+
+			if opts.Reason != "" {
+				if _, ok := closeReasons[opts.Reason]; !ok {
+					return &cmdutil.FlagError{Err: fmt.Errorf("invalid reason %q: must be one of completed, not-planned", opts.Reason)}
+				}
 			}
 
 			if runF != nil {
@@ -49,6 +68,10 @@ func NewCmdClose(f *cmdutil.Factory, runF func(*CloseOptions) error) *cobra.Comm
 		},
 	}
 
+	cmd.Flags().StringVarP(&opts.Search, "search", "S", "", "Close every open issue matching a search `query`")
+	cmd.Flags().StringVarP(&opts.Reason, "reason", "r", "", "Reason for closing: {completed|not-planned}")
+	cmd.Flags().StringVarP(&opts.Comment, "comment", "c", "", "Leave a closing comment")
+
 	return cmd
 }
 
@@ -59,22 +82,57 @@ func closeRun(opts *CloseOptions) error {
 	}
 	apiClient := api.NewClientFromHTTP(httpClient)
 
-	issue, baseRepo, err := shared.IssueFromArg(apiClient, opts.BaseRepo, opts.SelectorArg)
+	matches, err := shared.FindIssues(apiClient, opts.BaseRepo, opts.Selectors, opts.Search, "open")
 	if err != nil {
 		return err
 	}
 
-	if issue.Closed {
-		fmt.Fprintf(opts.IO.ErrOut, "%s Issue #%d (%s) is already closed\n", utils.Yellow("!"), issue.Number, issue.Title)
+	if len(matches) == 0 {
+		fmt.Fprintf(opts.IO.ErrOut, "%s No issues matched the search\n", utils.Yellow("!"))
 		return nil
 	}
 
-	err = api.IssueClose(apiClient, baseRepo, *issue)
-	if err != nil {
-		return err
+	closed := 0
+	failed := 0
+	for _, match := range matches {
+		issue, baseRepo := match.Issue, match.Repo
+
+		if issue.Closed {
+			fmt.Fprintf(opts.IO.ErrOut, "%s Issue #%d (%s) is already closed\n", utils.Yellow("!"), issue.Number, issue.Title)
+			continue
+		}
+
+		err := closeIssue(apiClient, baseRepo, issue, opts)
+		if err != nil {
+			if len(matches) == 1 {
+				return err
+			}
+			fmt.Fprintf(opts.IO.ErrOut, "%s Failed to close issue #%d: %v\n", utils.Red("X"), issue.Number, err)
+			failed++
+			continue
+		}
+
+		closed++
+		fmt.Fprintf(opts.IO.ErrOut, "%s Closed issue #%d (%s)\n", utils.Red("✔"), issue.Number, issue.Title)
 	}
 
-	fmt.Fprintf(opts.IO.ErrOut, "%s Closed issue #%d (%s)\n", utils.Red("✔"), issue.Number, issue.Title)
+	if len(matches) > 1 {
+		fmt.Fprintf(opts.IO.ErrOut, "Closed %d of %d issues\n", closed, len(matches))
+	}
+	if failed > 0 {
+		return cmdutil.SilentError
+	}
 
 	return nil
 }
+
+func closeIssue(apiClient *api.Client, baseRepo ghrepo.Interface, issue *api.Issue, opts *CloseOptions) error {
+	if opts.Comment != "" {
+		err := api.CommentCreate(apiClient, baseRepo, issue.ID, opts.Comment)
+		if err != nil {
+			return err
+		}
+	}
+
+	return api.IssueClose(apiClient, baseRepo, *issue, closeReasons[opts.Reason])
+}
@@ -5,6 +5,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/cli/cli/internal/config"
@@ -117,3 +118,90 @@ func TestIssueClose_issuesDisabled(t *testing.T) {
 		t.Fatalf("got error: %v", err)
 	}
 }
+
+func TestIssueClose_withReasonAndComment(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.StubResponse(200, bytes.NewBufferString(`
+	{ "data": { "repository": {
+		"hasIssuesEnabled": true,
+		"issue": { "number": 13, "title": "The title of the issue"}
+	} } }
+	`))
+
+	http.StubResponse(200, bytes.NewBufferString(`{"data": {}}`))
+	http.StubResponse(200, bytes.NewBufferString(`{"data": {}}`))
+
+	output, err := runCommand(http, true, `13 --reason not-planned --comment "Stale; see #14"`)
+	if err != nil {
+		t.Fatalf("error running command `issue close`: %v", err)
+	}
+
+	commentBody, _ := ioutil.ReadAll(http.Requests[1].Body)
+	if !strings.Contains(string(commentBody), "Stale; see #14") {
+		t.Errorf("expected closing comment in request, got %s", string(commentBody))
+	}
+
+	closeBody, _ := ioutil.ReadAll(http.Requests[2].Body)
+	if !strings.Contains(string(closeBody), "NOT_PLANNED") {
+		t.Errorf("expected state reason NOT_PLANNED in request, got %s", string(closeBody))
+	}
+
+	r := regexp.MustCompile(`Closed issue #13 \(The title of the issue\)`)
+
+	if !r.MatchString(output.Stderr()) {
+		t.Fatalf("output did not match regexp /%s/\n> output\n%q\n", r, output.Stderr())
+	}
+}
+
+func TestIssueClose_invalidReason(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	_, err := runCommand(http, true, "13 --reason obsolete")
+	if err == nil || err.Error() != `invalid reason "obsolete": must be one of completed, not-planned` {
+		t.Fatalf("got error: %v", err)
+	}
+}
+
+func TestIssueClose_multiple(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.StubResponse(200, bytes.NewBufferString(`
+	{ "data": { "repository": {
+		"hasIssuesEnabled": true,
+		"issue": { "number": 13, "title": "The first issue"}
+	} } }
+	`))
+	http.StubResponse(200, bytes.NewBufferString(`
+	{ "data": { "repository": {
+		"hasIssuesEnabled": true,
+		"issue": { "number": 14, "title": "The second issue"}
+	} } }
+	`))
+
+	http.StubResponse(200, bytes.NewBufferString(`{"data": {}}`))
+	http.StubResponse(200, bytes.NewBufferString(`{"data": {}}`))
+
+	output, err := runCommand(http, true, "13 14")
+	if err != nil {
+		t.Fatalf("error running command `issue close`: %v", err)
+	}
+
+	test.ExpectLines(t, output.Stderr(),
+		`Closed issue #13 \(The first issue\)`,
+		`Closed issue #14 \(The second issue\)`,
+		`Closed 2 of 2 issues`)
+}
+
+func TestIssueClose_noSelectors(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	_, err := runCommand(http, true, "")
+	if err == nil || err.Error() != "specify issues by number or URL, or select them with --search" {
+		t.Fatalf("got error: %v", err)
+	}
+}
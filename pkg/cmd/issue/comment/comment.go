@@ -0,0 +1,213 @@
+package comment
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/internal/config"
+	"github.com/cli/cli/internal/ghrepo"
+	issueShared "github.com/cli/cli/pkg/cmd/issue/shared"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/cli/cli/pkg/prompt"
+	"github.com/cli/cli/pkg/surveyext"
+	"github.com/cli/cli/utils"
+	"github.com/spf13/cobra"
+)
+
+type CommentOptions struct {
+	HttpClient func() (*http.Client, error)
+	Config     func() (config.Config, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	SelectorArg string
+	Body        string
+	BodyFile    string
+	Editor      bool
+	WebMode     bool
+	EditLast    bool
+	Interactive bool
+}
+
+func NewCmdComment(f *cmdutil.Factory, runF func(*CommentOptions) error) *cobra.Command {
+	opts := &CommentOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		Config:     f.Config,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "comment {<number> | <url>}",
+		Short: "Create a new issue comment",
+		Example: heredoc.Doc(`
+			$ gh issue comment 22 --body "I was able to reproduce this issue, lets fix it."
+			$ gh issue comment 22 --body-file comment.md
+			$ gh issue comment 22 --edit-last --body "I take that back."
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+
+			opts.SelectorArg = args[0]
+
+			inputFlags := 0
+			if cmd.Flags().Changed("body") {
+				inputFlags++
+			}
+			if cmd.Flags().Changed("body-file") {
+				inputFlags++
+			}
+			if opts.Editor {
+				inputFlags++
+			}
+			if opts.WebMode {
+				inputFlags++
+			}
+			if inputFlags > 1 {
+				return &cmdutil.FlagError{Err: errors.New("specify only one of --body, --body-file, --editor, or --web")}
+			}
+			if inputFlags == 0 {
+				if !opts.IO.CanPrompt() {
+					return &cmdutil.FlagError{Err: errors.New("--body, --body-file, --editor, or --web required when not running interactively")}
+				}
+				opts.Interactive = true
+			}
+			if opts.WebMode && opts.EditLast {
+				return &cmdutil.FlagError{Err: errors.New("--edit-last is not supported with --web")}
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return commentRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Body, "body", "b", "", "Supply a body. Will prompt for one otherwise.")
+	cmd.Flags().StringVarP(&opts.BodyFile, "body-file", "F", "", "Read body text from `file`")
+	cmd.Flags().BoolVarP(&opts.Editor, "editor", "e", false, "Add body using editor")
+	cmd.Flags().BoolVarP(&opts.WebMode, "web", "w", false, "Add body in browser")
+	cmd.Flags().BoolVar(&opts.EditLast, "edit-last", false, "Edit the last comment of the current user")
+
+	return cmd
+}
+
+func commentRun(opts *CommentOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	issue, baseRepo, err := issueShared.IssueFromArg(apiClient, opts.BaseRepo, opts.SelectorArg)
+	if err != nil {
+		return err
+	}
+
+	if opts.WebMode {
+		openURL := fmt.Sprintf("%s#issuecomment-new", issue.URL)
+		if opts.IO.IsStdoutTTY() {
+			fmt.Fprintf(opts.IO.ErrOut, "Opening %s in your browser.\n", utils.DisplayURL(openURL))
+		}
+		return utils.OpenInBrowser(openURL)
+	}
+
+	body := opts.Body
+	switch {
+	case opts.BodyFile != "":
+		contents, err := cmdutil.ReadFile(opts.BodyFile, opts.IO.In)
+		if err != nil {
+			return err
+		}
+		body = string(contents)
+	case opts.Editor || opts.Interactive:
+		if !opts.IO.CanPrompt() {
+			return errors.New("--editor requires an interactive terminal")
+		}
+		editorCommand, err := cmdutil.DetermineEditor(opts.Config)
+		if err != nil {
+			return err
+		}
+		body, err = commentSurvey(editorCommand)
+		if err != nil {
+			return err
+		}
+	}
+
+	if body == "" {
+		return errors.New("comment body cannot be blank")
+	}
+
+	if opts.EditLast {
+		comment, err := lastViewerComment(apiClient, baseRepo, issue.Number)
+		if err != nil {
+			return err
+		}
+		err = api.CommentUpdate(apiClient, baseRepo, comment.ID, body)
+		if err != nil {
+			return err
+		}
+	} else {
+		err = api.CommentCreate(apiClient, baseRepo, issue.ID, body)
+		if err != nil {
+			return err
+		}
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		verb := "Commented on"
+		if opts.EditLast {
+			verb = "Updated the last comment on"
+		}
+		fmt.Fprintf(opts.IO.ErrOut, "%s %s issue #%d (%s)\n", utils.Green("✔"), verb, issue.Number, issue.Title)
+	}
+
+	return nil
+}
+
+// lastViewerComment finds the most recent comment on the issue that was
+// authored by the current user
+func lastViewerComment(client *api.Client, repo ghrepo.Interface, number int) (*api.Comment, error) {
+	comments, err := api.IssueComments(client, repo, number)
+	if err != nil {
+		return nil, err
+	}
+	for i := len(comments.Comments) - 1; i >= 0; i-- {
+		comment := comments.Comments[i]
+		if comment.ViewerDidAuthor && comment.ID != "" {
+			return &comment, nil
+		}
+	}
+	return nil, errors.New("no comments found for the current user")
+}
+
+func commentSurvey(editorCommand string) (string, error) {
+	answers := struct {
+		Body string
+	}{}
+	qs := []*survey.Question{
+		{
+			Name: "body",
+			Prompt: &surveyext.GhEditor{
+				EditorCommand: editorCommand,
+				Editor: &survey.Editor{
+					Message:  "Comment body",
+					FileName: "*.md",
+				},
+			},
+		},
+	}
+
+	err := prompt.SurveyAsk(qs, &answers)
+	if err != nil {
+		return "", err
+	}
+
+	return answers.Body, nil
+}
@@ -0,0 +1,241 @@
+package comment
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/cli/cli/internal/config"
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/httpmock"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/cli/cli/test"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewCmdComment(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    string
+		isTTY   bool
+		want    CommentOptions
+		wantErr string
+	}{
+		{
+			name:  "body flag",
+			args:  "22 --body hello",
+			isTTY: false,
+			want: CommentOptions{
+				SelectorArg: "22",
+				Body:        "hello",
+			},
+		},
+		{
+			name:  "edit last",
+			args:  "22 --edit-last --body revised",
+			isTTY: false,
+			want: CommentOptions{
+				SelectorArg: "22",
+				Body:        "revised",
+				EditLast:    true,
+			},
+		},
+		{
+			name:  "no flags prompts interactively",
+			args:  "22",
+			isTTY: true,
+			want: CommentOptions{
+				SelectorArg: "22",
+				Interactive: true,
+			},
+		},
+		{
+			name:    "no flags non-interactive",
+			args:    "22",
+			isTTY:   false,
+			wantErr: "--body, --body-file, --editor, or --web required when not running interactively",
+		},
+		{
+			name:    "multiple body sources",
+			args:    "22 --body hi --editor",
+			isTTY:   true,
+			wantErr: "specify only one of --body, --body-file, --editor, or --web",
+		},
+		{
+			name:    "web with edit-last",
+			args:    "22 --web --edit-last",
+			isTTY:   true,
+			wantErr: "--edit-last is not supported with --web",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, _, _ := iostreams.Test()
+			io.SetStdoutTTY(tt.isTTY)
+			io.SetStdinTTY(tt.isTTY)
+			io.SetStderrTTY(tt.isTTY)
+
+			f := &cmdutil.Factory{
+				IOStreams: io,
+			}
+
+			var opts *CommentOptions
+			cmd := NewCmdComment(f, func(o *CommentOptions) error {
+				opts = o
+				return nil
+			})
+
+			argv, err := shlex.Split(tt.args)
+			require.NoError(t, err)
+			cmd.SetArgs(argv)
+
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(ioutil.Discard)
+			cmd.SetErr(ioutil.Discard)
+
+			_, err = cmd.ExecuteC()
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			} else {
+				require.NoError(t, err)
+			}
+
+			assert.Equal(t, tt.want.SelectorArg, opts.SelectorArg)
+			assert.Equal(t, tt.want.Body, opts.Body)
+			assert.Equal(t, tt.want.EditLast, opts.EditLast)
+			assert.Equal(t, tt.want.Interactive, opts.Interactive)
+		})
+	}
+}
+
+func runCommand(rt http.RoundTripper, isTTY bool, cli string) (*test.CmdOut, error) {
+	io, _, stdout, stderr := iostreams.Test()
+	io.SetStdoutTTY(isTTY)
+	io.SetStdinTTY(isTTY)
+	io.SetStderrTTY(isTTY)
+
+	factory := &cmdutil.Factory{
+		IOStreams: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: rt}, nil
+		},
+		Config: func() (config.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+	}
+
+	cmd := NewCmdComment(factory, nil)
+
+	cli = strings.TrimPrefix(cli, "issue comment")
+	argv, err := shlex.Split(cli)
+	if err != nil {
+		return nil, err
+	}
+	cmd.SetArgs(argv)
+
+	cmd.SetIn(&bytes.Buffer{})
+	cmd.SetOut(ioutil.Discard)
+	cmd.SetErr(ioutil.Discard)
+
+	_, err = cmd.ExecuteC()
+	return &test.CmdOut{
+		OutBuf: stdout,
+		ErrBuf: stderr,
+	}, err
+}
+
+func TestIssueComment(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query IssueByNumber\b`),
+		httpmock.StringResponse(`
+		{ "data": { "repository": { "hasIssuesEnabled": true, "issue": {
+			"id": "THE-ID",
+			"number": 22,
+			"title": "Frobnicator is broken",
+			"url": "https://github.com/OWNER/REPO/issues/22"
+		} } } }`))
+	http.Register(
+		httpmock.GraphQL(`mutation CommentCreate\b`),
+		httpmock.GraphQLMutation(`
+		{ "data": { "addComment": { "commentEdge": { "node": {
+			"id": "COMMENT-ID"
+		} } } } }`,
+			func(inputs map[string]interface{}) {
+				assert.Equal(t, "THE-ID", inputs["subjectId"])
+				assert.Equal(t, "reproduced on my machine", inputs["body"])
+			}))
+
+	output, err := runCommand(http, false, `issue comment 22 --body "reproduced on my machine"`)
+	if err != nil {
+		t.Fatalf("error running command `issue comment`: %v", err)
+	}
+
+	assert.Equal(t, "", output.String())
+}
+
+func TestIssueComment_editLast(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query IssueByNumber\b`),
+		httpmock.StringResponse(`
+		{ "data": { "repository": { "hasIssuesEnabled": true, "issue": {
+			"id": "THE-ID",
+			"number": 22,
+			"title": "Frobnicator is broken",
+			"url": "https://github.com/OWNER/REPO/issues/22"
+		} } } }`))
+	http.Register(
+		httpmock.GraphQL(`query IssueComments\b`),
+		httpmock.StringResponse(`
+		{ "data": { "repository": { "issue": { "comments": {
+			"nodes": [
+				{
+					"id": "OLD-COMMENT",
+					"author": { "login": "monalisa" },
+					"body": "first take",
+					"createdAt": "2020-01-01T12:00:00Z",
+					"viewerDidAuthor": true
+				},
+				{
+					"id": "OTHER-COMMENT",
+					"author": { "login": "hubot" },
+					"body": "beep",
+					"createdAt": "2020-01-02T12:00:00Z",
+					"viewerDidAuthor": false
+				}
+			],
+			"pageInfo": { "hasNextPage": false, "endCursor": "" },
+			"totalCount": 2
+		} } } } }`))
+	http.Register(
+		httpmock.GraphQL(`mutation CommentUpdate\b`),
+		httpmock.GraphQLMutation(`
+		{ "data": { "updateIssueComment": { "issueComment": {
+			"id": "OLD-COMMENT"
+		} } } }`,
+			func(inputs map[string]interface{}) {
+				assert.Equal(t, "OLD-COMMENT", inputs["id"])
+				assert.Equal(t, "second take", inputs["body"])
+			}))
+
+	output, err := runCommand(http, false, `issue comment 22 --edit-last --body "second take"`)
+	if err != nil {
+		t.Fatalf("error running command `issue comment`: %v", err)
+	}
+
+	assert.Equal(t, "", output.String())
+}
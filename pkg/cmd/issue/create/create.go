@@ -4,16 +4,22 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"os"
+	"path"
+	"strings"
 
+	"github.com/AlecAivazis/survey/v2"
 	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/api"
 	"github.com/cli/cli/git"
 	"github.com/cli/cli/internal/config"
 	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/clipboard"
 	prShared "github.com/cli/cli/pkg/cmd/pr/shared"
 	"github.com/cli/cli/pkg/cmdutil"
 	"github.com/cli/cli/pkg/githubtemplate"
 	"github.com/cli/cli/pkg/iostreams"
+	"github.com/cli/cli/pkg/prompt"
 	"github.com/cli/cli/utils"
 	"github.com/spf13/cobra"
 )
@@ -31,6 +37,9 @@ type CreateOptions struct {
 
 	Title       string
 	Body        string
+	BodyFile    string
+	Template    string
+	Copy        bool
 	Interactive bool
 
 	Assignees []string
@@ -55,6 +64,7 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 			$ gh issue create --label bug --label "help wanted"
 			$ gh issue create --assignee monalisa,hubot
 			$ gh issue create --project "Roadmap"
+			$ gh issue create --title "I found a bug" --template "Bug report"
 		`),
 		Args: cmdutil.NoArgsQuoteReminder,
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -63,9 +73,28 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 
 			titleProvided := cmd.Flags().Changed("title")
 			bodyProvided := cmd.Flags().Changed("body")
+			bodyFileProvided := cmd.Flags().Changed("body-file")
+			templateProvided := cmd.Flags().Changed("template")
 			opts.RepoOverride, _ = cmd.Flags().GetString("repo")
 
-			opts.Interactive = !(titleProvided && bodyProvided)
+			if bodyProvided && bodyFileProvided {
+				return &cmdutil.FlagError{Err: errors.New("specify only one of --body or --body-file")}
+			}
+			if (bodyProvided || bodyFileProvided) && templateProvided {
+				return &cmdutil.FlagError{Err: errors.New("--template is not supported when using --body or --body-file")}
+			}
+			if bodyFileProvided {
+				b, err := cmdutil.ReadFile(opts.BodyFile, opts.IO.In)
+				if err != nil {
+					return err
+				}
+				opts.Body = string(b)
+				bodyProvided = true
+			}
+
+			// a template can stand in for the body, so selecting one
+			// non-interactively only leaves the title to provide
+			opts.Interactive = !(titleProvided && (bodyProvided || templateProvided))
 
 			if opts.Interactive && !opts.IO.CanPrompt() {
 				return &cmdutil.FlagError{Err: errors.New("must provide --title and --body when not running interactively")}
@@ -80,11 +109,14 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 
 	cmd.Flags().StringVarP(&opts.Title, "title", "t", "", "Supply a title. Will prompt for one otherwise.")
 	cmd.Flags().StringVarP(&opts.Body, "body", "b", "", "Supply a body. Will prompt for one otherwise.")
+	cmd.Flags().StringVarP(&opts.BodyFile, "body-file", "F", "", "Read body text from `file` (use \"-\" to read from standard input)")
+	cmd.Flags().StringVarP(&opts.Template, "template", "T", "", "Use an issue template by `name` or file name")
 	cmd.Flags().BoolVarP(&opts.WebMode, "web", "w", false, "Open the browser to create an issue")
 	cmd.Flags().StringSliceVarP(&opts.Assignees, "assignee", "a", nil, "Assign people by their `login`")
 	cmd.Flags().StringSliceVarP(&opts.Labels, "label", "l", nil, "Add labels by `name`")
 	cmd.Flags().StringSliceVarP(&opts.Projects, "project", "p", nil, "Add the issue to projects by `name`")
 	cmd.Flags().StringVarP(&opts.Milestone, "milestone", "m", "", "Add the issue to a milestone by `name`")
+	cmd.Flags().BoolVar(&opts.Copy, "copy", false, "Copy the issue URL to the clipboard")
 
 	return cmd
 }
@@ -112,6 +144,19 @@ func createRun(opts *CreateOptions) error {
 
 	isTerminal := opts.IO.IsStdoutTTY()
 
+	var templateTitle, templateBody string
+	if opts.Template != "" {
+		templateFile, err := matchTemplate(nonLegacyTemplateFiles, opts.Template)
+		if err != nil {
+			return err
+		}
+		templateTitle = githubtemplate.ExtractTitle(templateFile)
+		templateBody = string(githubtemplate.ExtractContents(templateFile))
+		opts.Labels = append(opts.Labels, githubtemplate.ExtractLabels(templateFile)...)
+		// the template is already chosen, so skip the interactive chooser
+		nonLegacyTemplateFiles = nil
+	}
+
 	var milestones []string
 	if opts.Milestone != "" {
 		milestones = []string{opts.Milestone}
@@ -119,8 +164,16 @@ func createRun(opts *CreateOptions) error {
 
 	if opts.WebMode {
 		openURL := ghrepo.GenerateRepoURL(baseRepo, "issues/new")
-		if opts.Title != "" || opts.Body != "" {
-			openURL, err = prShared.WithPrAndIssueQueryParams(openURL, opts.Title, opts.Body, opts.Assignees, opts.Labels, opts.Projects, milestones)
+		webTitle := opts.Title
+		if webTitle == "" {
+			webTitle = templateTitle
+		}
+		webBody := opts.Body
+		if webBody == "" {
+			webBody = templateBody
+		}
+		if webTitle != "" || webBody != "" {
+			openURL, err = prShared.WithPrAndIssueQueryParams(openURL, webTitle, webBody, opts.Assignees, opts.Labels, opts.Projects, milestones)
 			if err != nil {
 				return err
 			}
@@ -159,19 +212,29 @@ func createRun(opts *CreateOptions) error {
 
 	if opts.Interactive {
 		var legacyTemplateFile *string
-		if opts.RepoOverride == "" {
+		if opts.Template == "" && opts.RepoOverride == "" {
 			if rootDir, err := git.ToplevelDir(); err == nil {
 				// TODO: figure out how to stub this in tests
 				legacyTemplateFile = githubtemplate.FindLegacy(rootDir, "ISSUE_TEMPLATE")
 			}
 		}
 
+		if opts.Template == "" && opts.RootDirOverride == "" && len(nonLegacyTemplateFiles) == 0 && legacyTemplateFile == nil {
+			// like the web UI, fall back to the owner's ".github" repository
+			// when this repository has no templates of its own
+			if communityDir := prShared.CommunityTemplatesDir(apiClient, baseRepo, "ISSUE_TEMPLATE"); communityDir != "" {
+				defer os.RemoveAll(communityDir)
+				nonLegacyTemplateFiles = githubtemplate.FindNonLegacy(communityDir, "ISSUE_TEMPLATE")
+				legacyTemplateFile = githubtemplate.FindLegacy(communityDir, "ISSUE_TEMPLATE")
+			}
+		}
+
 		editorCommand, err := cmdutil.DetermineEditor(opts.Config)
 		if err != nil {
 			return err
 		}
 
-		err = prShared.TitleBodySurvey(opts.IO, editorCommand, &tb, apiClient, baseRepo, title, body, prShared.Defaults{}, nonLegacyTemplateFiles, legacyTemplateFile, false, repo.ViewerCanTriage())
+		err = prShared.TitleBodySurvey(opts.IO, editorCommand, &tb, apiClient, baseRepo, title, body, prShared.Defaults{Title: templateTitle, Body: templateBody}, nonLegacyTemplateFiles, legacyTemplateFile, false, repo.ViewerCanTriage())
 		if err != nil {
 			return fmt.Errorf("could not collect title and/or body: %w", err)
 		}
@@ -191,6 +254,12 @@ func createRun(opts *CreateOptions) error {
 			body = tb.Body
 		}
 	} else {
+		if title == "" {
+			title = templateTitle
+		}
+		if body == "" {
+			body = templateBody
+		}
 		if title == "" {
 			return fmt.Errorf("title can't be blank")
 		}
@@ -207,6 +276,17 @@ func createRun(opts *CreateOptions) error {
 		}
 		return utils.OpenInBrowser(openURL)
 	} else if action == prShared.SubmitAction {
+		if opts.Interactive {
+			proceed, err := confirmNoDuplicate(apiClient, baseRepo, opts.IO, title)
+			if err != nil {
+				return err
+			}
+			if !proceed {
+				fmt.Fprintln(opts.IO.ErrOut, "Discarding.")
+				return nil
+			}
+		}
+
 		params := map[string]interface{}{
 			"title": title,
 			"body":  body,
@@ -223,9 +303,65 @@ func createRun(opts *CreateOptions) error {
 		}
 
 		fmt.Fprintln(opts.IO.Out, newIssue.URL)
+		if opts.Copy {
+			if copyErr := clipboard.Copy(newIssue.URL); copyErr != nil {
+				fmt.Fprintf(opts.IO.ErrOut, "warning: could not copy to clipboard: %v\n", copyErr)
+			}
+		}
 	} else {
 		panic("Unreachable state")
 	}
 
 	return nil
 }
+
+// confirmNoDuplicate searches open issues with similar titles and, when any
+// are found, lets the user back out in favor of commenting on an existing
+// issue. It reports whether issue creation should go ahead.
+func confirmNoDuplicate(apiClient *api.Client, baseRepo ghrepo.Interface, io *iostreams.IOStreams, title string) (bool, error) {
+	listResult, err := api.IssueList(apiClient, baseRepo, "open", nil, "", 3, "", "", "", fmt.Sprintf("%s in:title", title))
+	if err != nil || len(listResult.Issues) == 0 {
+		// duplicate detection is best-effort and should never block filing
+		return true, nil
+	}
+
+	fmt.Fprintf(io.ErrOut, "%s Open issues with similar titles already exist:\n", utils.Yellow("!"))
+	options := []string{"Submit anyway"}
+	for _, issue := range listResult.Issues {
+		fmt.Fprintf(io.ErrOut, "  #%d  %s\n", issue.Number, issue.Title)
+		options = append(options, fmt.Sprintf("Comment on #%d instead", issue.Number))
+	}
+
+	var choice int
+	err = prompt.SurveyAskOne(&survey.Select{
+		Message: "This issue may be a duplicate. What now?",
+		Options: options,
+	}, &choice)
+	if err != nil {
+		return false, fmt.Errorf("could not prompt: %w", err)
+	}
+	if choice == 0 {
+		return true, nil
+	}
+
+	issue := listResult.Issues[choice-1]
+	fmt.Fprintf(io.ErrOut, "Add your report with %s\n", utils.Bold(fmt.Sprintf("gh issue comment %d", issue.Number)))
+	return false, nil
+}
+
+// matchTemplate finds the template whose name or file name matches the
+// requested name
+func matchTemplate(templateFiles []string, name string) (string, error) {
+	templateNames := make([]string, 0, len(templateFiles))
+	for _, f := range templateFiles {
+		templateName := githubtemplate.ExtractName(f)
+		if strings.EqualFold(templateName, name) || strings.EqualFold(path.Base(f), name) {
+			return f, nil
+		}
+		templateNames = append(templateNames, templateName)
+	}
+	if len(templateNames) == 0 {
+		return "", errors.New("no issue templates found")
+	}
+	return "", fmt.Errorf("template %q not found\nAvailable templates:\n  %s", name, strings.Join(templateNames, "\n  "))
+}
@@ -3,8 +3,10 @@ package create
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
 	"net/http"
+	"os"
 	"os/exec"
 	"reflect"
 	"strings"
@@ -136,6 +138,13 @@ func TestIssueCreate_nonLegacyTemplate(t *testing.T) {
 			"hasIssuesEnabled": true
 		} } }
 	`))
+	http.StubResponse(200, bytes.NewBufferString(`
+		{ "data": { "search": {
+			"issueCount": 0,
+			"nodes": [],
+			"pageInfo": { "hasNextPage": false, "endCursor": "" }
+		} } }
+	`))
 	http.StubResponse(200, bytes.NewBufferString(`
 		{ "data": { "createIssue": { "issue": {
 			"URL": "https://github.com/OWNER/REPO/issues/12"
@@ -168,7 +177,7 @@ func TestIssueCreate_nonLegacyTemplate(t *testing.T) {
 		t.Errorf("error running command `issue create`: %v", err)
 	}
 
-	bodyBytes, _ := ioutil.ReadAll(http.Requests[1].Body)
+	bodyBytes, _ := ioutil.ReadAll(http.Requests[2].Body)
 	reqBody := struct {
 		Variables struct {
 			Input struct {
@@ -187,6 +196,101 @@ func TestIssueCreate_nonLegacyTemplate(t *testing.T) {
 	eq(t, output.String(), "https://github.com/OWNER/REPO/issues/12\n")
 }
 
+func TestIssueCreate_templateFlag(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query RepositoryInfo\b`),
+		httpmock.StringResponse(`
+		{ "data": { "repository": {
+			"id": "REPOID",
+			"hasIssuesEnabled": true
+		} } }
+		`))
+	http.Register(
+		httpmock.GraphQL(`query RepositoryResolveMetadataIDs\b`),
+		httpmock.StringResponse(`
+		{ "data": { "repository": {
+			"l000": { "name": "bug", "id": "BUGID" }
+		} } }
+		`))
+	http.Register(
+		httpmock.GraphQL(`mutation IssueCreate\b`),
+		httpmock.GraphQLMutation(`
+		{ "data": { "createIssue": { "issue": {
+			"URL": "https://github.com/OWNER/REPO/issues/12"
+		} } } }
+	`, func(inputs map[string]interface{}) {
+			eq(t, inputs["title"], "hello")
+			eq(t, inputs["body"], "I wanna report a bug")
+			eq(t, inputs["labelIds"], []interface{}{"BUGID"})
+		}))
+
+	output, err := runCommandWithRootDirOverridden(http, false, `-t hello --template "Bug report"`, "./fixtures/repoWithNonLegacyIssueTemplates")
+	if err != nil {
+		t.Errorf("error running command `issue create`: %v", err)
+	}
+
+	eq(t, output.String(), "https://github.com/OWNER/REPO/issues/12\n")
+}
+
+func TestIssueCreate_templateFlagForm(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query RepositoryInfo\b`),
+		httpmock.StringResponse(`
+		{ "data": { "repository": {
+			"id": "REPOID",
+			"hasIssuesEnabled": true
+		} } }
+		`))
+	http.Register(
+		httpmock.GraphQL(`query RepositoryResolveMetadataIDs\b`),
+		httpmock.StringResponse(`
+		{ "data": { "repository": {
+			"l000": { "name": "feedback", "id": "FEEDBACKID" }
+		} } }
+		`))
+	http.Register(
+		httpmock.GraphQL(`mutation IssueCreate\b`),
+		httpmock.GraphQLMutation(`
+		{ "data": { "createIssue": { "issue": {
+			"URL": "https://github.com/OWNER/REPO/issues/12"
+		} } } }
+	`, func(inputs map[string]interface{}) {
+			eq(t, inputs["title"], "hello")
+			eq(t, inputs["body"], "Thanks for taking the time to share your thoughts!\n\n"+
+				"### What did you try to do?\n\n"+
+				"### What happened?\n\n"+
+				"<!-- Include any error output -->\n\n"+
+				"### Checklist\n\n"+
+				"- [ ] I searched for existing issues\n")
+			eq(t, inputs["labelIds"], []interface{}{"FEEDBACKID"})
+		}))
+
+	output, err := runCommandWithRootDirOverridden(http, false, `-t hello --template Feedback`, "./fixtures/repoWithNonLegacyIssueTemplates")
+	if err != nil {
+		t.Errorf("error running command `issue create`: %v", err)
+	}
+
+	eq(t, output.String(), "https://github.com/OWNER/REPO/issues/12\n")
+}
+
+func TestIssueCreate_templateNotFound(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	_, err := runCommandWithRootDirOverridden(http, false, `-t hello --template gone`, "./fixtures/repoWithNonLegacyIssueTemplates")
+	if err == nil {
+		t.Fatal("expected error running command `issue create`")
+	}
+
+	assert.Equal(t, "template \"gone\" not found\nAvailable templates:\n  Bug report\n  Submit a request\n  Feedback", err.Error())
+}
+
 func TestIssueCreate_metadata(t *testing.T) {
 	http := &httpmock.Registry{}
 	defer http.Verify(t)
@@ -339,3 +443,175 @@ func TestIssueCreate_webTitleBody(t *testing.T) {
 	eq(t, output.String(), "")
 	eq(t, output.Stderr(), "Opening github.com/OWNER/REPO/issues/new in your browser.\n")
 }
+
+func TestIssueCreate_duplicateSubmitAnyway(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.StubResponse(200, bytes.NewBufferString(`
+		{ "data": { "repository": {
+			"id": "REPOID",
+			"hasIssuesEnabled": true
+		} } }
+	`))
+	http.StubResponse(200, bytes.NewBufferString(`
+		{ "data": { "search": {
+			"issueCount": 2,
+			"nodes": [
+				{ "number": 9, "title": "hello there" },
+				{ "number": 10, "title": "hello again" }
+			],
+			"pageInfo": { "hasNextPage": false, "endCursor": "" }
+		} } }
+	`))
+	http.StubResponse(200, bytes.NewBufferString(`
+		{ "data": { "createIssue": { "issue": {
+			"URL": "https://github.com/OWNER/REPO/issues/12"
+		} } } }
+	`))
+
+	as, teardown := prompt.InitAskStubber()
+	defer teardown()
+	as.Stub([]*prompt.QuestionStub{
+		{
+			Name:  "index",
+			Value: 1,
+		},
+	})
+	as.Stub([]*prompt.QuestionStub{
+		{
+			Name:    "body",
+			Default: true,
+		},
+	})
+	as.Stub([]*prompt.QuestionStub{
+		{
+			Name:  "confirmation",
+			Value: 0,
+		},
+	})
+	as.StubOne(0) // Submit anyway
+
+	output, err := runCommandWithRootDirOverridden(http, true, `-t hello`, "./fixtures/repoWithNonLegacyIssueTemplates")
+	if err != nil {
+		t.Errorf("error running command `issue create`: %v", err)
+	}
+
+	test.ExpectLines(t, output.Stderr(),
+		"Open issues with similar titles already exist",
+		`#9 +hello there`,
+		`#10 +hello again`)
+	eq(t, output.String(), "https://github.com/OWNER/REPO/issues/12\n")
+}
+
+func TestIssueCreate_duplicateAborted(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.StubResponse(200, bytes.NewBufferString(`
+		{ "data": { "repository": {
+			"id": "REPOID",
+			"hasIssuesEnabled": true
+		} } }
+	`))
+	http.StubResponse(200, bytes.NewBufferString(`
+		{ "data": { "search": {
+			"issueCount": 1,
+			"nodes": [
+				{ "number": 9, "title": "hello there" }
+			],
+			"pageInfo": { "hasNextPage": false, "endCursor": "" }
+		} } }
+	`))
+
+	as, teardown := prompt.InitAskStubber()
+	defer teardown()
+	as.Stub([]*prompt.QuestionStub{
+		{
+			Name:  "index",
+			Value: 1,
+		},
+	})
+	as.Stub([]*prompt.QuestionStub{
+		{
+			Name:    "body",
+			Default: true,
+		},
+	})
+	as.Stub([]*prompt.QuestionStub{
+		{
+			Name:  "confirmation",
+			Value: 0,
+		},
+	})
+	as.StubOne(1) // Comment on #9 instead
+
+	output, err := runCommandWithRootDirOverridden(http, true, `-t hello`, "./fixtures/repoWithNonLegacyIssueTemplates")
+	if err != nil {
+		t.Errorf("error running command `issue create`: %v", err)
+	}
+
+	eq(t, output.String(), "")
+	test.ExpectLines(t, output.Stderr(),
+		`#9 +hello there`,
+		"Add your report with gh issue comment 9",
+		"Discarding.")
+}
+
+func TestIssueCreate_bodyFile(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.StubResponse(200, bytes.NewBufferString(`
+		{ "data": { "repository": {
+			"id": "REPOID",
+			"hasIssuesEnabled": true
+		} } }
+	`))
+	http.StubResponse(200, bytes.NewBufferString(`
+		{ "data": { "createIssue": { "issue": {
+			"URL": "https://github.com/OWNER/REPO/issues/12"
+		} } } }
+	`))
+
+	tmpfile, err := ioutil.TempFile("", "issue-body")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(tmpfile.Name())
+	if _, err := tmpfile.WriteString("this body was generated by a script"); err != nil {
+		t.Fatal(err)
+	}
+	tmpfile.Close()
+
+	output, err := runCommand(http, true, fmt.Sprintf(`-t hello -F %q`, tmpfile.Name()))
+	if err != nil {
+		t.Errorf("error running command `issue create`: %v", err)
+	}
+
+	bodyBytes, _ := ioutil.ReadAll(http.Requests[1].Body)
+	reqBody := struct {
+		Variables struct {
+			Input struct {
+				Title string
+				Body  string
+			}
+		}
+	}{}
+	_ = json.Unmarshal(bodyBytes, &reqBody)
+
+	eq(t, reqBody.Variables.Input.Title, "hello")
+	eq(t, reqBody.Variables.Input.Body, "this body was generated by a script")
+
+	eq(t, output.String(), "https://github.com/OWNER/REPO/issues/12\n")
+}
+
+func TestIssueCreate_bodyAndBodyFile(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	_, err := runCommand(http, true, `-t hello -b "a body" -F body.md`)
+	if err == nil || err.Error() != "specify only one of --body or --body-file" {
+		t.Fatalf("got error: %v", err)
+	}
+}
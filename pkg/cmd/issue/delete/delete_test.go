@@ -0,0 +1,157 @@
+package delete
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/httpmock"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/cli/cli/pkg/prompt"
+	"github.com/cli/cli/test"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func runCommand(rt http.RoundTripper, isTTY bool, cli string) (*test.CmdOut, error) {
+	io, _, stdout, stderr := iostreams.Test()
+	io.SetStdoutTTY(isTTY)
+	io.SetStdinTTY(isTTY)
+	io.SetStderrTTY(isTTY)
+
+	factory := &cmdutil.Factory{
+		IOStreams: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: rt}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+	}
+
+	cmd := NewCmdDelete(factory, nil)
+
+	cli = strings.TrimPrefix(cli, "issue delete")
+	argv, err := shlex.Split(cli)
+	if err != nil {
+		return nil, err
+	}
+	cmd.SetArgs(argv)
+
+	cmd.SetIn(&bytes.Buffer{})
+	cmd.SetOut(ioutil.Discard)
+	cmd.SetErr(ioutil.Discard)
+
+	_, err = cmd.ExecuteC()
+	return &test.CmdOut{
+		OutBuf: stdout,
+		ErrBuf: stderr,
+	}, err
+}
+
+func TestIssueDelete_confirmed(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query IssueByNumber\b`),
+		httpmock.StringResponse(`
+		{ "data": { "repository": { "hasIssuesEnabled": true, "issue": {
+			"id": "ISSUE-ID",
+			"number": 13,
+			"title": "The wrong issue"
+		} } } }`))
+	http.Register(
+		httpmock.GraphQL(`mutation IssueDelete\b`),
+		httpmock.GraphQLMutation(`
+		{ "data": { "deleteIssue": { "repository": {
+			"id": "REPO-ID"
+		} } } }`,
+			func(inputs map[string]interface{}) {
+				assert.Equal(t, "ISSUE-ID", inputs["issueId"])
+			}))
+
+	as, surveyTeardown := prompt.InitAskStubber()
+	defer surveyTeardown()
+	as.StubOne("13")
+
+	output, err := runCommand(http, true, "issue delete 13")
+	if err != nil {
+		t.Fatalf("error running command `issue delete`: %v", err)
+	}
+
+	assert.Equal(t, "✔ Deleted issue #13 (The wrong issue)\n", output.Stderr())
+}
+
+func TestIssueDelete_numberMismatch(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query IssueByNumber\b`),
+		httpmock.StringResponse(`
+		{ "data": { "repository": { "hasIssuesEnabled": true, "issue": {
+			"id": "ISSUE-ID",
+			"number": 13,
+			"title": "The wrong issue"
+		} } } }`))
+
+	as, surveyTeardown := prompt.InitAskStubber()
+	defer surveyTeardown()
+	as.StubOne("14")
+
+	output, err := runCommand(http, true, "issue delete 13")
+	assert.Equal(t, cmdutil.SilentError, err)
+	assert.Equal(t, "X Issue number did not match, issue #13 was not deleted\n", output.Stderr())
+}
+
+func TestIssueDelete_skipConfirm(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query IssueByNumber\b`),
+		httpmock.StringResponse(`
+		{ "data": { "repository": { "hasIssuesEnabled": true, "issue": {
+			"id": "ISSUE-ID",
+			"number": 13,
+			"title": "The wrong issue"
+		} } } }`))
+	http.Register(
+		httpmock.GraphQL(`mutation IssueDelete\b`),
+		httpmock.GraphQLMutation(`
+		{ "data": { "deleteIssue": { "repository": {
+			"id": "REPO-ID"
+		} } } }`,
+			func(inputs map[string]interface{}) {
+				assert.Equal(t, "ISSUE-ID", inputs["issueId"])
+			}))
+
+	output, err := runCommand(http, false, "issue delete 13 --yes")
+	if err != nil {
+		t.Fatalf("error running command `issue delete`: %v", err)
+	}
+
+	assert.Equal(t, "✔ Deleted issue #13 (The wrong issue)\n", output.Stderr())
+}
+
+func TestIssueDelete_nonInteractive(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query IssueByNumber\b`),
+		httpmock.StringResponse(`
+		{ "data": { "repository": { "hasIssuesEnabled": true, "issue": {
+			"id": "ISSUE-ID",
+			"number": 13,
+			"title": "The wrong issue"
+		} } } }`))
+
+	_, err := runCommand(http, false, "issue delete 13")
+	assert.EqualError(t, err, "--yes required when not running interactively")
+}
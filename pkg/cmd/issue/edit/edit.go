@@ -0,0 +1,399 @@
+package edit
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/internal/config"
+	"github.com/cli/cli/internal/ghrepo"
+	issueShared "github.com/cli/cli/pkg/cmd/issue/shared"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/cli/cli/pkg/prompt"
+	"github.com/cli/cli/pkg/surveyext"
+	"github.com/shurcooL/githubv4"
+	"github.com/spf13/cobra"
+)
+
+type EditOptions struct {
+	HttpClient func() (*http.Client, error)
+	Config     func() (config.Config, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	Selectors   []string
+	Search      string
+	Interactive bool
+
+	Title            string
+	TitleChanged     bool
+	Body             string
+	BodyChanged      bool
+	Milestone        string
+	MilestoneChanged bool
+
+	AddAssignees    []string
+	RemoveAssignees []string
+	AddLabels       []string
+	RemoveLabels    []string
+	AddProjects     []string
+	RemoveProjects  []string
+	ProjectFields   []string
+}
+
+func NewCmdEdit(f *cmdutil.Factory, runF func(*EditOptions) error) *cobra.Command {
+	opts := &EditOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		Config:     f.Config,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "edit {<number> | <url>}...",
+		Short: "Edit an issue",
+		Example: heredoc.Doc(`
+			$ gh issue edit 23 --title "I found a bug" --body "Nothing works"
+			$ gh issue edit 23 --add-label "bug,help wanted" --remove-label "core"
+			$ gh issue edit 23 --add-assignee @me --remove-assignee monalisa
+			$ gh issue edit 23 --milestone "Version 1"
+			$ gh issue edit 23 --add-project "Roadmap" --project-field Status=Todo
+			$ gh issue edit 23 24 25 --add-label "wontfix"
+			$ gh issue edit --search "no response in 30 days" --add-label "stale"
+		`),
+		Args: cobra.ArbitraryArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+
+			opts.Selectors = args
+
+			if len(args) == 0 && opts.Search == "" {
+				return &cmdutil.FlagError{Err: errors.New("specify issues by number or URL, or select them with --search")}
+			}
+			if len(args) > 0 && opts.Search != "" {
+				return &cmdutil.FlagError{Err: errors.New("--search cannot be combined with issue arguments")}
+			}
+
+			opts.TitleChanged = cmd.Flags().Changed("title")
+			opts.BodyChanged = cmd.Flags().Changed("body")
+			opts.MilestoneChanged = cmd.Flags().Changed("milestone")
+
+			if len(opts.ProjectFields) > 0 && len(opts.AddProjects) == 0 {
+				return &cmdutil.FlagError{Err: errors.New("--project-field requires --add-project")}
+			}
+
+			if !opts.TitleChanged && !opts.BodyChanged && !opts.MilestoneChanged &&
+				len(opts.AddAssignees) == 0 && len(opts.RemoveAssignees) == 0 &&
+				len(opts.AddLabels) == 0 && len(opts.RemoveLabels) == 0 &&
+				len(opts.AddProjects) == 0 && len(opts.RemoveProjects) == 0 {
+				if len(args) > 1 || opts.Search != "" {
+					return &cmdutil.FlagError{Err: errors.New("specify fields to edit with flags when editing multiple issues")}
+				}
+				if !opts.IO.CanPrompt() {
+					return &cmdutil.FlagError{Err: errors.New("specify fields to edit with flags when not running interactively")}
+				}
+				opts.Interactive = true
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return editRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Title, "title", "t", "", "Revise the issue title")
+	cmd.Flags().StringVarP(&opts.Body, "body", "b", "", "Revise the issue body")
+	cmd.Flags().StringVarP(&opts.Milestone, "milestone", "m", "", "Edit the milestone the issue belongs to by `name`")
+	cmd.Flags().StringSliceVar(&opts.AddAssignees, "add-assignee", nil, "Add assigned users by their `login`")
+	cmd.Flags().StringSliceVar(&opts.RemoveAssignees, "remove-assignee", nil, "Remove assigned users by their `login`")
+	cmd.Flags().StringSliceVar(&opts.AddLabels, "add-label", nil, "Add labels by `name`")
+	cmd.Flags().StringSliceVar(&opts.RemoveLabels, "remove-label", nil, "Remove labels by `name`")
+	cmd.Flags().StringSliceVar(&opts.AddProjects, "add-project", nil, "Add the issue to projects by `name`")
+	cmd.Flags().StringSliceVar(&opts.RemoveProjects, "remove-project", nil, "Remove the issue from projects by `name`")
+	cmd.Flags().StringSliceVar(&opts.ProjectFields, "project-field", nil, "Set a field on items added with --add-project, in `name=value` format (next-generation projects only)")
+	cmd.Flags().StringVarP(&opts.Search, "search", "S", "", "Edit every issue matching a search `query`")
+
+	return cmd
+}
+
+func editRun(opts *EditOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	matches, err := issueShared.FindIssues(apiClient, opts.BaseRepo, opts.Selectors, opts.Search, "all")
+	if err != nil {
+		return err
+	}
+
+	if len(matches) == 0 {
+		fmt.Fprintf(opts.IO.ErrOut, "No issues matched the search\n")
+		return nil
+	}
+
+	if opts.Interactive {
+		err = editSurvey(opts, matches[0].Issue)
+		if err != nil {
+			return err
+		}
+	}
+
+	edited := 0
+	failed := 0
+	for _, match := range matches {
+		err := editIssue(opts, apiClient, match.Issue, match.Repo)
+		if err != nil {
+			if len(matches) == 1 {
+				return err
+			}
+			fmt.Fprintf(opts.IO.ErrOut, "failed to edit issue #%d: %v\n", match.Issue.Number, err)
+			failed++
+			continue
+		}
+		edited++
+		fmt.Fprintln(opts.IO.Out, match.Issue.URL)
+	}
+
+	if len(matches) > 1 {
+		fmt.Fprintf(opts.IO.ErrOut, "Edited %d of %d issues\n", edited, len(matches))
+	}
+	if failed > 0 {
+		return cmdutil.SilentError
+	}
+
+	return nil
+}
+
+func editIssue(opts *EditOptions, apiClient *api.Client, issue *api.Issue, baseRepo ghrepo.Interface) error {
+	params := githubv4.UpdateIssueInput{
+		ID: issue.ID,
+	}
+	if opts.TitleChanged {
+		params.Title = githubv4.NewString(githubv4.String(opts.Title))
+	}
+	if opts.BodyChanged {
+		params.Body = githubv4.NewString(githubv4.String(opts.Body))
+	}
+
+	// projects with custom fields are next-generation projects; those are
+	// handled through their own mutations after the main update
+	addProjects := opts.AddProjects
+	if len(opts.ProjectFields) > 0 {
+		addProjects = nil
+	}
+
+	editAssignees := len(opts.AddAssignees) > 0 || len(opts.RemoveAssignees) > 0
+	editLabels := len(opts.AddLabels) > 0 || len(opts.RemoveLabels) > 0
+	editProjects := len(addProjects) > 0 || len(opts.RemoveProjects) > 0
+
+	var err error
+	var metadataResult *api.RepoMetadataResult
+	var assignees, labels, projects []string
+	if editAssignees || editLabels || editProjects || opts.MilestoneChanged {
+		assignees = mergeSets(currentAssignees(issue), opts.AddAssignees, opts.RemoveAssignees)
+		labels = mergeSets(currentLabels(issue), opts.AddLabels, opts.RemoveLabels)
+		projects = mergeSets(currentProjects(issue), addProjects, opts.RemoveProjects)
+
+		resolveInput := api.RepoResolveInput{}
+		if editAssignees {
+			resolveInput.Assignees = assignees
+		}
+		if editLabels {
+			resolveInput.Labels = labels
+		}
+		if editProjects {
+			resolveInput.Projects = projects
+		}
+		if opts.MilestoneChanged && opts.Milestone != "" {
+			resolveInput.Milestones = []string{opts.Milestone}
+		}
+
+		metadataResult, err = api.RepoResolveMetadataIDs(apiClient, baseRepo, resolveInput)
+		if err != nil {
+			return err
+		}
+	}
+
+	if editAssignees {
+		ids, err := metadataResult.MembersToIDs(assignees)
+		if err != nil {
+			return fmt.Errorf("could not assign user: %w", err)
+		}
+		params.AssigneeIDs = gqlIDs(ids)
+	}
+	if editLabels {
+		ids, err := metadataResult.LabelsToIDs(labels)
+		if err != nil {
+			return fmt.Errorf("could not add label: %w", err)
+		}
+		params.LabelIDs = gqlIDs(ids)
+	}
+	if editProjects {
+		ids, err := metadataResult.ProjectsToIDs(projects)
+		if err != nil {
+			return fmt.Errorf("could not add to project: %w", err)
+		}
+		params.ProjectIDs = gqlIDs(ids)
+	}
+	if opts.MilestoneChanged {
+		if opts.Milestone == "" {
+			params.MilestoneID = githubv4.NewID(nil)
+		} else {
+			milestoneID, err := metadataResult.MilestoneToID(opts.Milestone)
+			if err != nil {
+				return fmt.Errorf("could not add to milestone '%s': %w", opts.Milestone, err)
+			}
+			params.MilestoneID = githubv4.NewID(githubv4.ID(milestoneID))
+		}
+	}
+
+	err = api.IssueUpdate(apiClient, baseRepo, params)
+	if err != nil {
+		return err
+	}
+
+	if len(opts.ProjectFields) > 0 {
+		for _, projectTitle := range opts.AddProjects {
+			err = api.ProjectV2AddItemWithFields(apiClient, baseRepo, projectTitle, issue.ID, opts.ProjectFields)
+			if err != nil {
+				return fmt.Errorf("could not add to project '%s': %w", projectTitle, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func currentAssignees(issue *api.Issue) []string {
+	logins := make([]string, 0, len(issue.Assignees.Nodes))
+	for _, a := range issue.Assignees.Nodes {
+		logins = append(logins, a.Login)
+	}
+	return logins
+}
+
+func currentLabels(issue *api.Issue) []string {
+	names := make([]string, 0, len(issue.Labels.Nodes))
+	for _, l := range issue.Labels.Nodes {
+		names = append(names, l.Name)
+	}
+	return names
+}
+
+func currentProjects(issue *api.Issue) []string {
+	names := make([]string, 0, len(issue.ProjectCards.Nodes))
+	for _, c := range issue.ProjectCards.Nodes {
+		names = append(names, c.Project.Name)
+	}
+	return names
+}
+
+// mergeSets combines the current set of names with additions and removals,
+// matching names case-insensitively
+func mergeSets(current, add, remove []string) []string {
+	contains := func(haystack []string, needle string) bool {
+		for _, s := range haystack {
+			if strings.EqualFold(s, needle) {
+				return true
+			}
+		}
+		return false
+	}
+
+	merged := []string{}
+	for _, s := range current {
+		if !contains(remove, s) {
+			merged = append(merged, s)
+		}
+	}
+	for _, s := range add {
+		if !contains(merged, s) {
+			merged = append(merged, s)
+		}
+	}
+	return merged
+}
+
+func gqlIDs(ids []string) *[]githubv4.ID {
+	result := make([]githubv4.ID, len(ids))
+	for i, id := range ids {
+		result[i] = githubv4.ID(id)
+	}
+	return &result
+}
+
+func editSurvey(opts *EditOptions, issue *api.Issue) error {
+	editorCommand, err := cmdutil.DetermineEditor(opts.Config)
+	if err != nil {
+		return err
+	}
+
+	fieldsAnswer := struct {
+		Fields []string
+	}{}
+	fieldsQs := []*survey.Question{
+		{
+			Name: "fields",
+			Prompt: &survey.MultiSelect{
+				Message: "What would you like to edit?",
+				Options: []string{"Title", "Body"},
+			},
+		},
+	}
+	err = prompt.SurveyAsk(fieldsQs, &fieldsAnswer)
+	if err != nil {
+		return err
+	}
+
+	for _, field := range fieldsAnswer.Fields {
+		switch field {
+		case "Title":
+			opts.TitleChanged = true
+			titleQs := []*survey.Question{
+				{
+					Name: "title",
+					Prompt: &survey.Input{
+						Message: "Title",
+						Default: issue.Title,
+					},
+				},
+			}
+			err = prompt.SurveyAsk(titleQs, opts)
+			if err != nil {
+				return err
+			}
+		case "Body":
+			opts.BodyChanged = true
+			bodyQs := []*survey.Question{
+				{
+					Name: "body",
+					Prompt: &surveyext.GhEditor{
+						BlankAllowed:  true,
+						EditorCommand: editorCommand,
+						Editor: &survey.Editor{
+							Message:       "Body",
+							FileName:      "*.md",
+							Default:       issue.Body,
+							HideDefault:   true,
+							AppendDefault: true,
+						},
+					},
+				},
+			}
+			err = prompt.SurveyAsk(bodyQs, opts)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
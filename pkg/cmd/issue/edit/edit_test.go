@@ -0,0 +1,270 @@
+package edit
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/cli/cli/internal/config"
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/httpmock"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/cli/cli/test"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewCmdEdit(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    string
+		isTTY   bool
+		want    EditOptions
+		wantErr string
+	}{
+		{
+			name:  "title and body flags",
+			args:  "23 --title mytitle --body mybody",
+			isTTY: false,
+			want: EditOptions{
+				Selectors:    []string{"23"},
+				Title:        "mytitle",
+				TitleChanged: true,
+				Body:         "mybody",
+				BodyChanged:  true,
+			},
+		},
+		{
+			name:  "add and remove flags",
+			args:  `23 --add-label bug --remove-assignee monalisa`,
+			isTTY: false,
+			want: EditOptions{
+				Selectors:       []string{"23"},
+				AddLabels:       []string{"bug"},
+				RemoveAssignees: []string{"monalisa"},
+			},
+		},
+		{
+			name:  "no flags prompts interactively",
+			args:  "23",
+			isTTY: true,
+			want: EditOptions{
+				Selectors:   []string{"23"},
+				Interactive: true,
+			},
+		},
+		{
+			name:    "no flags non-interactive",
+			args:    "23",
+			isTTY:   false,
+			wantErr: "specify fields to edit with flags when not running interactively",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, _, _ := iostreams.Test()
+			io.SetStdoutTTY(tt.isTTY)
+			io.SetStdinTTY(tt.isTTY)
+			io.SetStderrTTY(tt.isTTY)
+
+			f := &cmdutil.Factory{
+				IOStreams: io,
+			}
+
+			var opts *EditOptions
+			cmd := NewCmdEdit(f, func(o *EditOptions) error {
+				opts = o
+				return nil
+			})
+
+			argv, err := shlex.Split(tt.args)
+			require.NoError(t, err)
+			cmd.SetArgs(argv)
+
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(ioutil.Discard)
+			cmd.SetErr(ioutil.Discard)
+
+			_, err = cmd.ExecuteC()
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			} else {
+				require.NoError(t, err)
+			}
+
+			assert.Equal(t, tt.want.Selectors, opts.Selectors)
+			assert.Equal(t, tt.want.Interactive, opts.Interactive)
+			assert.Equal(t, tt.want.Title, opts.Title)
+			assert.Equal(t, tt.want.TitleChanged, opts.TitleChanged)
+			assert.Equal(t, tt.want.Body, opts.Body)
+			assert.Equal(t, tt.want.BodyChanged, opts.BodyChanged)
+			assert.Equal(t, tt.want.AddLabels, opts.AddLabels)
+			assert.Equal(t, tt.want.RemoveAssignees, opts.RemoveAssignees)
+		})
+	}
+}
+
+func runCommand(rt http.RoundTripper, isTTY bool, cli string) (*test.CmdOut, error) {
+	io, _, stdout, stderr := iostreams.Test()
+	io.SetStdoutTTY(isTTY)
+	io.SetStdinTTY(isTTY)
+	io.SetStderrTTY(isTTY)
+
+	factory := &cmdutil.Factory{
+		IOStreams: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: rt}, nil
+		},
+		Config: func() (config.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+	}
+
+	cmd := NewCmdEdit(factory, nil)
+
+	cli = strings.TrimPrefix(cli, "issue edit")
+	argv, err := shlex.Split(cli)
+	if err != nil {
+		return nil, err
+	}
+	cmd.SetArgs(argv)
+
+	cmd.SetIn(&bytes.Buffer{})
+	cmd.SetOut(ioutil.Discard)
+	cmd.SetErr(ioutil.Discard)
+
+	_, err = cmd.ExecuteC()
+	return &test.CmdOut{
+		OutBuf: stdout,
+		ErrBuf: stderr,
+	}, err
+}
+
+func TestIssueEdit_titleBody(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query IssueByNumber\b`),
+		httpmock.StringResponse(`
+		{ "data": { "repository": { "hasIssuesEnabled": true, "issue": {
+			"id": "THE-ID",
+			"number": 23,
+			"title": "old title",
+			"body": "old body",
+			"url": "https://github.com/OWNER/REPO/issues/23"
+		} } } }`))
+	http.Register(
+		httpmock.GraphQL(`mutation IssueUpdate\b`),
+		httpmock.GraphQLMutation(`
+		{ "data": { "updateIssue": { "issue": {
+			"id": "THE-ID"
+		} } } }`,
+			func(inputs map[string]interface{}) {
+				assert.Equal(t, "THE-ID", inputs["id"])
+				assert.Equal(t, "new title", inputs["title"])
+				assert.Equal(t, "new body", inputs["body"])
+			}))
+
+	output, err := runCommand(http, false, `issue edit 23 --title "new title" --body "new body"`)
+	if err != nil {
+		t.Fatalf("error running command `issue edit`: %v", err)
+	}
+
+	assert.Equal(t, "https://github.com/OWNER/REPO/issues/23\n", output.String())
+}
+
+func TestIssueEdit_labels(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query IssueByNumber\b`),
+		httpmock.StringResponse(`
+		{ "data": { "repository": { "hasIssuesEnabled": true, "issue": {
+			"id": "THE-ID",
+			"number": 23,
+			"url": "https://github.com/OWNER/REPO/issues/23",
+			"labels": { "nodes": [
+				{ "name": "core" },
+				{ "name": "bug" }
+			] }
+		} } } }`))
+	http.Register(
+		httpmock.GraphQL(`query RepositoryResolveMetadataIDs\b`),
+		httpmock.StringResponse(`
+		{ "data": { "repository": { "l000": { "id": "BUGID", "name": "bug" }, "l001": { "id": "DOCSID", "name": "docs" } } } }`))
+	http.Register(
+		httpmock.GraphQL(`mutation IssueUpdate\b`),
+		httpmock.GraphQLMutation(`
+		{ "data": { "updateIssue": { "issue": {
+			"id": "THE-ID"
+		} } } }`,
+			func(inputs map[string]interface{}) {
+				assert.Equal(t, "THE-ID", inputs["id"])
+				assert.Equal(t, []interface{}{"BUGID", "DOCSID"}, inputs["labelIds"])
+			}))
+
+	output, err := runCommand(http, false, `issue edit 23 --add-label docs --remove-label core`)
+	if err != nil {
+		t.Fatalf("error running command `issue edit`: %v", err)
+	}
+
+	assert.Equal(t, "https://github.com/OWNER/REPO/issues/23\n", output.String())
+}
+
+func TestIssueEdit_searchBulk(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.StubResponse(200, bytes.NewBufferString(`
+	{ "data": { "search": {
+		"issueCount": 2,
+		"nodes": [
+			{ "number": 5, "title": "stale one", "labels": {"nodes": []} },
+			{ "number": 7, "title": "stale two", "labels": {"nodes": []} }
+		],
+		"pageInfo": { "hasNextPage": false, "endCursor": "" }
+	} } }
+	`))
+	http.StubResponse(200, bytes.NewBufferString(`
+	{ "data": { "repository": { "hasIssuesEnabled": true, "issue": {
+		"id": "ISSUE5", "number": 5, "url": "https://github.com/OWNER/REPO/issues/5",
+		"labels": { "nodes": [ { "name": "stale" } ] }
+	} } } }
+	`))
+	http.StubResponse(200, bytes.NewBufferString(`
+	{ "data": { "repository": { "hasIssuesEnabled": true, "issue": {
+		"id": "ISSUE7", "number": 7, "url": "https://github.com/OWNER/REPO/issues/7",
+		"labels": { "nodes": [ { "name": "stale" } ] }
+	} } } }
+	`))
+	http.StubResponse(200, bytes.NewBufferString(`
+	{ "data": { "repository": { "l000": { "id": "STALEID", "name": "stale" }, "l001": { "id": "WONTFIXID", "name": "wontfix" } } } }
+	`))
+	http.StubResponse(200, bytes.NewBufferString(`
+	{ "data": { "updateIssue": { "issue": { "id": "ISSUE5" } } } }
+	`))
+	http.StubResponse(200, bytes.NewBufferString(`
+	{ "data": { "repository": { "l000": { "id": "STALEID", "name": "stale" }, "l001": { "id": "WONTFIXID", "name": "wontfix" } } } }
+	`))
+	http.StubResponse(200, bytes.NewBufferString(`
+	{ "data": { "updateIssue": { "issue": { "id": "ISSUE7" } } } }
+	`))
+
+	output, err := runCommand(http, false, `issue edit --search "label:stale" --add-label wontfix`)
+	if err != nil {
+		t.Fatalf("error running command `issue edit`: %v", err)
+	}
+
+	assert.Equal(t, "https://github.com/OWNER/REPO/issues/5\nhttps://github.com/OWNER/REPO/issues/7\n", output.String())
+	assert.Equal(t, "Edited 2 of 2 issues\n", output.Stderr())
+}
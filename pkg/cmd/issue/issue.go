@@ -3,10 +3,21 @@ package issue
 import (
 	"github.com/MakeNowJust/heredoc"
 	cmdClose "github.com/cli/cli/pkg/cmd/issue/close"
+	cmdComment "github.com/cli/cli/pkg/cmd/issue/comment"
 	cmdCreate "github.com/cli/cli/pkg/cmd/issue/create"
+	cmdDelete "github.com/cli/cli/pkg/cmd/issue/delete"
+	cmdEdit "github.com/cli/cli/pkg/cmd/issue/edit"
 	cmdList "github.com/cli/cli/pkg/cmd/issue/list"
+	cmdLock "github.com/cli/cli/pkg/cmd/issue/lock"
+	cmdPin "github.com/cli/cli/pkg/cmd/issue/pin"
 	cmdReopen "github.com/cli/cli/pkg/cmd/issue/reopen"
 	cmdStatus "github.com/cli/cli/pkg/cmd/issue/status"
+	cmdSubscribe "github.com/cli/cli/pkg/cmd/issue/subscribe"
+	cmdTransfer "github.com/cli/cli/pkg/cmd/issue/transfer"
+	cmdTriage "github.com/cli/cli/pkg/cmd/issue/triage"
+	cmdUnlock "github.com/cli/cli/pkg/cmd/issue/unlock"
+	cmdUnpin "github.com/cli/cli/pkg/cmd/issue/unpin"
+	cmdUnsubscribe "github.com/cli/cli/pkg/cmd/issue/unsubscribe"
 	cmdView "github.com/cli/cli/pkg/cmd/issue/view"
 	"github.com/cli/cli/pkg/cmdutil"
 	"github.com/spf13/cobra"
@@ -35,10 +46,21 @@ func NewCmdIssue(f *cmdutil.Factory) *cobra.Command {
 	cmdutil.EnableRepoOverride(cmd, f)
 
 	cmd.AddCommand(cmdClose.NewCmdClose(f, nil))
+	cmd.AddCommand(cmdComment.NewCmdComment(f, nil))
 	cmd.AddCommand(cmdCreate.NewCmdCreate(f, nil))
+	cmd.AddCommand(cmdDelete.NewCmdDelete(f, nil))
+	cmd.AddCommand(cmdEdit.NewCmdEdit(f, nil))
 	cmd.AddCommand(cmdList.NewCmdList(f, nil))
+	cmd.AddCommand(cmdLock.NewCmdLock(f, nil))
+	cmd.AddCommand(cmdPin.NewCmdPin(f, nil))
 	cmd.AddCommand(cmdReopen.NewCmdReopen(f, nil))
 	cmd.AddCommand(cmdStatus.NewCmdStatus(f, nil))
+	cmd.AddCommand(cmdSubscribe.NewCmdSubscribe(f, nil))
+	cmd.AddCommand(cmdTransfer.NewCmdTransfer(f, nil))
+	cmd.AddCommand(cmdTriage.NewCmdTriage(f, nil))
+	cmd.AddCommand(cmdUnlock.NewCmdUnlock(f, nil))
+	cmd.AddCommand(cmdUnpin.NewCmdUnpin(f, nil))
+	cmd.AddCommand(cmdUnsubscribe.NewCmdUnsubscribe(f, nil))
 	cmd.AddCommand(cmdView.NewCmdView(f, nil))
 
 	return cmd
@@ -7,6 +7,7 @@ import (
 	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/api"
 	"github.com/cli/cli/internal/config"
+	"github.com/cli/cli/internal/ghinstance"
 	"github.com/cli/cli/internal/ghrepo"
 	issueShared "github.com/cli/cli/pkg/cmd/issue/shared"
 	prShared "github.com/cli/cli/pkg/cmd/pr/shared"
@@ -22,7 +23,8 @@ type ListOptions struct {
 	IO         *iostreams.IOStreams
 	BaseRepo   func() (ghrepo.Interface, error)
 
-	WebMode bool
+	WebMode  bool
+	Exporter *cmdutil.Exporter
 
 	Assignee     string
 	Labels       []string
@@ -31,6 +33,8 @@ type ListOptions struct {
 	Author       string
 	Mention      string
 	Milestone    string
+	Search       string
+	Owner        string
 }
 
 func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Command {
@@ -48,6 +52,7 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 			$ gh issue list -A monalisa
 			$ gh issue list --web
 			$ gh issue list --milestone 'MVP'
+			$ gh issue list --search 'no:assignee sort:comments-desc'
 		`),
 		Args: cmdutil.NoArgsQuoteReminder,
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -58,6 +63,14 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 				return &cmdutil.FlagError{Err: fmt.Errorf("invalid limit: %v", opts.LimitResults)}
 			}
 
+			if opts.WebMode && opts.Exporter != nil {
+				return &cmdutil.FlagError{Err: fmt.Errorf("--json is not supported with --web")}
+			}
+
+			if opts.Owner != "" && opts.WebMode {
+				return &cmdutil.FlagError{Err: fmt.Errorf("--owner is not supported with --web")}
+			}
+
 			if runF != nil {
 				return runF(opts)
 			}
@@ -73,6 +86,9 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 	cmd.Flags().StringVarP(&opts.Author, "author", "A", "", "Filter by author")
 	cmd.Flags().StringVar(&opts.Mention, "mention", "", "Filter by mention")
 	cmd.Flags().StringVarP(&opts.Milestone, "milestone", "m", "", "Filter by milestone `number` or `title`")
+	cmd.Flags().StringVarP(&opts.Search, "search", "S", "", "Search issues with a query")
+	cmd.Flags().StringVarP(&opts.Owner, "owner", "O", "", "List issues across all repositories of a user or organization")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, issueShared.IssueListFields)
 
 	return cmd
 }
@@ -84,13 +100,34 @@ func listRun(opts *ListOptions) error {
 	}
 	apiClient := api.NewClientFromHTTP(httpClient)
 
+	isTerminal := opts.IO.IsStdoutTTY()
+
+	// owner-wide listings go through the search API and need no base
+	// repository, so the command works outside of any checkout
+	if opts.Owner != "" {
+		listResult, err := api.IssueListForOwner(apiClient, ghinstance.OverridableDefault(), opts.Owner, opts.State, opts.Labels, opts.Assignee, opts.LimitResults, opts.Author, opts.Mention, opts.Milestone, opts.Search)
+		if err != nil {
+			return err
+		}
+
+		if opts.Exporter != nil {
+			return issueShared.ExportIssues(opts.IO.Out, opts.Exporter.Fields(), listResult.Issues)
+		}
+
+		if isTerminal {
+			title := prShared.ListHeader(opts.Owner, "issue", len(listResult.Issues), listResult.TotalCount, true)
+			fmt.Fprintf(opts.IO.ErrOut, "\n%s\n\n", title)
+		}
+
+		issueShared.PrintIssues(opts.IO, "", len(listResult.Issues), listResult.Issues)
+		return nil
+	}
+
 	baseRepo, err := opts.BaseRepo()
 	if err != nil {
 		return err
 	}
 
-	isTerminal := opts.IO.IsStdoutTTY()
-
 	if opts.WebMode {
 		issueListURL := ghrepo.GenerateRepoURL(baseRepo, "issues")
 		openURL, err := prShared.ListURLWithQuery(issueListURL, prShared.FilterOptions{
@@ -101,6 +138,7 @@ func listRun(opts *ListOptions) error {
 			Author:    opts.Author,
 			Mention:   opts.Mention,
 			Milestone: opts.Milestone,
+			Search:    opts.Search,
 		})
 		if err != nil {
 			return err
@@ -111,17 +149,29 @@ func listRun(opts *ListOptions) error {
 		return utils.OpenInBrowser(openURL)
 	}
 
-	listResult, err := api.IssueList(apiClient, baseRepo, opts.State, opts.Labels, opts.Assignee, opts.LimitResults, opts.Author, opts.Mention, opts.Milestone)
+	if opts.Exporter == nil && !isTerminal {
+		// machine output is rendered page by page as results arrive, so very
+		// large listings don't have to be held in memory before the first row
+		// is written
+		return api.IssueListPaged(apiClient, baseRepo, opts.State, opts.Labels, opts.Assignee, opts.LimitResults, opts.Author, opts.Mention, opts.Milestone, opts.Search, func(page []api.Issue, totalCount int) error {
+			issueShared.PrintIssues(opts.IO, "", len(page), page)
+			return nil
+		})
+	}
+
+	listResult, err := api.IssueList(apiClient, baseRepo, opts.State, opts.Labels, opts.Assignee, opts.LimitResults, opts.Author, opts.Mention, opts.Milestone, opts.Search)
 	if err != nil {
 		return err
 	}
 
-	if isTerminal {
-		hasFilters := opts.State != "open" || len(opts.Labels) > 0 || opts.Assignee != "" || opts.Author != "" || opts.Mention != "" || opts.Milestone != ""
-		title := prShared.ListHeader(ghrepo.FullName(baseRepo), "issue", len(listResult.Issues), listResult.TotalCount, hasFilters)
-		fmt.Fprintf(opts.IO.ErrOut, "\n%s\n\n", title)
+	if opts.Exporter != nil {
+		return issueShared.ExportIssues(opts.IO.Out, opts.Exporter.Fields(), listResult.Issues)
 	}
 
+	hasFilters := opts.State != "open" || len(opts.Labels) > 0 || opts.Assignee != "" || opts.Author != "" || opts.Mention != "" || opts.Milestone != "" || opts.Search != ""
+	title := prShared.ListHeader(ghrepo.FullName(baseRepo), "issue", len(listResult.Issues), listResult.TotalCount, hasFilters)
+	fmt.Fprintf(opts.IO.ErrOut, "\n%s\n\n", title)
+
 	issueShared.PrintIssues(opts.IO, "", len(listResult.Issues), listResult.Issues)
 
 	return nil
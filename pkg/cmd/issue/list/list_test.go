@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"os/exec"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/cli/cli/internal/config"
@@ -84,6 +85,81 @@ func TestIssueList_nontty(t *testing.T) {
 		`4[\t]+number fore[\t]+label[\t]+\d+`)
 }
 
+func TestIssueList_search(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query IssueSearch\b`),
+		httpmock.GraphQLQuery(`
+		{ "data": { "search": {
+			"issueCount": 2,
+			"nodes": [
+				{ "number": 5, "title": "needs an owner", "state": "OPEN", "updatedAt": "2020-01-01T00:00:00Z", "labels": {"nodes": []} },
+				{ "number": 7, "title": "also needs an owner", "state": "OPEN", "updatedAt": "2020-01-01T00:00:00Z", "labels": {"nodes": []} }
+			],
+			"pageInfo": { "hasNextPage": false, "endCursor": "" }
+		} } }
+		`, func(_ string, vars map[string]interface{}) {
+			assert.Equal(t, `repo:OWNER/REPO is:issue sort:created-desc state:open label:"bug" no:assignee`, vars["q"])
+		}))
+
+	output, err := runCommand(http, false, `--label bug --search "no:assignee"`)
+	if err != nil {
+		t.Errorf("error running command `issue list`: %v", err)
+	}
+
+	test.ExpectLines(t, output.String(),
+		`5[\t]+OPEN[\t]+needs an owner`,
+		`7[\t]+OPEN[\t]+also needs an owner`)
+}
+
+func TestIssueList_nontty_paginated(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query IssueList\b`),
+		httpmock.StringResponse(`
+		{ "data": { "repository": {
+			"hasIssuesEnabled": true,
+			"issues": {
+				"totalCount": 3,
+				"nodes": [
+					{ "number": 1, "title": "page one issue", "state": "OPEN", "updatedAt": "2020-01-01T00:00:00Z", "labels": {"nodes": []} },
+					{ "number": 2, "title": "another page one issue", "state": "OPEN", "updatedAt": "2020-01-01T00:00:00Z", "labels": {"nodes": []} }
+				],
+				"pageInfo": { "hasNextPage": true, "endCursor": "CURSOR" }
+			}
+		} } }
+		`))
+	http.Register(
+		httpmock.GraphQL(`query IssueList\b`),
+		httpmock.StringResponse(`
+		{ "data": { "repository": {
+			"hasIssuesEnabled": true,
+			"issues": {
+				"totalCount": 3,
+				"nodes": [
+					{ "number": 3, "title": "page two issue", "state": "OPEN", "updatedAt": "2020-01-01T00:00:00Z", "labels": {"nodes": []} }
+				],
+				"pageInfo": { "hasNextPage": false, "endCursor": "" }
+			}
+		} } }
+		`))
+
+	output, err := runCommand(http, false, "")
+	if err != nil {
+		t.Errorf("error running command `issue list`: %v", err)
+	}
+
+	eq(t, output.Stderr(), "")
+	test.ExpectLines(t, output.String(),
+		`1[\t]+OPEN[\t]+page one issue`,
+		`2[\t]+OPEN[\t]+another page one issue`,
+		`3[\t]+OPEN[\t]+page two issue`)
+}
+
 func TestIssueList_tty(t *testing.T) {
 	http := &httpmock.Registry{}
 	defer http.Verify(t)
@@ -275,3 +351,81 @@ func TestIssueList_milestoneByNumber(t *testing.T) {
 		t.Fatalf("error running issue list: %v", err)
 	}
 }
+
+func TestIssueList_json(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query IssueList\b`),
+		httpmock.FileResponse("./fixtures/issueList.json"))
+
+	output, err := runCommand(http, false, `--json number,title`)
+	if err != nil {
+		t.Fatalf("error running command `issue list`: %v", err)
+	}
+
+	assert.Equal(t, "", output.Stderr())
+
+	var issues []map[string]interface{}
+	if err := json.Unmarshal(output.OutBuf.Bytes(), &issues); err != nil {
+		t.Fatalf("invalid JSON output: %v", err)
+	}
+	if len(issues) != 3 {
+		t.Fatalf("expected 3 issues, got %d", len(issues))
+	}
+	assert.Equal(t, float64(1), issues[0]["number"])
+	assert.Equal(t, "number won", issues[0]["title"])
+	if _, ok := issues[0]["state"]; ok {
+		t.Error("expected state to be omitted")
+	}
+}
+
+func TestIssueList_jsonInvalidField(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	_, err := runCommand(http, false, `--json number,colour`)
+	if err == nil || !strings.HasPrefix(err.Error(), `unknown JSON field "colour"`) {
+		t.Errorf("got error %v", err)
+	}
+}
+
+func TestIssueList_owner(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query IssueSearch\b`),
+		httpmock.GraphQLQuery(`
+		{ "data": { "search": {
+			"issueCount": 2,
+			"nodes": [
+				{ "number": 5, "title": "org wide one", "state": "OPEN", "updatedAt": "2020-01-01T00:00:00Z", "labels": {"nodes": []} },
+				{ "number": 7, "title": "org wide two", "state": "OPEN", "updatedAt": "2020-01-01T00:00:00Z", "labels": {"nodes": []} }
+			],
+			"pageInfo": { "hasNextPage": false, "endCursor": "" }
+		} } }
+		`, func(_ string, vars map[string]interface{}) {
+			assert.Equal(t, `user:octo-org is:issue sort:created-desc state:open `, vars["q"])
+		}))
+
+	output, err := runCommand(http, false, "--owner octo-org")
+	if err != nil {
+		t.Errorf("error running command `issue list`: %v", err)
+	}
+
+	test.ExpectLines(t, output.String(),
+		`5[\t]+OPEN[\t]+org wide one`,
+		`7[\t]+OPEN[\t]+org wide two`)
+}
+
+func TestIssueList_ownerWithWeb(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	_, err := runCommand(http, true, "--owner octo-org --web")
+	if err == nil || err.Error() != "--owner is not supported with --web" {
+		t.Fatalf("got error: %v", err)
+	}
+}
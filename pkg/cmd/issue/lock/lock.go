@@ -0,0 +1,108 @@
+package lock
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/internal/config"
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/cmd/issue/shared"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/cli/cli/utils"
+	"github.com/shurcooL/githubv4"
+	"github.com/spf13/cobra"
+)
+
+var lockReasons = map[string]githubv4.LockReason{
+	"off-topic":  githubv4.LockReasonOffTopic,
+	"too-heated": githubv4.LockReasonTooHeated,
+	"resolved":   githubv4.LockReasonResolved,
+	"spam":       githubv4.LockReasonSpam,
+}
+
+type LockOptions struct {
+	HttpClient func() (*http.Client, error)
+	Config     func() (config.Config, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	SelectorArg string
+	Reason      string
+}
+
+func NewCmdLock(f *cmdutil.Factory, runF func(*LockOptions) error) *cobra.Command {
+	opts := &LockOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		Config:     f.Config,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "lock {<number> | <url>}",
+		Short: "Lock issue conversation",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+
+			if len(args) > 0 {
+				opts.SelectorArg = args[0]
+			}
+
+			if opts.Reason != "" {
+				if _, ok := lockReasons[opts.Reason]; !ok {
+					return &cmdutil.FlagError{Err: fmt.Errorf("invalid reason %q: must be one of %s", opts.Reason, strings.Join(reasonNames(), ", "))}
+				}
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return lockRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Reason, "reason", "r", "", fmt.Sprintf("Reason for locking: {%s}", strings.Join(reasonNames(), "|")))
+
+	return cmd
+}
+
+func reasonNames() []string {
+	names := make([]string, 0, len(lockReasons))
+	for name := range lockReasons {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func lockRun(opts *LockOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	issue, baseRepo, err := shared.IssueFromArg(apiClient, opts.BaseRepo, opts.SelectorArg)
+	if err != nil {
+		return err
+	}
+
+	if issue.Locked {
+		fmt.Fprintf(opts.IO.ErrOut, "%s Issue #%d (%s) is already locked\n", utils.Yellow("!"), issue.Number, issue.Title)
+		return nil
+	}
+
+	err = api.IssueLock(apiClient, baseRepo, *issue, lockReasons[opts.Reason])
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(opts.IO.ErrOut, "%s Locked issue #%d (%s)\n", utils.Red("✔"), issue.Number, issue.Title)
+
+	return nil
+}
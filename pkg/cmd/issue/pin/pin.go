@@ -0,0 +1,75 @@
+package pin
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/cmd/issue/shared"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/cli/cli/utils"
+	"github.com/spf13/cobra"
+)
+
+type PinOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	SelectorArg string
+}
+
+func NewCmdPin(f *cmdutil.Factory, runF func(*PinOptions) error) *cobra.Command {
+	opts := &PinOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "pin {<number> | <url>}",
+		Short: "Pin issue",
+		Long:  `Pin an issue to its repository. A repository can have up to three pinned issues.`,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+
+			opts.SelectorArg = args[0]
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return pinRun(opts)
+		},
+	}
+
+	return cmd
+}
+
+func pinRun(opts *PinOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	issue, baseRepo, err := shared.IssueFromArg(apiClient, opts.BaseRepo, opts.SelectorArg)
+	if err != nil {
+		return err
+	}
+
+	err = api.IssuePin(apiClient, baseRepo, issue)
+	if err != nil {
+		if strings.Contains(err.Error(), "three pinned issues") {
+			return fmt.Errorf("issue #%d could not be pinned: a repository can have at most three pinned issues", issue.Number)
+		}
+		return err
+	}
+
+	fmt.Fprintf(opts.IO.ErrOut, "%s Pinned issue #%d (%s)\n", utils.Green("✔"), issue.Number, issue.Title)
+
+	return nil
+}
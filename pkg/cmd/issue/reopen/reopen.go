@@ -21,6 +21,7 @@ type ReopenOptions struct {
 	BaseRepo   func() (ghrepo.Interface, error)
 
 	SelectorArg string
+	Comment     string
 }
 
 func NewCmdReopen(f *cmdutil.Factory, runF func(*ReopenOptions) error) *cobra.Command {
@@ -49,6 +50,8 @@ func NewCmdReopen(f *cmdutil.Factory, runF func(*ReopenOptions) error) *cobra.Co
 		},
 	}
 
+	cmd.Flags().StringVarP(&opts.Comment, "comment", "c", "", "Leave a comment when reopening")
+
 	return cmd
 }
 
@@ -74,6 +77,13 @@ func reopenRun(opts *ReopenOptions) error {
 		return err
 	}
 
+	if opts.Comment != "" {
+		err = api.CommentCreate(apiClient, baseRepo, issue.ID, opts.Comment)
+		if err != nil {
+			return err
+		}
+	}
+
 	fmt.Fprintf(opts.IO.ErrOut, "%s Reopened issue #%d (%s)\n", utils.Green("✔"), issue.Number, issue.Title)
 
 	return nil
@@ -5,6 +5,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/cli/cli/internal/config"
@@ -117,3 +118,34 @@ func TestIssueReopen_issuesDisabled(t *testing.T) {
 		t.Fatalf("got error: %v", err)
 	}
 }
+
+func TestIssueReopen_withComment(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.StubResponse(200, bytes.NewBufferString(`
+	{ "data": { "repository": {
+		"hasIssuesEnabled": true,
+		"issue": { "number": 2, "title": "The title of the issue", "closed": true}
+	} } }
+	`))
+
+	http.StubResponse(200, bytes.NewBufferString(`{"data": {}}`))
+	http.StubResponse(200, bytes.NewBufferString(`{"data": {}}`))
+
+	output, err := runCommand(http, true, `2 --comment "Still happening on v2.1"`)
+	if err != nil {
+		t.Fatalf("error running command `issue reopen`: %v", err)
+	}
+
+	commentBody, _ := ioutil.ReadAll(http.Requests[2].Body)
+	if !strings.Contains(string(commentBody), "Still happening on v2.1") {
+		t.Errorf("expected comment in request, got %s", string(commentBody))
+	}
+
+	r := regexp.MustCompile(`Reopened issue #2 \(The title of the issue\)`)
+
+	if !r.MatchString(output.Stderr()) {
+		t.Fatalf("output did not match regexp /%s/\n> output\n%q\n", r, output.Stderr())
+	}
+}
@@ -0,0 +1,55 @@
+package shared
+
+import (
+	"fmt"
+
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/internal/ghrepo"
+)
+
+// BulkSearchLimit caps how many issues a --search query can select for a bulk
+// operation in one run
+const BulkSearchLimit = 100
+
+// IssueMatch pairs an issue with the repository it was found in, since
+// selectors given as URLs can point outside the base repository
+type IssueMatch struct {
+	Issue *api.Issue
+	Repo  ghrepo.Interface
+}
+
+// FindIssues resolves the issues a bulk command operates on: explicit
+// selectors (numbers or URLs), or every issue matching a search query when
+// search is non-empty. Search results are refetched one by one so callers see
+// the same fully populated issues a direct lookup would return.
+func FindIssues(apiClient *api.Client, baseRepoFn func() (ghrepo.Interface, error), selectors []string, search string, state string) ([]IssueMatch, error) {
+	if search != "" {
+		baseRepo, err := baseRepoFn()
+		if err != nil {
+			return nil, fmt.Errorf("could not determine base repo: %w", err)
+		}
+		listResult, err := api.IssueList(apiClient, baseRepo, state, nil, "", BulkSearchLimit, "", "", "", search)
+		if err != nil {
+			return nil, err
+		}
+		matches := make([]IssueMatch, 0, len(listResult.Issues))
+		for _, listed := range listResult.Issues {
+			issue, err := api.IssueByNumber(apiClient, baseRepo, listed.Number)
+			if err != nil {
+				return nil, err
+			}
+			matches = append(matches, IssueMatch{Issue: issue, Repo: baseRepo})
+		}
+		return matches, nil
+	}
+
+	matches := make([]IssueMatch, 0, len(selectors))
+	for _, selector := range selectors {
+		issue, baseRepo, err := IssueFromArg(apiClient, baseRepoFn, selector)
+		if err != nil {
+			return nil, err
+		}
+		matches = append(matches, IssueMatch{Issue: issue, Repo: baseRepo})
+	}
+	return matches, nil
+}
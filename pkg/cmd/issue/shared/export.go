@@ -0,0 +1,104 @@
+package shared
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/pkg/cmdutil"
+)
+
+// IssueListFields are the fields that `issue list --json` can output
+var IssueListFields = []cmdutil.JSONField{
+	{Name: "number", Type: "number", Example: `123`},
+	{Name: "title", Type: "string", Example: `"Crash on startup"`},
+	{Name: "state", Type: "string", Example: `"OPEN"`},
+	{Name: "stateReason", Type: "string", Example: `"COMPLETED"`},
+	{Name: "url", Type: "string", Example: `"https://github.com/OWNER/REPO/issues/123"`},
+	{Name: "createdAt", Type: "string", Example: `"2020-01-01T12:00:00Z"`},
+	{Name: "author", Type: "object", Example: `{"login": "octocat"}`},
+	{Name: "labels", Type: "array", Example: `[{"name": "bug"}]`},
+	{Name: "assignees", Type: "array", Example: `[{"login": "octocat"}]`},
+	{Name: "milestone", Type: "object", Example: `{"title": "v1.0"}`},
+}
+
+// IssueViewFields are the fields that `issue view --json` can output
+var IssueViewFields = append([]cmdutil.JSONField{
+	{Name: "body", Type: "string", Example: `"It crashes every time"`},
+	{Name: "closed", Type: "boolean", Example: `false`},
+	{Name: "comments", Type: "number", Example: `3`},
+	{Name: "updatedAt", Type: "string", Example: `"2020-01-02T12:00:00Z"`},
+	{Name: "projectCards", Type: "array", Example: `[{"project": "Roadmap", "column": "To do"}]`},
+}, IssueListFields...)
+
+// ExportIssues writes issues to w as a JSON array limited to the selected fields
+func ExportIssues(w io.Writer, fields []string, issues []api.Issue) error {
+	data := make([]map[string]interface{}, 0, len(issues))
+	for i := range issues {
+		data = append(data, exportIssue(&issues[i], fields))
+	}
+	return json.NewEncoder(w).Encode(data)
+}
+
+// ExportIssue writes issue to w as a JSON object limited to the selected fields
+func ExportIssue(w io.Writer, fields []string, issue *api.Issue) error {
+	return json.NewEncoder(w).Encode(exportIssue(issue, fields))
+}
+
+func exportIssue(issue *api.Issue, fields []string) map[string]interface{} {
+	data := make(map[string]interface{}, len(fields))
+	for _, f := range fields {
+		switch f {
+		case "number":
+			data[f] = issue.Number
+		case "title":
+			data[f] = issue.Title
+		case "state":
+			data[f] = issue.State
+		case "stateReason":
+			data[f] = issue.StateReason
+		case "closed":
+			data[f] = issue.Closed
+		case "url":
+			data[f] = issue.URL
+		case "body":
+			data[f] = issue.Body
+		case "createdAt":
+			data[f] = issue.CreatedAt
+		case "updatedAt":
+			data[f] = issue.UpdatedAt
+		case "comments":
+			data[f] = issue.Comments.TotalCount
+		case "author":
+			data[f] = map[string]string{"login": issue.Author.Login}
+		case "labels":
+			labels := make([]map[string]string, 0, len(issue.Labels.Nodes))
+			for _, l := range issue.Labels.Nodes {
+				labels = append(labels, map[string]string{"name": l.Name})
+			}
+			data[f] = labels
+		case "assignees":
+			assignees := make([]map[string]string, 0, len(issue.Assignees.Nodes))
+			for _, a := range issue.Assignees.Nodes {
+				assignees = append(assignees, map[string]string{"login": a.Login})
+			}
+			data[f] = assignees
+		case "milestone":
+			if issue.Milestone.Title == "" {
+				data[f] = nil
+			} else {
+				data[f] = map[string]string{"title": issue.Milestone.Title}
+			}
+		case "projectCards":
+			cards := make([]map[string]string, 0, len(issue.ProjectCards.Nodes))
+			for _, c := range issue.ProjectCards.Nodes {
+				cards = append(cards, map[string]string{
+					"project": c.Project.Name,
+					"column":  c.Column.Name,
+				})
+			}
+			data[f] = cards
+		}
+	}
+	return data
+}
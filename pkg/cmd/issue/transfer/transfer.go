@@ -0,0 +1,105 @@
+package transfer
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/internal/ghrepo"
+	issueShared "github.com/cli/cli/pkg/cmd/issue/shared"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/cli/cli/pkg/prompt"
+	"github.com/spf13/cobra"
+)
+
+type TransferOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	SelectorArg string
+	DestRepoArg string
+	SkipConfirm bool
+}
+
+func NewCmdTransfer(f *cmdutil.Factory, runF func(*TransferOptions) error) *cobra.Command {
+	opts := &TransferOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "transfer {<number> | <url>} <destination-repo>",
+		Short: "Transfer an issue to another repository",
+		Example: heredoc.Doc(`
+			$ gh issue transfer 123 OWNER/REPO
+		`),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+
+			opts.SelectorArg = args[0]
+			opts.DestRepoArg = args[1]
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return transferRun(opts)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&opts.SkipConfirm, "yes", "y", false, "Skip the confirmation prompt")
+
+	return cmd
+}
+
+func transferRun(opts *TransferOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	issue, baseRepo, err := issueShared.IssueFromArg(apiClient, opts.BaseRepo, opts.SelectorArg)
+	if err != nil {
+		return err
+	}
+
+	destRepo, err := ghrepo.FromFullName(opts.DestRepoArg)
+	if err != nil {
+		return fmt.Errorf("argument error: %w", err)
+	}
+
+	if !opts.SkipConfirm && opts.IO.CanPrompt() {
+		var confirmed bool
+		err := prompt.SurveyAskOne(&survey.Confirm{
+			Message: fmt.Sprintf("Transfer issue #%d from %s to %s?", issue.Number, ghrepo.FullName(baseRepo), ghrepo.FullName(destRepo)),
+			Default: true,
+		}, &confirmed)
+		if err != nil {
+			return err
+		}
+
+		if !confirmed {
+			return cmdutil.SilentError
+		}
+	}
+
+	destRepoInfo, err := api.GitHubRepo(apiClient, destRepo)
+	if err != nil {
+		return err
+	}
+
+	url, err := api.IssueTransfer(apiClient, baseRepo, issue, destRepoInfo.ID)
+	if err != nil {
+		return fmt.Errorf("API call failed: %w", err)
+	}
+
+	fmt.Fprintln(opts.IO.Out, url)
+
+	return nil
+}
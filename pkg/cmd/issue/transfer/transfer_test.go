@@ -0,0 +1,109 @@
+package transfer
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/httpmock"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/cli/cli/test"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func runCommand(rt http.RoundTripper, isTTY bool, cli string) (*test.CmdOut, error) {
+	io, _, stdout, stderr := iostreams.Test()
+	io.SetStdoutTTY(isTTY)
+	io.SetStdinTTY(isTTY)
+	io.SetStderrTTY(isTTY)
+
+	factory := &cmdutil.Factory{
+		IOStreams: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: rt}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+	}
+
+	cmd := NewCmdTransfer(factory, nil)
+
+	cli = strings.TrimPrefix(cli, "issue transfer")
+	argv, err := shlex.Split(cli)
+	if err != nil {
+		return nil, err
+	}
+	cmd.SetArgs(argv)
+
+	cmd.SetIn(&bytes.Buffer{})
+	cmd.SetOut(ioutil.Discard)
+	cmd.SetErr(ioutil.Discard)
+
+	_, err = cmd.ExecuteC()
+	return &test.CmdOut{
+		OutBuf: stdout,
+		ErrBuf: stderr,
+	}, err
+}
+
+func TestIssueTransfer(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query IssueByNumber\b`),
+		httpmock.StringResponse(`
+		{ "data": { "repository": { "hasIssuesEnabled": true, "issue": {
+			"id": "ISSUE-ID",
+			"number": 123,
+			"title": "A bug in the wrong repo",
+			"url": "https://github.com/OWNER/REPO/issues/123"
+		} } } }`))
+	http.Register(
+		httpmock.GraphQL(`query RepositoryInfo\b`),
+		httpmock.StringResponse(`
+		{ "data": { "repository": {
+			"id": "DEST-ID",
+			"hasIssuesEnabled": true
+		} } }`))
+	http.Register(
+		httpmock.GraphQL(`mutation IssueTransfer\b`),
+		httpmock.GraphQLMutation(`
+		{ "data": { "transferIssue": { "issue": {
+			"url": "https://github.com/OWNER/OTHER/issues/1"
+		} } } }`,
+			func(inputs map[string]interface{}) {
+				assert.Equal(t, "ISSUE-ID", inputs["issueId"])
+				assert.Equal(t, "DEST-ID", inputs["repositoryId"])
+			}))
+
+	output, err := runCommand(http, false, "issue transfer 123 OWNER/OTHER")
+	if err != nil {
+		t.Fatalf("error running command `issue transfer`: %v", err)
+	}
+
+	assert.Equal(t, "https://github.com/OWNER/OTHER/issues/1\n", output.String())
+}
+
+func TestIssueTransfer_badDestination(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query IssueByNumber\b`),
+		httpmock.StringResponse(`
+		{ "data": { "repository": { "hasIssuesEnabled": true, "issue": {
+			"id": "ISSUE-ID",
+			"number": 123,
+			"url": "https://github.com/OWNER/REPO/issues/123"
+		} } } }`))
+
+	_, err := runCommand(http, false, "issue transfer 123 not-a-repo")
+	assert.EqualError(t, err, `argument error: expected the "[HOST/]OWNER/REPO" format, got "not-a-repo"`)
+}
@@ -0,0 +1,357 @@
+package triage
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/internal/config"
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/cli/cli/pkg/prompt"
+	"github.com/cli/cli/utils"
+	"github.com/shurcooL/githubv4"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+type TriageOptions struct {
+	HttpClient func() (*http.Client, error)
+	Config     func() (config.Config, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	Limit int
+}
+
+func NewCmdTriage(f *cmdutil.Factory, runF func(*TriageOptions) error) *cobra.Command {
+	opts := &TriageOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		Config:     f.Config,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "triage",
+		Short: "Triage issues one at a time",
+		Long: heredoc.Doc(`
+			Interactively walk through the open issues in a repository that have no labels yet.
+
+			For each issue you can add labels, an assignee, or a milestone, close it, or skip
+			it. Mutations are queued up and applied in one batch when you finish. Skipped
+			issues are remembered so a later triage session resumes where you left off.
+		`),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+
+			if opts.Limit < 1 {
+				return &cmdutil.FlagError{Err: fmt.Errorf("invalid limit: %v", opts.Limit)}
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return triageRun(opts)
+		},
+	}
+
+	cmd.Flags().IntVarP(&opts.Limit, "limit", "L", 50, "Maximum number of issues to triage")
+
+	return cmd
+}
+
+type triageAction struct {
+	issue   api.Issue
+	summary string
+	apply   func(apiClient *api.Client, repo ghrepo.Interface) error
+}
+
+func triageRun(opts *TriageOptions) error {
+	if !opts.IO.CanPrompt() {
+		return errors.New("issue triage requires an interactive terminal")
+	}
+
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	opts.IO.StartProgressIndicator()
+	listResult, err := api.IssueList(apiClient, baseRepo, "open", nil, "", opts.Limit, "", "", "", "")
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	skipped := loadSkippedIssues(ghrepo.FullName(baseRepo))
+
+	untriaged := []api.Issue{}
+	for _, issue := range listResult.Issues {
+		if issue.Labels.TotalCount > 0 || skipped[issue.Number] {
+			continue
+		}
+		untriaged = append(untriaged, issue)
+	}
+
+	out := opts.IO.Out
+
+	if len(untriaged) == 0 {
+		fmt.Fprintf(out, "%s No untriaged issues in %s\n", utils.GreenCheck(), ghrepo.FullName(baseRepo))
+		return nil
+	}
+
+	var metadata *api.RepoMetadataResult
+	fetchMetadata := func() (*api.RepoMetadataResult, error) {
+		if metadata != nil {
+			return metadata, nil
+		}
+		opts.IO.StartProgressIndicator()
+		var err error
+		metadata, err = api.RepoMetadata(apiClient, baseRepo, api.RepoMetadataInput{
+			Assignees:  true,
+			Labels:     true,
+			Milestones: true,
+		})
+		opts.IO.StopProgressIndicator()
+		return metadata, err
+	}
+
+	actions := []triageAction{}
+
+	for i, issue := range untriaged {
+		fmt.Fprintf(out, "\n%s %s\n", utils.Bold(fmt.Sprintf("#%d", issue.Number)), utils.Bold(issue.Title))
+		fmt.Fprintf(out, "%s\n", utils.Gray(fmt.Sprintf("opened by %s • issue %d of %d", issue.Author.Login, i+1, len(untriaged))))
+		if issue.Body != "" {
+			md, err := utils.RenderMarkdown(issue.Body)
+			if err != nil {
+				md = issue.Body + "\n"
+			}
+			fmt.Fprint(out, md)
+		}
+
+		var choice string
+		err := prompt.SurveyAskOne(&survey.Select{
+			Message: "What would you like to do?",
+			Options: []string{"label", "assign", "milestone", "close", "skip", "quit"},
+		}, &choice)
+		if err != nil {
+			return fmt.Errorf("could not prompt: %w", err)
+		}
+
+		if choice == "quit" {
+			break
+		}
+
+		switch choice {
+		case "label":
+			meta, err := fetchMetadata()
+			if err != nil {
+				return err
+			}
+			names := []string{}
+			for _, l := range meta.Labels {
+				names = append(names, l.Name)
+			}
+			var selected []string
+			err = prompt.SurveyAskOne(&survey.MultiSelect{
+				Message: "Labels",
+				Options: names,
+			}, &selected)
+			if err != nil {
+				return fmt.Errorf("could not prompt: %w", err)
+			}
+			labelIDs, err := meta.LabelsToIDs(selected)
+			if err != nil {
+				return err
+			}
+			issueID := issue.ID
+			actions = append(actions, triageAction{
+				issue:   issue,
+				summary: fmt.Sprintf("label %v", selected),
+				apply: func(apiClient *api.Client, repo ghrepo.Interface) error {
+					ids := toV4IDs(labelIDs)
+					return api.IssueUpdate(apiClient, repo, githubv4.UpdateIssueInput{
+						ID:       issueID,
+						LabelIDs: &ids,
+					})
+				},
+			})
+		case "assign":
+			meta, err := fetchMetadata()
+			if err != nil {
+				return err
+			}
+			logins := []string{}
+			for _, u := range meta.AssignableUsers {
+				logins = append(logins, u.Login)
+			}
+			var assignee string
+			err = prompt.SurveyAskOne(&survey.Select{
+				Message: "Assignee",
+				Options: logins,
+			}, &assignee)
+			if err != nil {
+				return fmt.Errorf("could not prompt: %w", err)
+			}
+			userIDs, err := meta.MembersToIDs([]string{assignee})
+			if err != nil {
+				return err
+			}
+			issueID := issue.ID
+			actions = append(actions, triageAction{
+				issue:   issue,
+				summary: fmt.Sprintf("assign to %s", assignee),
+				apply: func(apiClient *api.Client, repo ghrepo.Interface) error {
+					ids := toV4IDs(userIDs)
+					return api.IssueUpdate(apiClient, repo, githubv4.UpdateIssueInput{
+						ID:          issueID,
+						AssigneeIDs: &ids,
+					})
+				},
+			})
+		case "milestone":
+			meta, err := fetchMetadata()
+			if err != nil {
+				return err
+			}
+			if len(meta.Milestones) == 0 {
+				fmt.Fprintf(opts.IO.ErrOut, "%s This repository has no milestones\n", utils.Yellow("!"))
+				continue
+			}
+			titles := []string{}
+			for _, m := range meta.Milestones {
+				titles = append(titles, m.Title)
+			}
+			var title string
+			err = prompt.SurveyAskOne(&survey.Select{
+				Message: "Milestone",
+				Options: titles,
+			}, &title)
+			if err != nil {
+				return fmt.Errorf("could not prompt: %w", err)
+			}
+			milestoneID, err := meta.MilestoneToID(title)
+			if err != nil {
+				return err
+			}
+			issueID := issue.ID
+			actions = append(actions, triageAction{
+				issue:   issue,
+				summary: fmt.Sprintf("milestone %q", title),
+				apply: func(apiClient *api.Client, repo ghrepo.Interface) error {
+					id := githubv4.ID(milestoneID)
+					return api.IssueUpdate(apiClient, repo, githubv4.UpdateIssueInput{
+						ID:          issueID,
+						MilestoneID: &id,
+					})
+				},
+			})
+		case "close":
+			closeIssue := issue
+			actions = append(actions, triageAction{
+				issue:   issue,
+				summary: "close",
+				apply: func(apiClient *api.Client, repo ghrepo.Interface) error {
+					return api.IssueClose(apiClient, repo, closeIssue, "")
+				},
+			})
+		case "skip":
+			skipped[issue.Number] = true
+		}
+	}
+
+	if err := saveSkippedIssues(ghrepo.FullName(baseRepo), skipped); err != nil {
+		fmt.Fprintf(opts.IO.ErrOut, "%s failed to record skipped issues: %v\n", utils.Yellow("!"), err)
+	}
+
+	if len(actions) == 0 {
+		fmt.Fprintf(out, "\nNothing to do.\n")
+		return nil
+	}
+
+	fmt.Fprintf(out, "\nApplying %s...\n", utils.Pluralize(len(actions), "change"))
+
+	failed := 0
+	for _, action := range actions {
+		err := action.apply(apiClient, baseRepo)
+		if err != nil {
+			failed++
+			fmt.Fprintf(opts.IO.ErrOut, "%s #%d: %s: %v\n", utils.RedX(), action.issue.Number, action.summary, err)
+			continue
+		}
+		fmt.Fprintf(out, "%s #%d: %s\n", utils.GreenCheck(), action.issue.Number, action.summary)
+	}
+
+	if failed > 0 {
+		return cmdutil.SilentError
+	}
+	return nil
+}
+
+func toV4IDs(ids []string) []githubv4.ID {
+	result := make([]githubv4.ID, 0, len(ids))
+	for _, id := range ids {
+		result = append(result, githubv4.ID(id))
+	}
+	return result
+}
+
+// triageStateFile records, per repository, which issues were skipped during
+// earlier triage sessions so they are not presented again.
+func triageStateFile() string {
+	return path.Join(config.ConfigDir(), "triage.yml")
+}
+
+func loadSkippedIssues(repoName string) map[int]bool {
+	skipped := map[int]bool{}
+	data, err := ioutil.ReadFile(triageStateFile())
+	if err != nil {
+		return skipped
+	}
+	state := map[string][]int{}
+	if err := yaml.Unmarshal(data, &state); err != nil {
+		return skipped
+	}
+	for _, number := range state[repoName] {
+		skipped[number] = true
+	}
+	return skipped
+}
+
+func saveSkippedIssues(repoName string, skipped map[int]bool) error {
+	state := map[string][]int{}
+	if data, err := ioutil.ReadFile(triageStateFile()); err == nil {
+		_ = yaml.Unmarshal(data, &state)
+	}
+
+	numbers := []int{}
+	for number := range skipped {
+		numbers = append(numbers, number)
+	}
+	state[repoName] = numbers
+
+	data, err := yaml.Marshal(state)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(config.ConfigDir(), 0771); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(triageStateFile(), data, 0600)
+}
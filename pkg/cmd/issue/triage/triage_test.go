@@ -0,0 +1,110 @@
+package triage
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/internal/config"
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/httpmock"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewCmdTriage(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    string
+		want    TriageOptions
+		wantErr string
+	}{
+		{
+			name: "no arguments",
+			args: "",
+			want: TriageOptions{
+				Limit: 50,
+			},
+		},
+		{
+			name: "limit",
+			args: "--limit 10",
+			want: TriageOptions{
+				Limit: 10,
+			},
+		},
+		{
+			name:    "invalid limit",
+			args:    "--limit 0",
+			wantErr: "invalid limit: 0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, _, _ := iostreams.Test()
+
+			f := &cmdutil.Factory{
+				IOStreams: io,
+			}
+
+			var opts *TriageOptions
+			cmd := NewCmdTriage(f, func(o *TriageOptions) error {
+				opts = o
+				return nil
+			})
+			cmd.PersistentFlags().StringP("repo", "R", "", "")
+
+			argv, err := shlex.Split(tt.args)
+			require.NoError(t, err)
+			cmd.SetArgs(argv)
+
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(ioutil.Discard)
+			cmd.SetErr(ioutil.Discard)
+
+			_, err = cmd.ExecuteC()
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.want.Limit, opts.Limit)
+		})
+	}
+}
+
+func TestIssueTriage_notty(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	io, _, _, _ := iostreams.Test()
+	io.SetStdoutTTY(false)
+	io.SetStdinTTY(false)
+
+	factory := &cmdutil.Factory{
+		IOStreams: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Config: func() (config.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+	}
+
+	cmd := NewCmdTriage(factory, nil)
+	cmd.SetArgs([]string{})
+	cmd.SetIn(&bytes.Buffer{})
+	cmd.SetOut(ioutil.Discard)
+	cmd.SetErr(ioutil.Discard)
+
+	_, err := cmd.ExecuteC()
+	assert.EqualError(t, err, "issue triage requires an interactive terminal")
+}
@@ -0,0 +1,83 @@
+package unpin
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/httpmock"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/cli/cli/test"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func runCommand(rt http.RoundTripper, isTTY bool, cli string) (*test.CmdOut, error) {
+	io, _, stdout, stderr := iostreams.Test()
+	io.SetStdoutTTY(isTTY)
+	io.SetStdinTTY(isTTY)
+	io.SetStderrTTY(isTTY)
+
+	factory := &cmdutil.Factory{
+		IOStreams: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: rt}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+	}
+
+	cmd := NewCmdUnpin(factory, nil)
+
+	cli = strings.TrimPrefix(cli, "issue unpin")
+	argv, err := shlex.Split(cli)
+	if err != nil {
+		return nil, err
+	}
+	cmd.SetArgs(argv)
+
+	cmd.SetIn(&bytes.Buffer{})
+	cmd.SetOut(ioutil.Discard)
+	cmd.SetErr(ioutil.Discard)
+
+	_, err = cmd.ExecuteC()
+	return &test.CmdOut{
+		OutBuf: stdout,
+		ErrBuf: stderr,
+	}, err
+}
+
+func TestIssueUnpin(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query IssueByNumber\b`),
+		httpmock.StringResponse(`
+		{ "data": { "repository": { "hasIssuesEnabled": true, "issue": {
+			"id": "ISSUE-ID",
+			"number": 123,
+			"title": "Roadmap discussion"
+		} } } }`))
+	http.Register(
+		httpmock.GraphQL(`mutation IssueUnpin\b`),
+		httpmock.GraphQLMutation(`
+		{ "data": { "unpinIssue": { "issue": {
+			"id": "ISSUE-ID"
+		} } } }`,
+			func(inputs map[string]interface{}) {
+				assert.Equal(t, "ISSUE-ID", inputs["issueId"])
+			}))
+
+	output, err := runCommand(http, true, "issue unpin 123")
+	if err != nil {
+		t.Fatalf("error running command `issue unpin`: %v", err)
+	}
+
+	assert.Equal(t, "✔ Unpinned issue #123 (Roadmap discussion)\n", output.Stderr())
+}
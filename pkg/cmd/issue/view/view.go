@@ -28,8 +28,14 @@ type ViewOptions struct {
 
 	SelectorArg string
 	WebMode     bool
+	Comments    bool
+	Exporter    *cmdutil.Exporter
 }
 
+// threads longer than this are better read in the browser than dumped into a
+// terminal, and fetching them page by page would take a while anyway
+const longThreadThreshold = 500
+
 func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Command {
 	opts := &ViewOptions{
 		IO:         f.IOStreams,
@@ -56,6 +62,10 @@ func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Comman
 				opts.SelectorArg = args[0]
 			}
 
+			if opts.WebMode && opts.Exporter != nil {
+				return &cmdutil.FlagError{Err: fmt.Errorf("--json is not supported with --web")}
+			}
+
 			if runF != nil {
 				return runF(opts)
 			}
@@ -64,6 +74,8 @@ func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Comman
 	}
 
 	cmd.Flags().BoolVarP(&opts.WebMode, "web", "w", false, "Open an issue in the browser")
+	cmd.Flags().BoolVarP(&opts.Comments, "comments", "c", false, "View issue comments")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, issueShared.IssueViewFields)
 
 	return cmd
 }
@@ -75,11 +87,15 @@ func viewRun(opts *ViewOptions) error {
 	}
 	apiClient := api.NewClientFromHTTP(httpClient)
 
-	issue, _, err := issueShared.IssueFromArg(apiClient, opts.BaseRepo, opts.SelectorArg)
+	issue, baseRepo, err := issueShared.IssueFromArg(apiClient, opts.BaseRepo, opts.SelectorArg)
 	if err != nil {
 		return err
 	}
 
+	if opts.Exporter != nil {
+		return issueShared.ExportIssue(opts.IO.Out, opts.Exporter.Fields(), issue)
+	}
+
 	openURL := issue.URL
 
 	if opts.WebMode {
@@ -88,11 +104,100 @@ func viewRun(opts *ViewOptions) error {
 		}
 		return utils.OpenInBrowser(openURL)
 	}
+
+	var comments *api.CommentsAndTotalCount
+	if opts.Comments {
+		if issue.Comments.TotalCount > longThreadThreshold {
+			if opts.IO.IsStdoutTTY() {
+				fmt.Fprintf(opts.IO.ErrOut, "This issue has %d comments; opening %s in your browser instead.\n",
+					issue.Comments.TotalCount, utils.DisplayURL(openURL))
+			}
+			return utils.OpenInBrowser(openURL)
+		}
+		comments, err = api.IssueComments(apiClient, baseRepo, issue.Number)
+		if err != nil {
+			return err
+		}
+	}
+
 	if opts.IO.IsStdoutTTY() {
-		return printHumanIssuePreview(opts.IO.Out, issue)
+		if err := printHumanIssuePreview(opts.IO.Out, issue); err != nil {
+			return err
+		}
+		if comments != nil {
+			return printHumanComments(opts.IO.Out, comments)
+		}
+		return nil
 	}
 
-	return printRawIssuePreview(opts.IO.Out, issue)
+	if err := printRawIssuePreview(opts.IO.Out, issue); err != nil {
+		return err
+	}
+	if comments != nil {
+		return printRawComments(opts.IO.Out, comments)
+	}
+	return nil
+}
+
+func printRawComments(out io.Writer, comments *api.CommentsAndTotalCount) error {
+	for _, comment := range comments.Comments {
+		fmt.Fprintln(out, "--")
+		fmt.Fprintf(out, "author:\t%s\n", comment.Author.Login)
+		fmt.Fprintf(out, "association:\t%s\n", strings.ToLower(comment.AuthorAssociation))
+		fmt.Fprintf(out, "edited:\t%v\n", comment.IncludesCreatedEdit)
+		fmt.Fprintf(out, "time:\t%s\n", comment.CreatedAt.Format(time.RFC3339))
+		fmt.Fprintf(out, "reactions:\t%s\n", reactionsSummary(comment))
+		fmt.Fprintln(out, comment.Body)
+	}
+	return nil
+}
+
+func printHumanComments(out io.Writer, comments *api.CommentsAndTotalCount) error {
+	fmt.Fprintln(out, utils.Bold(fmt.Sprintf("Showing %s", utils.Pluralize(comments.TotalCount, "comment"))))
+	for _, comment := range comments.Comments {
+		fmt.Fprintln(out)
+		header := fmt.Sprintf("%s • %s", comment.Author.Login, utils.FuzzyAgo(time.Since(comment.CreatedAt)))
+		if comment.IncludesCreatedEdit {
+			header += " • edited"
+		}
+		fmt.Fprintln(out, utils.Gray(header))
+		md, err := utils.RenderMarkdown(comment.Body)
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(out, md)
+		if reactions := reactionsSummary(comment); reactions != "" {
+			fmt.Fprintln(out, utils.Gray(reactions))
+		}
+	}
+	return nil
+}
+
+var reactionEmoji = map[string]string{
+	"THUMBS_UP":   "👍",
+	"THUMBS_DOWN": "👎",
+	"LAUGH":       "😄",
+	"HOORAY":      "🎉",
+	"CONFUSED":    "😕",
+	"HEART":       "❤️",
+	"ROCKET":      "🚀",
+	"EYES":        "👀",
+}
+
+// reactionsSummary tallies the reactions on a comment, e.g. "2 👍 • 1 🎉"
+func reactionsSummary(comment api.Comment) string {
+	parts := []string{}
+	for _, group := range comment.ReactionGroups {
+		if group.Users.TotalCount == 0 {
+			continue
+		}
+		emoji, ok := reactionEmoji[group.Content]
+		if !ok {
+			continue
+		}
+		parts = append(parts, fmt.Sprintf("%d %s", group.Users.TotalCount, emoji))
+	}
+	return strings.Join(parts, " • ")
 }
 
 func printRawIssuePreview(out io.Writer, issue *api.Issue) error {
@@ -2,6 +2,7 @@ package view
 
 import (
 	"bytes"
+	"encoding/json"
 	"io/ioutil"
 	"net/http"
 	"os/exec"
@@ -263,6 +264,88 @@ func TestIssueView_tty_Preview(t *testing.T) {
 	}
 }
 
+func TestIssueView_tty_Comments(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+	http.Register(httpmock.GraphQL(`query IssueByNumber\b`), httpmock.FileResponse("./fixtures/issueView_preview.json"))
+	http.Register(httpmock.GraphQL(`query IssueComments\b`), httpmock.FileResponse("./fixtures/issueViewComments.json"))
+
+	output, err := runCommand(http, true, "123 --comments")
+	if err != nil {
+		t.Fatalf("error running command `issue view --comments`: %v", err)
+	}
+
+	expectedOutputs := []string{
+		`ix of coins`,
+		`Showing 2 comments`,
+		`monalisa •`,
+		`have you tried turning it off and on again`,
+		`2 👍 • 1 🎉`,
+		`octocat •.*edited`,
+		`works on my machine`,
+	}
+	test.ExpectLines(t, output.String(), expectedOutputs...)
+}
+
+func TestIssueView_nontty_Comments(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+	http.Register(httpmock.GraphQL(`query IssueByNumber\b`), httpmock.FileResponse("./fixtures/issueView_preview.json"))
+	http.Register(httpmock.GraphQL(`query IssueComments\b`), httpmock.FileResponse("./fixtures/issueViewComments.json"))
+
+	output, err := runCommand(http, false, "123 --comments")
+	if err != nil {
+		t.Fatalf("error running command `issue view --comments`: %v", err)
+	}
+
+	expectedOutputs := []string{
+		`title:\tix of coins`,
+		`author:\tmonalisa`,
+		`association:\tmember`,
+		`edited:\tfalse`,
+		`time:\t2020-01-01T12:00:00Z`,
+		`reactions:\t2 👍 • 1 🎉`,
+		`have you tried turning it off and on again`,
+		`edited:\ttrue`,
+		`works on my machine`,
+	}
+	test.ExpectLines(t, output.String(), expectedOutputs...)
+}
+
+func TestIssueView_Comments_longThread(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.StubResponse(200, bytes.NewBufferString(`
+	{ "data": { "repository": { "hasIssuesEnabled": true, "issue": {
+		"number": 123,
+		"comments": { "totalCount": 2000 },
+		"url": "https://github.com/OWNER/REPO/issues/123"
+	} } } }
+	`))
+
+	var seenCmd *exec.Cmd
+	restoreCmd := run.SetPrepareCmd(func(cmd *exec.Cmd) run.Runnable {
+		seenCmd = cmd
+		return &test.OutputStub{}
+	})
+	defer restoreCmd()
+
+	output, err := runCommand(http, true, "123 --comments")
+	if err != nil {
+		t.Fatalf("error running command `issue view --comments`: %v", err)
+	}
+
+	eq(t, output.String(), "")
+	eq(t, output.Stderr(), "This issue has 2000 comments; opening github.com/OWNER/REPO/issues/123 in your browser instead.\n")
+
+	if seenCmd == nil {
+		t.Fatal("expected a command to run")
+	}
+	url := seenCmd.Args[len(seenCmd.Args)-1]
+	eq(t, url, "https://github.com/OWNER/REPO/issues/123")
+}
+
 func TestIssueView_web_notFound(t *testing.T) {
 	http := &httpmock.Registry{}
 	defer http.Verify(t)
@@ -338,3 +421,26 @@ func TestIssueView_web_urlArg(t *testing.T) {
 	url := seenCmd.Args[len(seenCmd.Args)-1]
 	eq(t, url, "https://github.com/OWNER/REPO/issues/123")
 }
+
+func TestIssueView_json(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.Register(httpmock.GraphQL(`query IssueByNumber\b`), httpmock.FileResponse("./fixtures/issueView_preview.json"))
+
+	output, err := runCommand(http, false, `123 --json number,title,comments`)
+	if err != nil {
+		t.Fatalf("error running command `issue view`: %v", err)
+	}
+
+	var issue map[string]interface{}
+	if err := json.Unmarshal(output.OutBuf.Bytes(), &issue); err != nil {
+		t.Fatalf("invalid JSON output: %v", err)
+	}
+	eq(t, issue["number"], float64(123))
+	eq(t, issue["title"], "ix of coins")
+	eq(t, issue["comments"], float64(9))
+	if _, ok := issue["state"]; ok {
+		t.Error("expected state to be omitted")
+	}
+}
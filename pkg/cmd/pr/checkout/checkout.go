@@ -28,6 +28,7 @@ type CheckoutOptions struct {
 	Branch     func() (string, error)
 
 	SelectorArg       string
+	BranchName        string
 	RecurseSubmodules bool
 }
 
@@ -64,6 +65,7 @@ func NewCmdCheckout(f *cmdutil.Factory, runF func(*CheckoutOptions) error) *cobr
 		},
 	}
 
+	cmd.Flags().StringVarP(&opts.BranchName, "branch", "b", "", "Local branch name to use (default: the name of the head branch)")
 	cmd.Flags().BoolVarP(&opts.RecurseSubmodules, "recurse-submodules", "", false, "Update all active submodules (recursively)")
 
 	return cmd
@@ -110,7 +112,10 @@ func checkoutRun(opts *CheckoutOptions) error {
 	}
 
 	var cmdQueue [][]string
-	newBranchName := pr.HeadRefName
+	newBranchName, err := localBranchName(opts.BranchName, cfg, pr)
+	if err != nil {
+		return err
+	}
 	if strings.HasPrefix(newBranchName, "-") {
 		return fmt.Errorf("invalid branch name: %q", newBranchName)
 	}
@@ -129,19 +134,32 @@ func checkoutRun(opts *CheckoutOptions) error {
 		} else {
 			cmdQueue = append(cmdQueue, []string{"git", "checkout", "-b", newBranchName, "--no-track", remoteBranch})
 			cmdQueue = append(cmdQueue, []string{"git", "config", fmt.Sprintf("branch.%s.remote", newBranchName), headRemote.Name})
+			if newBranchName != pr.HeadRefName {
+				// ensure pushes go to the PR head even though the names differ
+				cmdQueue = append(cmdQueue, []string{"git", "config", fmt.Sprintf("branch.%s.pushRemote", newBranchName), headRemote.Name})
+			}
 			cmdQueue = append(cmdQueue, []string{"git", "config", fmt.Sprintf("branch.%s.merge", newBranchName), "refs/heads/" + pr.HeadRefName})
 		}
 	} else {
 		// no git remote for PR head
 
+		// the head repository may have been deleted, in which case the pull
+		// request can only be fetched through the base repository's
+		// refs/pull/<number>/head ref
+		headRepoGone := pr.HeadRepository.Name == ""
+
 		defaultBranchName, err := api.RepoDefaultBranch(apiClient, baseRepo)
 		if err != nil {
 			return err
 		}
 
 		// avoid naming the new branch the same as the default branch
-		if newBranchName == defaultBranchName {
-			newBranchName = fmt.Sprintf("%s/%s", pr.HeadRepositoryOwner.Login, newBranchName)
+		if opts.BranchName == "" && newBranchName == defaultBranchName {
+			if headRepoGone {
+				newBranchName = fmt.Sprintf("pr/%d", pr.Number)
+			} else {
+				newBranchName = fmt.Sprintf("%s/%s", pr.HeadRepositoryOwner.Login, newBranchName)
+			}
 		}
 
 		ref := fmt.Sprintf("refs/pull/%d/head", pr.Number)
@@ -157,7 +175,7 @@ func checkoutRun(opts *CheckoutOptions) error {
 
 		remote := baseURLOrName
 		mergeRef := ref
-		if pr.MaintainerCanModify {
+		if pr.MaintainerCanModify && !headRepoGone {
 			headRepo := ghrepo.NewWithHost(pr.HeadRepositoryOwner.Login, pr.HeadRepository.Name, baseRepo.RepoHost())
 			remote = ghrepo.FormatRemoteURL(headRepo, protocol)
 			mergeRef = fmt.Sprintf("refs/heads/%s", pr.HeadRefName)
@@ -184,3 +202,28 @@ func checkoutRun(opts *CheckoutOptions) error {
 
 	return nil
 }
+
+// localBranchName determines the name for the local branch that a pull request
+// is checked out into: an explicit --branch value, a naming scheme from the
+// `pr_checkout_branch_naming` config option, or the head branch name.
+func localBranchName(override string, cfg config.Config, pr *api.PullRequest) (string, error) {
+	if override != "" {
+		return override, nil
+	}
+
+	scheme, _ := cfg.Get("", "pr_checkout_branch_naming")
+	switch scheme {
+	case "", "head":
+		return pr.HeadRefName, nil
+	case "number":
+		return fmt.Sprintf("pr/%d", pr.Number), nil
+	case "author":
+		if pr.HeadRepositoryOwner.Login == "" {
+			// the author's repository is gone; fall back to the PR number
+			return fmt.Sprintf("pr/%d", pr.Number), nil
+		}
+		return fmt.Sprintf("%s/%s", pr.HeadRepositoryOwner.Login, pr.HeadRefName), nil
+	default:
+		return "", fmt.Errorf("unsupported pr_checkout_branch_naming value: %q. Expected one of head, number, or author", scheme)
+	}
+}
@@ -143,6 +143,77 @@ func TestPRCheckout_sameRepo(t *testing.T) {
 	eq(t, strings.Join(ranCommands[3], " "), "git config branch.feature.merge refs/heads/feature")
 }
 
+func TestPRCheckout_customBranch(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.Register(httpmock.GraphQL(`query PullRequestByNumber\b`), httpmock.StringResponse(`
+	{ "data": { "repository": { "pullRequest": {
+		"number": 123,
+		"headRefName": "feature",
+		"headRepositoryOwner": {
+			"login": "hubot"
+		},
+		"headRepository": {
+			"name": "REPO"
+		},
+		"isCrossRepository": false,
+		"maintainerCanModify": false
+	} } } }
+	`))
+
+	ranCommands := [][]string{}
+	restoreCmd := run.SetPrepareCmd(func(cmd *exec.Cmd) run.Runnable {
+		switch strings.Join(cmd.Args, " ") {
+		case "git show-ref --verify -- refs/heads/fixes":
+			return &errorStub{"exit status: 1"}
+		default:
+			ranCommands = append(ranCommands, cmd.Args)
+			return &test.OutputStub{}
+		}
+	})
+	defer restoreCmd()
+
+	output, err := runCommand(http, nil, "master", `123 --branch fixes`)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, "", output.String())
+	if !assert.Equal(t, 5, len(ranCommands)) {
+		return
+	}
+	eq(t, strings.Join(ranCommands[0], " "), "git fetch origin +refs/heads/feature:refs/remotes/origin/feature")
+	eq(t, strings.Join(ranCommands[1], " "), "git checkout -b fixes --no-track origin/feature")
+	eq(t, strings.Join(ranCommands[2], " "), "git config branch.fixes.remote origin")
+	eq(t, strings.Join(ranCommands[3], " "), "git config branch.fixes.pushRemote origin")
+	eq(t, strings.Join(ranCommands[4], " "), "git config branch.fixes.merge refs/heads/feature")
+}
+
+func Test_localBranchName(t *testing.T) {
+	pr := &api.PullRequest{Number: 123, HeadRefName: "feature"}
+	pr.HeadRepositoryOwner.Login = "hubot"
+
+	name, err := localBranchName("custom", config.NewBlankConfig(), pr)
+	assert.NoError(t, err)
+	eq(t, name, "custom")
+
+	name, err = localBranchName("", config.NewBlankConfig(), pr)
+	assert.NoError(t, err)
+	eq(t, name, "feature")
+
+	name, err = localBranchName("", config.NewFromString("pr_checkout_branch_naming: number\n"), pr)
+	assert.NoError(t, err)
+	eq(t, name, "pr/123")
+
+	name, err = localBranchName("", config.NewFromString("pr_checkout_branch_naming: author\n"), pr)
+	assert.NoError(t, err)
+	eq(t, name, "hubot/feature")
+
+	_, err = localBranchName("", config.NewFromString("pr_checkout_branch_naming: bogus\n"), pr)
+	assert.EqualError(t, err, `unsupported pr_checkout_branch_naming value: "bogus". Expected one of head, number, or author`)
+}
+
 func TestPRCheckout_urlArg(t *testing.T) {
 	http := &httpmock.Registry{}
 	defer http.Verify(t)
@@ -566,6 +637,44 @@ func TestPRCheckout_maintainerCanModify(t *testing.T) {
 	eq(t, strings.Join(ranCommands[3], " "), "git config branch.feature.merge refs/heads/feature")
 }
 
+func TestPRCheckout_deletedFork(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.Register(httpmock.GraphQL(`query PullRequestByNumber\b`), httpmock.StringResponse(`
+	{ "data": { "repository": { "pullRequest": {
+		"number": 123,
+		"headRefName": "feature",
+		"headRepositoryOwner": null,
+		"headRepository": null,
+		"isCrossRepository": true,
+		"maintainerCanModify": true
+	} } } }
+	`))
+
+	ranCommands := [][]string{}
+	restoreCmd := run.SetPrepareCmd(func(cmd *exec.Cmd) run.Runnable {
+		switch strings.Join(cmd.Args, " ") {
+		case "git config branch.feature.merge":
+			return &errorStub{"exit status 1"}
+		default:
+			ranCommands = append(ranCommands, cmd.Args)
+			return &test.OutputStub{}
+		}
+	})
+	defer restoreCmd()
+
+	output, err := runCommand(http, nil, "master", `123`)
+	eq(t, err, nil)
+	eq(t, output.String(), "")
+
+	eq(t, len(ranCommands), 4)
+	eq(t, strings.Join(ranCommands[0], " "), "git fetch origin refs/pull/123/head:feature")
+	eq(t, strings.Join(ranCommands[1], " "), "git checkout feature")
+	eq(t, strings.Join(ranCommands[2], " "), "git config branch.feature.remote origin")
+	eq(t, strings.Join(ranCommands[3], " "), "git config branch.feature.merge refs/pull/123/head")
+}
+
 func TestPRCheckout_recurseSubmodules(t *testing.T) {
 	http := &httpmock.Registry{}
 
@@ -25,6 +25,7 @@ type ChecksOptions struct {
 	Remotes    func() (context.Remotes, error)
 
 	SelectorArg string
+	Required    bool
 }
 
 func NewCmdChecks(f *cmdutil.Factory, runF func(*ChecksOptions) error) *cobra.Command {
@@ -60,6 +61,8 @@ func NewCmdChecks(f *cmdutil.Factory, runF func(*ChecksOptions) error) *cobra.Co
 		},
 	}
 
+	cmd.Flags().BoolVar(&opts.Required, "required", false, "Only show checks that are required by branch protection")
+
 	return cmd
 }
 
@@ -70,11 +73,21 @@ func checksRun(opts *ChecksOptions) error {
 	}
 	apiClient := api.NewClientFromHTTP(httpClient)
 
-	pr, _, err := shared.PRFromArgs(apiClient, opts.BaseRepo, opts.Branch, opts.Remotes, opts.SelectorArg)
+	pr, baseRepo, err := shared.PRFromArgs(apiClient, opts.BaseRepo, opts.Branch, opts.Remotes, opts.SelectorArg)
 	if err != nil {
 		return err
 	}
 
+	if opts.Required {
+		// the isRequired field can only be requested when the pull request
+		// number is known, so re-fetch the rollup even when the pull request
+		// was looked up by branch
+		pr, err = api.PullRequestByNumber(apiClient, baseRepo, pr.Number)
+		if err != nil {
+			return err
+		}
+	}
+
 	if len(pr.Commits.Nodes) == 0 {
 		return nil
 	}
@@ -100,6 +113,9 @@ func checksRun(opts *ChecksOptions) error {
 	outputs := []output{}
 
 	for _, c := range pr.Commits.Nodes[0].Commit.StatusCheckRollup.Contexts.Nodes {
+		if opts.Required && !c.IsRequired {
+			continue
+		}
 		mark := "✓"
 		bucket := "pass"
 		state := c.State
@@ -31,6 +31,14 @@ func TestNewCmdChecks(t *testing.T) {
 				SelectorArg: "1234",
 			},
 		},
+		{
+			name: "required flag",
+			cli:  "1234 --required",
+			wants: ChecksOptions{
+				SelectorArg: "1234",
+				Required:    true,
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -57,18 +65,20 @@ func TestNewCmdChecks(t *testing.T) {
 			assert.NoError(t, err)
 
 			assert.Equal(t, tt.wants.SelectorArg, gotOpts.SelectorArg)
+			assert.Equal(t, tt.wants.Required, gotOpts.Required)
 		})
 	}
 }
 
 func Test_checksRun(t *testing.T) {
 	tests := []struct {
-		name    string
-		fixture string
-		stubs   func(*httpmock.Registry)
-		wantOut string
-		nontty  bool
-		wantErr bool
+		name     string
+		fixture  string
+		stubs    func(*httpmock.Registry)
+		wantOut  string
+		nontty   bool
+		required bool
+		wantErr  bool
 	}{
 		{
 			name: "no commits",
@@ -116,6 +126,19 @@ func Test_checksRun(t *testing.T) {
 			wantOut: "Some checks were not successful\n1 failing, 2 successful, and 0 pending checks\n\nX  a status           sweet link\n✓  cool tests  1m26s  sweet link\n✓  rad tests   1m26s  sweet link\n",
 			wantErr: true,
 		},
+		{
+			name:     "only required",
+			required: true,
+			stubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query PullRequestByNumber\b`),
+					httpmock.FileResponse("./fixtures/onlyRequired.json"))
+				reg.Register(
+					httpmock.GraphQL(`query PullRequestByNumber\b`),
+					httpmock.FileResponse("./fixtures/onlyRequired.json"))
+			},
+			wantOut: "All checks were successful\n0 failing, 1 successful, and 0 pending checks\n\n✓  cool tests  1m26s  sweet link\n",
+		},
 		{
 			name:   "no commits",
 			nontty: true,
@@ -168,6 +191,20 @@ func Test_checksRun(t *testing.T) {
 			wantOut: "a status\tfail\t0\tsweet link\ncool tests\tpass\t1m26s\tsweet link\nrad tests\tpass\t1m26s\tsweet link\n",
 			wantErr: true,
 		},
+		{
+			name:     "only required",
+			nontty:   true,
+			required: true,
+			stubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query PullRequestByNumber\b`),
+					httpmock.FileResponse("./fixtures/onlyRequired.json"))
+				reg.Register(
+					httpmock.GraphQL(`query PullRequestByNumber\b`),
+					httpmock.FileResponse("./fixtures/onlyRequired.json"))
+			},
+			wantOut: "cool tests\tpass\t1m26s\tsweet link\n",
+		},
 	}
 
 	for _, tt := range tests {
@@ -181,6 +218,7 @@ func Test_checksRun(t *testing.T) {
 					return ghrepo.New("OWNER", "REPO"), nil
 				},
 				SelectorArg: "123",
+				Required:    tt.required,
 			}
 
 			reg := &httpmock.Registry{}
@@ -23,6 +23,8 @@ type CloseOptions struct {
 	Branch     func() (string, error)
 
 	SelectorArg       string
+	SelectorArgs      []string
+	Comment           string
 	DeleteBranch      bool
 	DeleteLocalBranch bool
 }
@@ -36,15 +38,16 @@ func NewCmdClose(f *cmdutil.Factory, runF func(*CloseOptions) error) *cobra.Comm
 	}
 
 	cmd := &cobra.Command{
-		Use:   "close {<number> | <url> | <branch>}",
+		Use:   "close {<number> | <url> | <branch>}...",
 		Short: "Close a pull request",
-		Args:  cobra.ExactArgs(1),
+		Args:  cobra.MinimumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// support `-R, --repo` override
 			opts.BaseRepo = f.BaseRepo
 
 			if len(args) > 0 {
 				opts.SelectorArg = args[0]
+				opts.SelectorArgs = args
 			}
 
 			opts.DeleteLocalBranch = !cmd.Flags().Changed("repo")
@@ -55,12 +58,22 @@ func NewCmdClose(f *cmdutil.Factory, runF func(*CloseOptions) error) *cobra.Comm
 			return closeRun(opts)
 		},
 	}
+	cmd.Flags().StringVarP(&opts.Comment, "comment", "c", "", "Leave a closing comment")
 	cmd.Flags().BoolVarP(&opts.DeleteBranch, "delete-branch", "d", false, "Delete the local and remote branch after close")
 
 	return cmd
 }
 
 func closeRun(opts *CloseOptions) error {
+	if len(opts.SelectorArgs) > 1 {
+		return shared.BatchRun(opts.IO, opts.SelectorArgs, func(selector string) error {
+			o := *opts
+			o.SelectorArg = selector
+			o.SelectorArgs = []string{selector}
+			return closeRun(&o)
+		})
+	}
+
 	httpClient, err := opts.HttpClient()
 	if err != nil {
 		return err
@@ -80,6 +93,13 @@ func closeRun(opts *CloseOptions) error {
 		return nil
 	}
 
+	if opts.Comment != "" {
+		err := api.CommentCreate(apiClient, baseRepo, pr.ID, opts.Comment)
+		if err != nil {
+			return fmt.Errorf("API call failed: %w", err)
+		}
+	}
+
 	err = api.PullRequestClose(apiClient, baseRepo, pr)
 	if err != nil {
 		return fmt.Errorf("API call failed: %w", err)
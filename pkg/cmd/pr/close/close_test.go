@@ -81,6 +81,87 @@ func TestPrClose(t *testing.T) {
 	}
 }
 
+func TestPrClose_withComment(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.StubResponse(200, bytes.NewBufferString(`
+		{ "data": { "repository": {
+			"pullRequest": { "id": "THE-ID", "number": 96, "title": "The title of the PR" }
+		} } }
+	`))
+
+	http.StubResponse(200, bytes.NewBufferString(`{"data": {}}`)) // CommentCreate
+	http.StubResponse(200, bytes.NewBufferString(`{"id": "THE-ID"}`))
+
+	output, err := runCommand(http, true, `96 --comment "closing in favor of #100"`)
+	if err != nil {
+		t.Fatalf("error running command `pr close`: %v", err)
+	}
+
+	r := regexp.MustCompile(`Closed pull request #96 \(The title of the PR\)`)
+
+	if !r.MatchString(output.Stderr()) {
+		t.Fatalf("output did not match regexp /%s/\n> output\n%q\n", r, output.Stderr())
+	}
+}
+
+func TestPrClose_batch(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.StubResponse(200, bytes.NewBufferString(`
+		{ "data": { "repository": {
+			"pullRequest": { "number": 96, "title": "The title of the PR" }
+		} } }
+	`))
+	http.StubResponse(200, bytes.NewBufferString(`{"id": "THE-ID"}`))
+
+	http.StubResponse(200, bytes.NewBufferString(`
+		{ "data": { "repository": {
+			"pullRequest": { "number": 97, "title": "Another PR" }
+		} } }
+	`))
+	http.StubResponse(200, bytes.NewBufferString(`{"id": "OTHER-ID"}`))
+
+	output, err := runCommand(http, true, "96 97")
+	if err != nil {
+		t.Fatalf("error running command `pr close`: %v", err)
+	}
+
+	test.ExpectLines(t, output.Stderr(),
+		`Closed pull request #96 \(The title of the PR\)`,
+		`Closed pull request #97 \(Another PR\)`)
+}
+
+func TestPrClose_batch_failure(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.StubResponse(200, bytes.NewBufferString(`
+		{ "data": { "repository": {
+			"pullRequest": { "number": 96, "title": "The title of the PR", "state": "MERGED" }
+		} } }
+	`))
+
+	http.StubResponse(200, bytes.NewBufferString(`
+		{ "data": { "repository": {
+			"pullRequest": { "number": 97, "title": "Another PR" }
+		} } }
+	`))
+	http.StubResponse(200, bytes.NewBufferString(`{"id": "OTHER-ID"}`))
+
+	output, err := runCommand(http, true, "96 97")
+	if err != cmdutil.SilentError {
+		t.Fatalf("expected SilentError, got %v", err)
+	}
+
+	test.ExpectLines(t, output.Stderr(),
+		`Pull request #96 \(The title of the PR\) can't be closed because it was already merged`,
+		`Closed pull request #97 \(Another PR\)`,
+		`1 pull request failed`)
+}
+
 func TestPrClose_alreadyClosed(t *testing.T) {
 	http := &httpmock.Registry{}
 	defer http.Verify(t)
@@ -0,0 +1,246 @@
+package comment
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/context"
+	"github.com/cli/cli/internal/config"
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/cmd/pr/shared"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/cli/cli/pkg/prompt"
+	"github.com/cli/cli/pkg/surveyext"
+	"github.com/cli/cli/utils"
+	"github.com/spf13/cobra"
+)
+
+type CommentOptions struct {
+	HttpClient func() (*http.Client, error)
+	Config     func() (config.Config, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+	Remotes    func() (context.Remotes, error)
+	Branch     func() (string, error)
+
+	SelectorArg string
+	Body        string
+	BodyFile    string
+	Editor      bool
+	WebMode     bool
+	EditLast    bool
+	ReplyTo     int
+	Interactive bool
+}
+
+func NewCmdComment(f *cmdutil.Factory, runF func(*CommentOptions) error) *cobra.Command {
+	opts := &CommentOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		Config:     f.Config,
+		Remotes:    f.Remotes,
+		Branch:     f.Branch,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "comment [<number> | <url> | <branch>]",
+		Short: "Create a new pr comment",
+		Long: heredoc.Doc(`
+			Create a new pr comment.
+
+			Without an argument, the pull request that belongs to the current branch is commented on.
+
+			To reply within an existing review thread instead of adding a top-level comment,
+			pass the ID of a review comment to '--reply-to'.
+		`),
+		Example: heredoc.Doc(`
+			$ gh pr comment 22 --body "This looks great, lets get it deployed."
+			$ gh pr comment 22 --body-file comment.md
+			$ gh pr comment 22 --edit-last --body "I take that back."
+			$ gh pr comment 22 --reply-to 336238 --body "Good catch, fixed."
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+
+			if len(args) > 0 {
+				opts.SelectorArg = args[0]
+			}
+
+			inputFlags := 0
+			if cmd.Flags().Changed("body") {
+				inputFlags++
+			}
+			if cmd.Flags().Changed("body-file") {
+				inputFlags++
+			}
+			if opts.Editor {
+				inputFlags++
+			}
+			if opts.WebMode {
+				inputFlags++
+			}
+			if inputFlags > 1 {
+				return &cmdutil.FlagError{Err: errors.New("specify only one of --body, --body-file, --editor, or --web")}
+			}
+			if inputFlags == 0 {
+				if !opts.IO.CanPrompt() {
+					return &cmdutil.FlagError{Err: errors.New("--body, --body-file, --editor, or --web required when not running interactively")}
+				}
+				opts.Interactive = true
+			}
+			if opts.WebMode && opts.EditLast {
+				return &cmdutil.FlagError{Err: errors.New("--edit-last is not supported with --web")}
+			}
+			if opts.ReplyTo > 0 {
+				if opts.WebMode {
+					return &cmdutil.FlagError{Err: errors.New("--reply-to is not supported with --web")}
+				}
+				if opts.EditLast {
+					return &cmdutil.FlagError{Err: errors.New("--reply-to is not supported with --edit-last")}
+				}
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return commentRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Body, "body", "b", "", "Supply a body. Will prompt for one otherwise.")
+	cmd.Flags().StringVarP(&opts.BodyFile, "body-file", "F", "", "Read body text from `file`")
+	cmd.Flags().BoolVarP(&opts.Editor, "editor", "e", false, "Add body using editor")
+	cmd.Flags().BoolVarP(&opts.WebMode, "web", "w", false, "Add body in browser")
+	cmd.Flags().BoolVar(&opts.EditLast, "edit-last", false, "Edit the last comment of the current user")
+	cmd.Flags().IntVar(&opts.ReplyTo, "reply-to", 0, "Reply to the review comment with the given `id` instead of adding a top-level comment")
+
+	return cmd
+}
+
+func commentRun(opts *CommentOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	pr, baseRepo, err := shared.PRFromArgs(apiClient, opts.BaseRepo, opts.Branch, opts.Remotes, opts.SelectorArg)
+	if err != nil {
+		return err
+	}
+
+	if opts.WebMode {
+		openURL := fmt.Sprintf("%s#issuecomment-new", pr.URL)
+		if opts.IO.IsStdoutTTY() {
+			fmt.Fprintf(opts.IO.ErrOut, "Opening %s in your browser.\n", utils.DisplayURL(openURL))
+		}
+		return utils.OpenInBrowser(openURL)
+	}
+
+	body := opts.Body
+	switch {
+	case opts.BodyFile != "":
+		contents, err := cmdutil.ReadFile(opts.BodyFile, opts.IO.In)
+		if err != nil {
+			return err
+		}
+		body = string(contents)
+	case opts.Editor || opts.Interactive:
+		if !opts.IO.CanPrompt() {
+			return errors.New("--editor requires an interactive terminal")
+		}
+		editorCommand, err := cmdutil.DetermineEditor(opts.Config)
+		if err != nil {
+			return err
+		}
+		body, err = commentSurvey(editorCommand)
+		if err != nil {
+			return err
+		}
+	}
+
+	if body == "" {
+		return errors.New("comment body cannot be blank")
+	}
+
+	if opts.ReplyTo > 0 {
+		err = api.CommentReply(apiClient, baseRepo, pr.Number, opts.ReplyTo, body)
+		if err != nil {
+			return err
+		}
+	} else if opts.EditLast {
+		comment, err := lastViewerComment(apiClient, baseRepo, pr.Number)
+		if err != nil {
+			return err
+		}
+		err = api.CommentUpdate(apiClient, baseRepo, comment.ID, body)
+		if err != nil {
+			return err
+		}
+	} else {
+		err = api.CommentCreate(apiClient, baseRepo, pr.ID, body)
+		if err != nil {
+			return err
+		}
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		verb := "Commented on"
+		if opts.ReplyTo > 0 {
+			verb = "Replied to a review comment on"
+		} else if opts.EditLast {
+			verb = "Updated the last comment on"
+		}
+		fmt.Fprintf(opts.IO.ErrOut, "%s %s pull request #%d (%s)\n", utils.Green("✔"), verb, pr.Number, pr.Title)
+	}
+
+	return nil
+}
+
+// lastViewerComment finds the most recent comment on the pull request that
+// was authored by the current user
+func lastViewerComment(client *api.Client, repo ghrepo.Interface, number int) (*api.Comment, error) {
+	comments, err := api.PullRequestComments(client, repo, number)
+	if err != nil {
+		return nil, err
+	}
+	for i := len(comments.Comments) - 1; i >= 0; i-- {
+		comment := comments.Comments[i]
+		if comment.ViewerDidAuthor && comment.ID != "" {
+			return &comment, nil
+		}
+	}
+	return nil, errors.New("no comments found for the current user")
+}
+
+func commentSurvey(editorCommand string) (string, error) {
+	answers := struct {
+		Body string
+	}{}
+	qs := []*survey.Question{
+		{
+			Name: "body",
+			Prompt: &surveyext.GhEditor{
+				EditorCommand: editorCommand,
+				Editor: &survey.Editor{
+					Message:  "Comment body",
+					FileName: "*.md",
+				},
+			},
+		},
+	}
+
+	err := prompt.SurveyAsk(qs, &answers)
+	if err != nil {
+		return "", err
+	}
+
+	return answers.Body, nil
+}
@@ -0,0 +1,309 @@
+package comment
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/cli/cli/context"
+	"github.com/cli/cli/git"
+	"github.com/cli/cli/internal/config"
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/httpmock"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/cli/cli/test"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewCmdComment(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    string
+		isTTY   bool
+		want    CommentOptions
+		wantErr string
+	}{
+		{
+			name:  "body flag",
+			args:  "22 --body hello",
+			isTTY: false,
+			want: CommentOptions{
+				SelectorArg: "22",
+				Body:        "hello",
+			},
+		},
+		{
+			name:  "edit last",
+			args:  "22 --edit-last --body revised",
+			isTTY: false,
+			want: CommentOptions{
+				SelectorArg: "22",
+				Body:        "revised",
+				EditLast:    true,
+			},
+		},
+		{
+			name:  "no flags prompts interactively",
+			args:  "22",
+			isTTY: true,
+			want: CommentOptions{
+				SelectorArg: "22",
+				Interactive: true,
+			},
+		},
+		{
+			name:  "reply to",
+			args:  "22 --reply-to 336238 --body fixed",
+			isTTY: false,
+			want: CommentOptions{
+				SelectorArg: "22",
+				Body:        "fixed",
+				ReplyTo:     336238,
+			},
+		},
+		{
+			name:    "no flags non-interactive",
+			args:    "22",
+			isTTY:   false,
+			wantErr: "--body, --body-file, --editor, or --web required when not running interactively",
+		},
+		{
+			name:    "multiple body sources",
+			args:    "22 --body hi --editor",
+			isTTY:   true,
+			wantErr: "specify only one of --body, --body-file, --editor, or --web",
+		},
+		{
+			name:    "web with edit-last",
+			args:    "22 --web --edit-last",
+			isTTY:   true,
+			wantErr: "--edit-last is not supported with --web",
+		},
+		{
+			name:    "reply-to with web",
+			args:    "22 --web --reply-to 336238",
+			isTTY:   true,
+			wantErr: "--reply-to is not supported with --web",
+		},
+		{
+			name:    "reply-to with edit-last",
+			args:    "22 --edit-last --reply-to 336238 --body fixed",
+			isTTY:   true,
+			wantErr: "--reply-to is not supported with --edit-last",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, _, _ := iostreams.Test()
+			io.SetStdoutTTY(tt.isTTY)
+			io.SetStdinTTY(tt.isTTY)
+			io.SetStderrTTY(tt.isTTY)
+
+			f := &cmdutil.Factory{
+				IOStreams: io,
+			}
+
+			var opts *CommentOptions
+			cmd := NewCmdComment(f, func(o *CommentOptions) error {
+				opts = o
+				return nil
+			})
+
+			argv, err := shlex.Split(tt.args)
+			require.NoError(t, err)
+			cmd.SetArgs(argv)
+
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(ioutil.Discard)
+			cmd.SetErr(ioutil.Discard)
+
+			_, err = cmd.ExecuteC()
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			} else {
+				require.NoError(t, err)
+			}
+
+			assert.Equal(t, tt.want.SelectorArg, opts.SelectorArg)
+			assert.Equal(t, tt.want.Body, opts.Body)
+			assert.Equal(t, tt.want.EditLast, opts.EditLast)
+			assert.Equal(t, tt.want.ReplyTo, opts.ReplyTo)
+			assert.Equal(t, tt.want.Interactive, opts.Interactive)
+		})
+	}
+}
+
+func runCommand(rt http.RoundTripper, isTTY bool, cli string) (*test.CmdOut, error) {
+	io, _, stdout, stderr := iostreams.Test()
+	io.SetStdoutTTY(isTTY)
+	io.SetStdinTTY(isTTY)
+	io.SetStderrTTY(isTTY)
+
+	factory := &cmdutil.Factory{
+		IOStreams: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: rt}, nil
+		},
+		Config: func() (config.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+		Remotes: func() (context.Remotes, error) {
+			return context.Remotes{
+				{
+					Remote: &git.Remote{Name: "origin"},
+					Repo:   ghrepo.New("OWNER", "REPO"),
+				},
+			}, nil
+		},
+		Branch: func() (string, error) {
+			return "trunk", nil
+		},
+	}
+
+	cmd := NewCmdComment(factory, nil)
+
+	cli = strings.TrimPrefix(cli, "pr comment")
+	argv, err := shlex.Split(cli)
+	if err != nil {
+		return nil, err
+	}
+	cmd.SetArgs(argv)
+
+	cmd.SetIn(&bytes.Buffer{})
+	cmd.SetOut(ioutil.Discard)
+	cmd.SetErr(ioutil.Discard)
+
+	_, err = cmd.ExecuteC()
+	return &test.CmdOut{
+		OutBuf: stdout,
+		ErrBuf: stderr,
+	}, err
+}
+
+func TestPRComment(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query PullRequestByNumber\b`),
+		httpmock.StringResponse(`
+		{ "data": { "repository": { "pullRequest": {
+			"id": "THE-ID",
+			"number": 22,
+			"title": "Wire frobnication",
+			"url": "https://github.com/OWNER/REPO/pull/22"
+		} } } }`))
+	http.Register(
+		httpmock.GraphQL(`mutation CommentCreate\b`),
+		httpmock.GraphQLMutation(`
+		{ "data": { "addComment": { "commentEdge": { "node": {
+			"id": "COMMENT-ID"
+		} } } } }`,
+			func(inputs map[string]interface{}) {
+				assert.Equal(t, "THE-ID", inputs["subjectId"])
+				assert.Equal(t, "looks good", inputs["body"])
+			}))
+
+	output, err := runCommand(http, false, `pr comment 22 --body "looks good"`)
+	if err != nil {
+		t.Fatalf("error running command `pr comment`: %v", err)
+	}
+
+	assert.Equal(t, "", output.String())
+}
+
+func TestPRComment_replyTo(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query PullRequestByNumber\b`),
+		httpmock.StringResponse(`
+		{ "data": { "repository": { "pullRequest": {
+			"id": "THE-ID",
+			"number": 22,
+			"title": "Wire frobnication",
+			"url": "https://github.com/OWNER/REPO/pull/22"
+		} } } }`))
+	http.Register(
+		httpmock.REST("POST", "repos/OWNER/REPO/pulls/22/comments/336238/replies"),
+		httpmock.StringResponse(`{"id": 336239}`))
+
+	output, err := runCommand(http, false, `pr comment 22 --reply-to 336238 --body "fixed in the next push"`)
+	if err != nil {
+		t.Fatalf("error running command `pr comment`: %v", err)
+	}
+
+	bodyBytes, err := ioutil.ReadAll(http.Requests[1].Body)
+	require.NoError(t, err)
+	reqBody := map[string]interface{}{}
+	require.NoError(t, json.Unmarshal(bodyBytes, &reqBody))
+	assert.Equal(t, "fixed in the next push", reqBody["body"])
+
+	assert.Equal(t, "", output.String())
+}
+
+func TestPRComment_editLast(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query PullRequestByNumber\b`),
+		httpmock.StringResponse(`
+		{ "data": { "repository": { "pullRequest": {
+			"id": "THE-ID",
+			"number": 22,
+			"title": "Wire frobnication",
+			"url": "https://github.com/OWNER/REPO/pull/22"
+		} } } }`))
+	http.Register(
+		httpmock.GraphQL(`query PullRequestComments\b`),
+		httpmock.StringResponse(`
+		{ "data": { "repository": { "pullRequest": { "comments": {
+			"nodes": [
+				{
+					"id": "OLD-COMMENT",
+					"author": { "login": "monalisa" },
+					"body": "first take",
+					"createdAt": "2020-01-01T12:00:00Z",
+					"viewerDidAuthor": true
+				},
+				{
+					"id": "OTHER-COMMENT",
+					"author": { "login": "hubot" },
+					"body": "beep",
+					"createdAt": "2020-01-02T12:00:00Z",
+					"viewerDidAuthor": false
+				}
+			],
+			"pageInfo": { "hasNextPage": false, "endCursor": "" },
+			"totalCount": 2
+		} } } } }`))
+	http.Register(
+		httpmock.GraphQL(`mutation CommentUpdate\b`),
+		httpmock.GraphQLMutation(`
+		{ "data": { "updateIssueComment": { "issueComment": {
+			"id": "OLD-COMMENT"
+		} } } }`,
+			func(inputs map[string]interface{}) {
+				assert.Equal(t, "OLD-COMMENT", inputs["id"])
+				assert.Equal(t, "second take", inputs["body"])
+			}))
+
+	output, err := runCommand(http, false, `pr comment 22 --edit-last --body "second take"`)
+	if err != nil {
+		t.Fatalf("error running command `pr comment`: %v", err)
+	}
+
+	assert.Equal(t, "", output.String())
+}
@@ -4,6 +4,8 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -13,10 +15,12 @@ import (
 	"github.com/cli/cli/git"
 	"github.com/cli/cli/internal/config"
 	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/clipboard"
 	"github.com/cli/cli/pkg/cmd/pr/shared"
 	"github.com/cli/cli/pkg/cmdutil"
 	"github.com/cli/cli/pkg/githubtemplate"
 	"github.com/cli/cli/pkg/iostreams"
+	"github.com/cli/cli/pkg/prompt"
 	"github.com/cli/cli/utils"
 	"github.com/spf13/cobra"
 )
@@ -33,13 +37,17 @@ type CreateOptions struct {
 	RootDirOverride string
 	RepoOverride    string
 
-	Autofill bool
-	WebMode  bool
+	Autofill  bool
+	FillFirst bool
+	WebMode   bool
 
 	IsDraft    bool
+	Copy       bool
 	Title      string
 	Body       string
+	BodyFile   string
 	BaseBranch string
+	Template   string
 
 	Reviewers []string
 	Assignees []string
@@ -72,14 +80,40 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 		RunE: func(cmd *cobra.Command, args []string) error {
 			titleProvided := cmd.Flags().Changed("title")
 			bodyProvided := cmd.Flags().Changed("body")
+			bodyFileProvided := cmd.Flags().Changed("body-file")
 			opts.RepoOverride, _ = cmd.Flags().GetString("repo")
 
+			if bodyProvided && bodyFileProvided {
+				return &cmdutil.FlagError{Err: errors.New("specify only one of --body or --body-file")}
+			}
+			if bodyFileProvided {
+				b, err := cmdutil.ReadFile(opts.BodyFile, opts.IO.In)
+				if err != nil {
+					return err
+				}
+				opts.Body = string(b)
+				bodyProvided = true
+			}
+
 			opts.Interactive = !(titleProvided && bodyProvided)
 
-			if !opts.IO.CanPrompt() && !opts.WebMode && !titleProvided && !opts.Autofill {
+			if !opts.IO.CanPrompt() && !opts.WebMode && !titleProvided && !opts.Autofill && !opts.FillFirst {
 				return &cmdutil.FlagError{Err: errors.New("--title or --fill required when not running interactively")}
 			}
 
+			if opts.Autofill && opts.FillFirst {
+				return &cmdutil.FlagError{Err: errors.New("specify only one of --fill or --fill-first")}
+			}
+
+			if opts.Template != "" {
+				if bodyProvided {
+					return &cmdutil.FlagError{Err: errors.New("--template is not supported when using --body or --body-file")}
+				}
+				if opts.Autofill || opts.FillFirst {
+					return &cmdutil.FlagError{Err: errors.New("--template is not supported with --fill or --fill-first")}
+				}
+			}
+
 			if opts.IsDraft && opts.WebMode {
 				return errors.New("the --draft flag is not supported with --web")
 			}
@@ -98,14 +132,18 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 	fl.BoolVarP(&opts.IsDraft, "draft", "d", false, "Mark pull request as a draft")
 	fl.StringVarP(&opts.Title, "title", "t", "", "Supply a title. Will prompt for one otherwise.")
 	fl.StringVarP(&opts.Body, "body", "b", "", "Supply a body. Will prompt for one otherwise.")
+	fl.StringVarP(&opts.BodyFile, "body-file", "F", "", "Read body text from `file` (use \"-\" to read from standard input)")
 	fl.StringVarP(&opts.BaseBranch, "base", "B", "", "The branch into which you want your code merged")
 	fl.BoolVarP(&opts.WebMode, "web", "w", false, "Open the web browser to create a pull request")
 	fl.BoolVarP(&opts.Autofill, "fill", "f", false, "Do not prompt for title/body and just use commit info")
+	fl.BoolVar(&opts.FillFirst, "fill-first", false, "Do not prompt for title/body and just use the first commit's info")
 	fl.StringSliceVarP(&opts.Reviewers, "reviewer", "r", nil, "Request reviews from people by their `login`")
-	fl.StringSliceVarP(&opts.Assignees, "assignee", "a", nil, "Assign people by their `login`")
+	fl.StringSliceVarP(&opts.Assignees, "assignee", "a", nil, "Assign people by their `login`. Use \"@me\" to self-assign.")
 	fl.StringSliceVarP(&opts.Labels, "label", "l", nil, "Add labels by `name`")
 	fl.StringSliceVarP(&opts.Projects, "project", "p", nil, "Add the pull request to projects by `name`")
 	fl.StringVarP(&opts.Milestone, "milestone", "m", "", "Add the pull request to a milestone by `name`")
+	fl.StringVarP(&opts.Template, "template", "T", "", "Use a pull request template by `name`")
+	fl.BoolVar(&opts.Copy, "copy", false, "Copy the pull request URL to the clipboard")
 
 	return cmd
 }
@@ -181,18 +219,37 @@ func createRun(opts *CreateOptions) error {
 	if baseRemote, err := remotes.FindByRepo(baseRepo.RepoOwner(), baseRepo.RepoName()); err == nil {
 		baseTrackingBranch = fmt.Sprintf("%s/%s", baseRemote.Name, baseBranch)
 	}
-	defs, defaultsErr := computeDefaults(baseTrackingBranch, headBranch)
+	defs, defaultsErr := computeDefaults(baseTrackingBranch, headBranch, opts.FillFirst)
 
 	title := opts.Title
 	body := opts.Body
 
+	var chosenTemplateFile string
+	if opts.Template != "" {
+		rootDir := opts.RootDirOverride
+		if rootDir == "" {
+			rootDir, _ = git.ToplevelDir()
+		}
+		chosenTemplateFile, err = findTemplate(rootDir, opts.Template)
+		if err != nil {
+			return err
+		}
+		if opts.WebMode {
+			body = string(githubtemplate.ExtractContents(chosenTemplateFile))
+		} else if !opts.IO.CanPrompt() {
+			// scripted use: take the template as the body without prompting
+			body = string(githubtemplate.ExtractContents(chosenTemplateFile))
+			opts.Interactive = false
+		}
+	}
+
 	action := shared.SubmitAction
 	if opts.WebMode {
 		action = shared.PreviewAction
 		if (title == "" || body == "") && defaultsErr != nil {
 			return fmt.Errorf("could not compute title or body defaults: %w", defaultsErr)
 		}
-	} else if opts.Autofill {
+	} else if opts.Autofill || opts.FillFirst {
 		if defaultsErr != nil {
 			return fmt.Errorf("could not compute title or body defaults: %w", defaultsErr)
 		}
@@ -217,7 +274,7 @@ func createRun(opts *CreateOptions) error {
 
 	isTerminal := opts.IO.IsStdinTTY() && opts.IO.IsStdoutTTY()
 
-	if !opts.WebMode && !opts.Autofill {
+	if !opts.WebMode && !opts.Autofill && !opts.FillFirst {
 		message := "\nCreating pull request for %s into %s in %s\n\n"
 		if opts.IsDraft {
 			message = "\nCreating draft pull request for %s into %s in %s\n\n"
@@ -243,11 +300,13 @@ func createRun(opts *CreateOptions) error {
 		Milestones: milestoneTitles,
 	}
 
-	if !opts.WebMode && !opts.Autofill && opts.Interactive {
+	if !opts.WebMode && !opts.Autofill && !opts.FillFirst && opts.Interactive {
 		var nonLegacyTemplateFiles []string
 		var legacyTemplateFile *string
 
-		if opts.RootDirOverride != "" {
+		if chosenTemplateFile != "" {
+			legacyTemplateFile = &chosenTemplateFile
+		} else if opts.RootDirOverride != "" {
 			nonLegacyTemplateFiles = githubtemplate.FindNonLegacy(opts.RootDirOverride, "PULL_REQUEST_TEMPLATE")
 			legacyTemplateFile = githubtemplate.FindLegacy(opts.RootDirOverride, "PULL_REQUEST_TEMPLATE")
 		} else if rootDir, err := git.ToplevelDir(); err == nil {
@@ -255,6 +314,16 @@ func createRun(opts *CreateOptions) error {
 			legacyTemplateFile = githubtemplate.FindLegacy(rootDir, "PULL_REQUEST_TEMPLATE")
 		}
 
+		if chosenTemplateFile == "" && opts.RootDirOverride == "" && len(nonLegacyTemplateFiles) == 0 && legacyTemplateFile == nil {
+			// like the web UI, fall back to the owner's ".github" repository
+			// when this repository has no templates of its own
+			if communityDir := shared.CommunityTemplatesDir(client, baseRepo, "PULL_REQUEST_TEMPLATE"); communityDir != "" {
+				defer os.RemoveAll(communityDir)
+				nonLegacyTemplateFiles = githubtemplate.FindNonLegacy(communityDir, "PULL_REQUEST_TEMPLATE")
+				legacyTemplateFile = githubtemplate.FindLegacy(communityDir, "PULL_REQUEST_TEMPLATE")
+			}
+		}
+
 		editorCommand, err := cmdutil.DetermineEditor(opts.Config)
 		if err != nil {
 			return err
@@ -285,13 +354,25 @@ func createRun(opts *CreateOptions) error {
 	}
 
 	didForkRepo := false
-	// if a head repository could not be determined so far, automatically create
-	// one by forking the base repository
+	// if a head repository could not be determined so far, create one by
+	// forking the base repository, asking for confirmation when interactive
 	if headRepo == nil {
 		if baseRepo.IsPrivate {
 			return fmt.Errorf("cannot fork private repository '%s'", ghrepo.FullName(baseRepo))
 		}
-		headRepo, err = api.ForkRepo(client, baseRepo)
+		if opts.IO.CanPrompt() {
+			forkDesired := true
+			err := prompt.Confirm(fmt.Sprintf("You have no push access to %s; push %q to a fork and open the pull request from there?",
+				ghrepo.FullName(baseRepo), headBranch), &forkDesired)
+			if err != nil {
+				return fmt.Errorf("failed to prompt: %w", err)
+			}
+			if !forkDesired {
+				fmt.Fprintln(opts.IO.ErrOut, "Discarding.")
+				return cmdutil.SilentError
+			}
+		}
+		headRepo, err = api.ForkRepo(client, baseRepo, "")
 		if err != nil {
 			return fmt.Errorf("error forking repo: %w", err)
 		}
@@ -355,6 +436,10 @@ func createRun(opts *CreateOptions) error {
 	}
 
 	if action == shared.SubmitAction {
+		if isTerminal && body != "" {
+			shared.SurfaceIssueReferences(client, baseRepo, opts.IO, body)
+		}
+
 		params := map[string]interface{}{
 			"title":       title,
 			"body":        body,
@@ -362,6 +447,11 @@ func createRun(opts *CreateOptions) error {
 			"baseRefName": baseBranch,
 			"headRefName": headBranchLabel,
 		}
+		if !ghrepo.IsSame(baseRepo, headRepo) {
+			// allow maintainers to push to the fork branch when the pull
+			// request comes from another repository
+			params["maintainerCanModify"] = true
+		}
 
 		err = shared.AddMetadataToIssueParams(client, baseRepo, params, &tb)
 		if err != nil {
@@ -371,6 +461,11 @@ func createRun(opts *CreateOptions) error {
 		pr, err := api.CreatePullRequest(client, baseRepo, params)
 		if pr != nil {
 			fmt.Fprintln(opts.IO.Out, pr.URL)
+			if opts.Copy {
+				if copyErr := clipboard.Copy(pr.URL); copyErr != nil {
+					fmt.Fprintf(opts.IO.ErrOut, "warning: could not copy to clipboard: %v\n", copyErr)
+				}
+			}
 		}
 		if err != nil {
 			if pr != nil {
@@ -394,7 +489,22 @@ func createRun(opts *CreateOptions) error {
 	return nil
 }
 
-func computeDefaults(baseRef, headRef string) (shared.Defaults, error) {
+// findTemplate locates a pull request template by name, matching either its
+// filename or the name declared in its frontmatter
+func findTemplate(rootDir string, name string) (string, error) {
+	paths := githubtemplate.FindNonLegacy(rootDir, "PULL_REQUEST_TEMPLATE")
+	if legacyPath := githubtemplate.FindLegacy(rootDir, "PULL_REQUEST_TEMPLATE"); legacyPath != nil {
+		paths = append(paths, *legacyPath)
+	}
+	for _, p := range paths {
+		if strings.EqualFold(filepath.Base(p), name) || strings.EqualFold(githubtemplate.ExtractName(p), name) {
+			return p, nil
+		}
+	}
+	return "", fmt.Errorf("could not find pull request template %q", name)
+}
+
+func computeDefaults(baseRef, headRef string, useFirstCommit bool) (shared.Defaults, error) {
 	out := shared.Defaults{}
 
 	commits, err := git.Commits(baseRef, headRef)
@@ -402,6 +512,18 @@ func computeDefaults(baseRef, headRef string) (shared.Defaults, error) {
 		return out, err
 	}
 
+	if useFirstCommit && len(commits) > 0 {
+		// commits are listed newest first; the branch's first commit is last
+		firstCommit := commits[len(commits)-1]
+		out.Title = firstCommit.Title
+		body, err := git.CommitBody(firstCommit.Sha)
+		if err != nil {
+			return out, err
+		}
+		out.Body = body
+		return out, nil
+	}
+
 	if len(commits) == 1 {
 		out.Title = commits[0].Title
 		body, err := git.CommitBody(commits[0].Sha)
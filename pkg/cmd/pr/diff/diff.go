@@ -12,6 +12,7 @@ import (
 
 	"github.com/cli/cli/api"
 	"github.com/cli/cli/context"
+	"github.com/cli/cli/internal/config"
 	"github.com/cli/cli/internal/ghrepo"
 	"github.com/cli/cli/pkg/cmd/pr/shared"
 	"github.com/cli/cli/pkg/cmdutil"
@@ -22,6 +23,7 @@ import (
 
 type DiffOptions struct {
 	HttpClient func() (*http.Client, error)
+	Config     func() (config.Config, error)
 	IO         *iostreams.IOStreams
 	BaseRepo   func() (ghrepo.Interface, error)
 	Remotes    func() (context.Remotes, error)
@@ -29,12 +31,15 @@ type DiffOptions struct {
 
 	SelectorArg string
 	UseColor    string
+	NameOnly    bool
+	Patch       bool
 }
 
 func NewCmdDiff(f *cmdutil.Factory, runF func(*DiffOptions) error) *cobra.Command {
 	opts := &DiffOptions{
 		IO:         f.IOStreams,
 		HttpClient: f.HttpClient,
+		Config:     f.Config,
 		Remotes:    f.Remotes,
 		Branch:     f.Branch,
 	}
@@ -71,6 +76,8 @@ func NewCmdDiff(f *cmdutil.Factory, runF func(*DiffOptions) error) *cobra.Comman
 	}
 
 	cmd.Flags().StringVar(&opts.UseColor, "color", "auto", "Use color in diff output: {always|never|auto}")
+	cmd.Flags().BoolVar(&opts.NameOnly, "name-only", false, "Display only names of changed files")
+	cmd.Flags().BoolVar(&opts.Patch, "patch", false, "Display diff in patch format")
 
 	return cmd
 }
@@ -87,19 +94,28 @@ func diffRun(opts *DiffOptions) error {
 		return err
 	}
 
-	diff, err := apiClient.PullRequestDiff(baseRepo, pr.Number)
+	diffFormat := "diff"
+	if opts.Patch {
+		diffFormat = "patch"
+	}
+
+	diff, err := apiClient.PullRequestDiff(baseRepo, pr.Number, diffFormat)
 	if err != nil {
 		return fmt.Errorf("could not find pull request diff: %w", err)
 	}
 	defer diff.Close()
 
+	if opts.NameOnly {
+		return printNames(opts.IO.Out, diff)
+	}
+
 	if opts.UseColor == "never" {
 		_, err = io.Copy(opts.IO.Out, diff)
 		return err
 	}
 
 	if opts.IO.IsStdoutTTY() {
-		if pager := os.Getenv("PAGER"); pager != "" {
+		if pager := findPager(opts.Config); pager != "" {
 			return runPager(pager, diff, opts.IO.Out)
 		}
 	}
@@ -149,6 +165,40 @@ func validColorFlag(c string) bool {
 	return c == "auto" || c == "always" || c == "never"
 }
 
+// printNames scans a unified diff and prints just the paths of changed files.
+func printNames(w io.Writer, diff io.Reader) error {
+	diffLines := bufio.NewScanner(diff)
+	for diffLines.Scan() {
+		diffLine := diffLines.Text()
+		if !strings.HasPrefix(diffLine, "+++ ") {
+			continue
+		}
+		name := strings.TrimPrefix(diffLine, "+++ ")
+		name = strings.TrimPrefix(name, "b/")
+		if name == "/dev/null" {
+			continue
+		}
+		fmt.Fprintln(w, name)
+	}
+	return diffLines.Err()
+}
+
+// findPager determines which pager to pipe output through, preferring the
+// GH_PAGER environment variable, then the `pager` config value, then PAGER.
+func findPager(cfgFunc func() (config.Config, error)) string {
+	if ghPager, ghPagerExists := os.LookupEnv("GH_PAGER"); ghPagerExists {
+		return ghPager
+	}
+	if cfgFunc != nil {
+		if cfg, err := cfgFunc(); err == nil {
+			if cfgPager, _ := cfg.Get("", "pager"); cfgPager != "" {
+				return cfgPager
+			}
+		}
+	}
+	return os.Getenv("PAGER")
+}
+
 var runPager = func(pager string, diff io.Reader, out io.Writer) error {
 	args, err := shlex.Split(pager)
 	if err != nil {
@@ -269,6 +269,28 @@ func TestPRDiff_pager(t *testing.T) {
 	}
 }
 
+func TestPRDiff_name_only(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+	http.StubResponse(200, bytes.NewBufferString(`
+		{ "data": { "repository": { "pullRequests": { "nodes": [
+			{ "url": "https://github.com/OWNER/REPO/pull/123",
+			  "number": 123,
+			  "id": "foobar123",
+			  "headRefName": "feature",
+				"baseRefName": "master" }
+		] } } } }`))
+	http.StubResponse(200, bytes.NewBufferString(testDiff))
+	output, err := runCommand(http, nil, false, "--name-only")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	wantNames := ".github/workflows/releases.yml\nMakefile\n"
+	if diff := cmp.Diff(wantNames, output.String()); diff != "" {
+		t.Errorf("command output did not match:\n%s", diff)
+	}
+}
+
 const testDiff = `diff --git a/.github/workflows/releases.yml b/.github/workflows/releases.yml
 index 73974448..b7fc0154 100644
 --- a/.github/workflows/releases.yml
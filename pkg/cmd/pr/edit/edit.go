@@ -0,0 +1,419 @@
+package edit
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/context"
+	"github.com/cli/cli/internal/config"
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/cmd/pr/shared"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/cli/cli/pkg/prompt"
+	"github.com/cli/cli/pkg/surveyext"
+	"github.com/shurcooL/githubv4"
+	"github.com/spf13/cobra"
+)
+
+type EditOptions struct {
+	HttpClient func() (*http.Client, error)
+	Config     func() (config.Config, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+	Remotes    func() (context.Remotes, error)
+	Branch     func() (string, error)
+
+	SelectorArg string
+	Interactive bool
+
+	Title            string
+	TitleChanged     bool
+	Body             string
+	BodyChanged      bool
+	BaseBranch       string
+	Milestone        string
+	MilestoneChanged bool
+
+	AddReviewers    []string
+	RemoveReviewers []string
+	AddAssignees    []string
+	RemoveAssignees []string
+	AddLabels       []string
+	RemoveLabels    []string
+	AddProjects     []string
+	RemoveProjects  []string
+	ProjectFields   []string
+}
+
+func NewCmdEdit(f *cmdutil.Factory, runF func(*EditOptions) error) *cobra.Command {
+	opts := &EditOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		Config:     f.Config,
+		Remotes:    f.Remotes,
+		Branch:     f.Branch,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "edit [<number> | <url> | <branch>]",
+		Short: "Edit a pull request",
+		Long: heredoc.Doc(`
+			Edit a pull request.
+
+			Without an argument, the pull request that belongs to the current branch is edited.
+		`),
+		Example: heredoc.Doc(`
+			$ gh pr edit 23 --title "I found a bug" --body "Nothing works"
+			$ gh pr edit 23 --add-label "bug,help wanted" --remove-label "core"
+			$ gh pr edit 23 --add-reviewer monalisa,hubot --remove-reviewer myorg/team-name
+			$ gh pr edit 23 --add-assignee @me --remove-assignee monalisa
+			$ gh pr edit 23 --base dev
+			$ gh pr edit 23 --milestone "Version 1"
+			$ gh pr edit 23 --add-project "Roadmap" --project-field Status=Todo
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+
+			if len(args) > 0 {
+				opts.SelectorArg = args[0]
+			}
+
+			opts.TitleChanged = cmd.Flags().Changed("title")
+			opts.BodyChanged = cmd.Flags().Changed("body")
+			opts.MilestoneChanged = cmd.Flags().Changed("milestone")
+
+			if len(opts.ProjectFields) > 0 && len(opts.AddProjects) == 0 {
+				return &cmdutil.FlagError{Err: errors.New("--project-field requires --add-project")}
+			}
+
+			if !opts.TitleChanged && !opts.BodyChanged && !opts.MilestoneChanged &&
+				opts.BaseBranch == "" &&
+				len(opts.AddReviewers) == 0 && len(opts.RemoveReviewers) == 0 &&
+				len(opts.AddAssignees) == 0 && len(opts.RemoveAssignees) == 0 &&
+				len(opts.AddLabels) == 0 && len(opts.RemoveLabels) == 0 &&
+				len(opts.AddProjects) == 0 && len(opts.RemoveProjects) == 0 {
+				if !opts.IO.CanPrompt() {
+					return &cmdutil.FlagError{Err: errors.New("specify fields to edit with flags when not running interactively")}
+				}
+				opts.Interactive = true
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return editRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Title, "title", "t", "", "Revise the pull request title")
+	cmd.Flags().StringVarP(&opts.Body, "body", "b", "", "Revise the pull request body")
+	cmd.Flags().StringVarP(&opts.BaseBranch, "base", "B", "", "Change the base branch for this pull request")
+	cmd.Flags().StringVarP(&opts.Milestone, "milestone", "m", "", "Edit the milestone the pull request belongs to by `name`")
+	cmd.Flags().StringSliceVar(&opts.AddReviewers, "add-reviewer", nil, "Add reviewers by their `handle`")
+	cmd.Flags().StringSliceVar(&opts.RemoveReviewers, "remove-reviewer", nil, "Remove reviewers by their `handle`")
+	cmd.Flags().StringSliceVar(&opts.AddAssignees, "add-assignee", nil, "Add assigned users by their `login`")
+	cmd.Flags().StringSliceVar(&opts.RemoveAssignees, "remove-assignee", nil, "Remove assigned users by their `login`")
+	cmd.Flags().StringSliceVar(&opts.AddLabels, "add-label", nil, "Add labels by `name`")
+	cmd.Flags().StringSliceVar(&opts.RemoveLabels, "remove-label", nil, "Remove labels by `name`")
+	cmd.Flags().StringSliceVar(&opts.AddProjects, "add-project", nil, "Add the pull request to projects by `name`")
+	cmd.Flags().StringSliceVar(&opts.RemoveProjects, "remove-project", nil, "Remove the pull request from projects by `name`")
+	cmd.Flags().StringSliceVar(&opts.ProjectFields, "project-field", nil, "Set a field on items added with --add-project, in `name=value` format (next-generation projects only)")
+
+	return cmd
+}
+
+func editRun(opts *EditOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	pr, baseRepo, err := shared.PRFromArgs(apiClient, opts.BaseRepo, opts.Branch, opts.Remotes, opts.SelectorArg)
+	if err != nil {
+		return err
+	}
+
+	if opts.Interactive {
+		err = editSurvey(opts, pr)
+		if err != nil {
+			return err
+		}
+	}
+
+	params := githubv4.UpdatePullRequestInput{
+		PullRequestID: pr.ID,
+	}
+	if opts.TitleChanged {
+		params.Title = githubv4.NewString(githubv4.String(opts.Title))
+	}
+	if opts.BodyChanged {
+		params.Body = githubv4.NewString(githubv4.String(opts.Body))
+	}
+	if opts.BaseBranch != "" {
+		params.BaseRefName = githubv4.NewString(githubv4.String(opts.BaseBranch))
+	}
+
+	// projects with custom fields are next-generation projects; those are
+	// handled through their own mutations after the main update
+	addProjects := opts.AddProjects
+	if len(opts.ProjectFields) > 0 {
+		addProjects = nil
+	}
+
+	editAssignees := len(opts.AddAssignees) > 0 || len(opts.RemoveAssignees) > 0
+	editLabels := len(opts.AddLabels) > 0 || len(opts.RemoveLabels) > 0
+	editProjects := len(addProjects) > 0 || len(opts.RemoveProjects) > 0
+	editReviewers := len(opts.AddReviewers) > 0 || len(opts.RemoveReviewers) > 0
+
+	var metadataResult *api.RepoMetadataResult
+	var assignees, labels, projects, reviewers []string
+	if editAssignees || editLabels || editProjects || editReviewers || opts.MilestoneChanged {
+		assignees = mergeSets(currentAssignees(pr), opts.AddAssignees, opts.RemoveAssignees)
+		labels = mergeSets(currentLabels(pr), opts.AddLabels, opts.RemoveLabels)
+		projects = mergeSets(currentProjects(pr), addProjects, opts.RemoveProjects)
+		reviewers = mergeSets(currentReviewers(pr, baseRepo), opts.AddReviewers, opts.RemoveReviewers)
+
+		resolveInput := api.RepoResolveInput{}
+		if editAssignees {
+			resolveInput.Assignees = assignees
+		}
+		if editLabels {
+			resolveInput.Labels = labels
+		}
+		if editProjects {
+			resolveInput.Projects = projects
+		}
+		if editReviewers {
+			resolveInput.Reviewers = reviewers
+		}
+		if opts.MilestoneChanged && opts.Milestone != "" {
+			resolveInput.Milestones = []string{opts.Milestone}
+		}
+
+		metadataResult, err = api.RepoResolveMetadataIDs(apiClient, baseRepo, resolveInput)
+		if err != nil {
+			return err
+		}
+	}
+
+	if editAssignees {
+		ids, err := metadataResult.MembersToIDs(assignees)
+		if err != nil {
+			return fmt.Errorf("could not assign user: %w", err)
+		}
+		params.AssigneeIDs = gqlIDs(ids)
+	}
+	if editLabels {
+		ids, err := metadataResult.LabelsToIDs(labels)
+		if err != nil {
+			return fmt.Errorf("could not add label: %w", err)
+		}
+		params.LabelIDs = gqlIDs(ids)
+	}
+	if editProjects {
+		ids, err := metadataResult.ProjectsToIDs(projects)
+		if err != nil {
+			return fmt.Errorf("could not add to project: %w", err)
+		}
+		params.ProjectIDs = gqlIDs(ids)
+	}
+	if opts.MilestoneChanged {
+		if opts.Milestone == "" {
+			params.MilestoneID = githubv4.NewID(nil)
+		} else {
+			milestoneID, err := metadataResult.MilestoneToID(opts.Milestone)
+			if err != nil {
+				return fmt.Errorf("could not add to milestone '%s': %w", opts.Milestone, err)
+			}
+			params.MilestoneID = githubv4.NewID(githubv4.ID(milestoneID))
+		}
+	}
+
+	err = api.PullRequestUpdate(apiClient, baseRepo, params)
+	if err != nil {
+		return err
+	}
+
+	if len(opts.ProjectFields) > 0 {
+		for _, projectTitle := range opts.AddProjects {
+			err = api.ProjectV2AddItemWithFields(apiClient, baseRepo, projectTitle, pr.ID, opts.ProjectFields)
+			if err != nil {
+				return fmt.Errorf("could not add to project '%s': %w", projectTitle, err)
+			}
+		}
+	}
+
+	if editReviewers {
+		var userReviewers, teamReviewers []string
+		for _, r := range reviewers {
+			if strings.ContainsRune(r, '/') {
+				teamReviewers = append(teamReviewers, r)
+			} else {
+				userReviewers = append(userReviewers, r)
+			}
+		}
+		userIDs, err := metadataResult.MembersToIDs(userReviewers)
+		if err != nil {
+			return fmt.Errorf("could not request reviewer: %w", err)
+		}
+		teamIDs, err := metadataResult.TeamsToIDs(teamReviewers)
+		if err != nil {
+			return fmt.Errorf("could not request team reviewer: %w", err)
+		}
+		err = api.PullRequestSetReviewers(apiClient, baseRepo, pr, userIDs, teamIDs)
+		if err != nil {
+			return err
+		}
+	}
+
+	fmt.Fprintln(opts.IO.Out, pr.URL)
+
+	return nil
+}
+
+func currentAssignees(pr *api.PullRequest) []string {
+	logins := make([]string, 0, len(pr.Assignees.Nodes))
+	for _, a := range pr.Assignees.Nodes {
+		logins = append(logins, a.Login)
+	}
+	return logins
+}
+
+func currentLabels(pr *api.PullRequest) []string {
+	names := make([]string, 0, len(pr.Labels.Nodes))
+	for _, l := range pr.Labels.Nodes {
+		names = append(names, l.Name)
+	}
+	return names
+}
+
+func currentProjects(pr *api.PullRequest) []string {
+	names := make([]string, 0, len(pr.ProjectCards.Nodes))
+	for _, c := range pr.ProjectCards.Nodes {
+		names = append(names, c.Project.Name)
+	}
+	return names
+}
+
+func currentReviewers(pr *api.PullRequest, repo ghrepo.Interface) []string {
+	var handles []string
+	for _, r := range pr.ReviewRequests.Nodes {
+		if r.RequestedReviewer.TypeName == "Team" {
+			handles = append(handles, fmt.Sprintf("%s/%s", repo.RepoOwner(), r.RequestedReviewer.Slug))
+		} else {
+			handles = append(handles, r.RequestedReviewer.Login)
+		}
+	}
+	return handles
+}
+
+// mergeSets combines the current set of names with additions and removals,
+// matching names case-insensitively
+func mergeSets(current, add, remove []string) []string {
+	contains := func(haystack []string, needle string) bool {
+		for _, s := range haystack {
+			if strings.EqualFold(s, needle) {
+				return true
+			}
+		}
+		return false
+	}
+
+	merged := []string{}
+	for _, s := range current {
+		if !contains(remove, s) {
+			merged = append(merged, s)
+		}
+	}
+	for _, s := range add {
+		if !contains(merged, s) {
+			merged = append(merged, s)
+		}
+	}
+	return merged
+}
+
+func gqlIDs(ids []string) *[]githubv4.ID {
+	result := make([]githubv4.ID, len(ids))
+	for i, id := range ids {
+		result[i] = githubv4.ID(id)
+	}
+	return &result
+}
+
+func editSurvey(opts *EditOptions, pr *api.PullRequest) error {
+	editorCommand, err := cmdutil.DetermineEditor(opts.Config)
+	if err != nil {
+		return err
+	}
+
+	fieldsAnswer := struct {
+		Fields []string
+	}{}
+	fieldsQs := []*survey.Question{
+		{
+			Name: "fields",
+			Prompt: &survey.MultiSelect{
+				Message: "What would you like to edit?",
+				Options: []string{"Title", "Body"},
+			},
+		},
+	}
+	err = prompt.SurveyAsk(fieldsQs, &fieldsAnswer)
+	if err != nil {
+		return err
+	}
+
+	for _, field := range fieldsAnswer.Fields {
+		switch field {
+		case "Title":
+			opts.TitleChanged = true
+			titleQs := []*survey.Question{
+				{
+					Name: "title",
+					Prompt: &survey.Input{
+						Message: "Title",
+						Default: pr.Title,
+					},
+				},
+			}
+			err = prompt.SurveyAsk(titleQs, opts)
+			if err != nil {
+				return err
+			}
+		case "Body":
+			opts.BodyChanged = true
+			bodyQs := []*survey.Question{
+				{
+					Name: "body",
+					Prompt: &surveyext.GhEditor{
+						BlankAllowed:  true,
+						EditorCommand: editorCommand,
+						Editor: &survey.Editor{
+							Message:       "Body",
+							FileName:      "*.md",
+							Default:       pr.Body,
+							HideDefault:   true,
+							AppendDefault: true,
+						},
+					},
+				},
+			}
+			err = prompt.SurveyAsk(bodyQs, opts)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
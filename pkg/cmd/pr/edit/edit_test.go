@@ -0,0 +1,355 @@
+package edit
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/cli/cli/context"
+	"github.com/cli/cli/git"
+	"github.com/cli/cli/internal/config"
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/httpmock"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/cli/cli/test"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewCmdEdit(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    string
+		isTTY   bool
+		want    EditOptions
+		wantErr string
+	}{
+		{
+			name:  "title and body flags",
+			args:  "23 --title mytitle --body mybody",
+			isTTY: false,
+			want: EditOptions{
+				SelectorArg:  "23",
+				Title:        "mytitle",
+				TitleChanged: true,
+				Body:         "mybody",
+				BodyChanged:  true,
+			},
+		},
+		{
+			name:  "add and remove flags",
+			args:  `23 --add-label bug --remove-assignee monalisa`,
+			isTTY: false,
+			want: EditOptions{
+				SelectorArg:     "23",
+				AddLabels:       []string{"bug"},
+				RemoveAssignees: []string{"monalisa"},
+			},
+		},
+		{
+			name:  "no flags prompts interactively",
+			args:  "23",
+			isTTY: true,
+			want: EditOptions{
+				SelectorArg: "23",
+				Interactive: true,
+			},
+		},
+		{
+			name:    "no flags non-interactive",
+			args:    "23",
+			isTTY:   false,
+			wantErr: "specify fields to edit with flags when not running interactively",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, _, _ := iostreams.Test()
+			io.SetStdoutTTY(tt.isTTY)
+			io.SetStdinTTY(tt.isTTY)
+			io.SetStderrTTY(tt.isTTY)
+
+			f := &cmdutil.Factory{
+				IOStreams: io,
+			}
+
+			var opts *EditOptions
+			cmd := NewCmdEdit(f, func(o *EditOptions) error {
+				opts = o
+				return nil
+			})
+
+			argv, err := shlex.Split(tt.args)
+			require.NoError(t, err)
+			cmd.SetArgs(argv)
+
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(ioutil.Discard)
+			cmd.SetErr(ioutil.Discard)
+
+			_, err = cmd.ExecuteC()
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			} else {
+				require.NoError(t, err)
+			}
+
+			assert.Equal(t, tt.want.SelectorArg, opts.SelectorArg)
+			assert.Equal(t, tt.want.Interactive, opts.Interactive)
+			assert.Equal(t, tt.want.Title, opts.Title)
+			assert.Equal(t, tt.want.TitleChanged, opts.TitleChanged)
+			assert.Equal(t, tt.want.Body, opts.Body)
+			assert.Equal(t, tt.want.BodyChanged, opts.BodyChanged)
+			assert.Equal(t, tt.want.AddLabels, opts.AddLabels)
+			assert.Equal(t, tt.want.RemoveAssignees, opts.RemoveAssignees)
+		})
+	}
+}
+
+func runCommand(rt http.RoundTripper, isTTY bool, cli string) (*test.CmdOut, error) {
+	io, _, stdout, stderr := iostreams.Test()
+	io.SetStdoutTTY(isTTY)
+	io.SetStdinTTY(isTTY)
+	io.SetStderrTTY(isTTY)
+
+	factory := &cmdutil.Factory{
+		IOStreams: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: rt}, nil
+		},
+		Config: func() (config.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+		Remotes: func() (context.Remotes, error) {
+			return context.Remotes{
+				{
+					Remote: &git.Remote{Name: "origin"},
+					Repo:   ghrepo.New("OWNER", "REPO"),
+				},
+			}, nil
+		},
+		Branch: func() (string, error) {
+			return "trunk", nil
+		},
+	}
+
+	cmd := NewCmdEdit(factory, nil)
+
+	cli = strings.TrimPrefix(cli, "pr edit")
+	argv, err := shlex.Split(cli)
+	if err != nil {
+		return nil, err
+	}
+	cmd.SetArgs(argv)
+
+	cmd.SetIn(&bytes.Buffer{})
+	cmd.SetOut(ioutil.Discard)
+	cmd.SetErr(ioutil.Discard)
+
+	_, err = cmd.ExecuteC()
+	return &test.CmdOut{
+		OutBuf: stdout,
+		ErrBuf: stderr,
+	}, err
+}
+
+func TestPrEdit_titleBody(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query PullRequestByNumber\b`),
+		httpmock.StringResponse(`
+		{ "data": { "repository": { "pullRequest": {
+			"id": "THE-ID",
+			"number": 23,
+			"title": "old title",
+			"body": "old body",
+			"url": "https://github.com/OWNER/REPO/pull/23"
+		} } } }`))
+	http.Register(
+		httpmock.GraphQL(`mutation PullRequestUpdate\b`),
+		httpmock.GraphQLMutation(`
+		{ "data": { "updatePullRequest": { "pullRequest": {
+			"id": "THE-ID"
+		} } } }`,
+			func(inputs map[string]interface{}) {
+				assert.Equal(t, "THE-ID", inputs["pullRequestId"])
+				assert.Equal(t, "new title", inputs["title"])
+				assert.Equal(t, "new body", inputs["body"])
+			}))
+
+	output, err := runCommand(http, false, `pr edit 23 --title "new title" --body "new body"`)
+	if err != nil {
+		t.Fatalf("error running command `pr edit`: %v", err)
+	}
+
+	assert.Equal(t, "https://github.com/OWNER/REPO/pull/23\n", output.String())
+}
+
+func TestPrEdit_labels(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query PullRequestByNumber\b`),
+		httpmock.StringResponse(`
+		{ "data": { "repository": { "pullRequest": {
+			"id": "THE-ID",
+			"number": 23,
+			"url": "https://github.com/OWNER/REPO/pull/23",
+			"labels": { "nodes": [
+				{ "name": "core" },
+				{ "name": "bug" }
+			] }
+		} } } }`))
+	http.Register(
+		httpmock.GraphQL(`query RepositoryResolveMetadataIDs\b`),
+		httpmock.StringResponse(`
+		{ "data": { "repository": { "l000": { "id": "BUGID", "name": "bug" }, "l001": { "id": "DOCSID", "name": "docs" } } } }`))
+	http.Register(
+		httpmock.GraphQL(`mutation PullRequestUpdate\b`),
+		httpmock.GraphQLMutation(`
+		{ "data": { "updatePullRequest": { "pullRequest": {
+			"id": "THE-ID"
+		} } } }`,
+			func(inputs map[string]interface{}) {
+				assert.Equal(t, "THE-ID", inputs["pullRequestId"])
+				assert.Equal(t, []interface{}{"BUGID", "DOCSID"}, inputs["labelIds"])
+			}))
+
+	output, err := runCommand(http, false, `pr edit 23 --add-label docs --remove-label core`)
+	if err != nil {
+		t.Fatalf("error running command `pr edit`: %v", err)
+	}
+
+	assert.Equal(t, "https://github.com/OWNER/REPO/pull/23\n", output.String())
+}
+
+func TestPrEdit_projectFields(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query PullRequestByNumber\b`),
+		httpmock.StringResponse(`
+		{ "data": { "repository": { "pullRequest": {
+			"id": "THE-ID",
+			"number": 23,
+			"url": "https://github.com/OWNER/REPO/pull/23"
+		} } } }`))
+	http.Register(
+		httpmock.GraphQL(`mutation PullRequestUpdate\b`),
+		httpmock.GraphQLMutation(`
+		{ "data": { "updatePullRequest": { "pullRequest": {
+			"id": "THE-ID"
+		} } } }`,
+			func(inputs map[string]interface{}) {
+				assert.Equal(t, "THE-ID", inputs["pullRequestId"])
+			}))
+	http.Register(
+		httpmock.GraphQL(`query ProjectV2ByTitle\b`),
+		httpmock.StringResponse(`
+		{ "data": {
+			"repository": { "projectsV2": { "nodes": [
+				{ "id": "PROJECT-ID", "title": "Roadmap" }
+			] } },
+			"repositoryOwner": { "projectsV2": { "nodes": [] } }
+		} }`))
+	http.Register(
+		httpmock.GraphQL(`mutation ProjectV2ItemAdd\b`),
+		httpmock.GraphQLMutation(`
+		{ "data": { "addProjectV2ItemById": { "item": {
+			"id": "ITEM-ID"
+		} } } }`,
+			func(inputs map[string]interface{}) {
+				assert.Equal(t, "PROJECT-ID", inputs["projectId"])
+				assert.Equal(t, "THE-ID", inputs["contentId"])
+			}))
+	http.Register(
+		httpmock.GraphQL(`query ProjectV2Fields\b`),
+		httpmock.StringResponse(`
+		{ "data": { "node": { "fields": { "nodes": [
+			{ "id": "TITLE-FIELD-ID", "name": "Title", "dataType": "TITLE" },
+			{ "id": "STATUS-FIELD-ID", "name": "Status", "dataType": "SINGLE_SELECT", "options": [
+				{ "id": "TODO-ID", "name": "Todo" },
+				{ "id": "DONE-ID", "name": "Done" }
+			] }
+		] } } } }`))
+	http.Register(
+		httpmock.GraphQL(`mutation ProjectV2ItemFieldUpdate\b`),
+		httpmock.GraphQLMutation(`
+		{ "data": { "updateProjectV2ItemFieldValue": { "projectV2Item": {
+			"id": "ITEM-ID"
+		} } } }`,
+			func(inputs map[string]interface{}) {
+				assert.Equal(t, "PROJECT-ID", inputs["projectId"])
+				assert.Equal(t, "ITEM-ID", inputs["itemId"])
+				assert.Equal(t, "STATUS-FIELD-ID", inputs["fieldId"])
+				assert.Equal(t, map[string]interface{}{"singleSelectOptionId": "TODO-ID"}, inputs["value"])
+			}))
+
+	output, err := runCommand(http, false, `pr edit 23 --add-project Roadmap --project-field Status=Todo`)
+	if err != nil {
+		t.Fatalf("error running command `pr edit`: %v", err)
+	}
+
+	assert.Equal(t, "https://github.com/OWNER/REPO/pull/23\n", output.String())
+}
+
+func TestPrEdit_reviewers(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query PullRequestByNumber\b`),
+		httpmock.StringResponse(`
+		{ "data": { "repository": { "pullRequest": {
+			"id": "THE-ID",
+			"number": 23,
+			"url": "https://github.com/OWNER/REPO/pull/23",
+			"reviewRequests": { "nodes": [
+				{ "requestedReviewer": { "__typename": "User", "login": "hubot" } }
+			] }
+		} } } }`))
+	http.Register(
+		httpmock.GraphQL(`query RepositoryResolveMetadataIDs\b`),
+		httpmock.StringResponse(`
+		{ "data": { "u000": { "id": "HUBOTID", "login": "hubot" }, "u001": { "id": "MONAID", "login": "monalisa" } } }`))
+	http.Register(
+		httpmock.GraphQL(`mutation PullRequestUpdate\b`),
+		httpmock.GraphQLMutation(`
+		{ "data": { "updatePullRequest": { "pullRequest": {
+			"id": "THE-ID"
+		} } } }`,
+			func(inputs map[string]interface{}) {
+				assert.Equal(t, "THE-ID", inputs["pullRequestId"])
+			}))
+	http.Register(
+		httpmock.GraphQL(`mutation PullRequestSetReviewers\b`),
+		httpmock.GraphQLMutation(`
+		{ "data": { "requestReviews": { "pullRequest": {
+			"id": "THE-ID"
+		} } } }`,
+			func(inputs map[string]interface{}) {
+				assert.Equal(t, "THE-ID", inputs["pullRequestId"])
+				assert.Equal(t, []interface{}{"HUBOTID", "MONAID"}, inputs["userIds"])
+				assert.Equal(t, []interface{}{}, inputs["teamIds"])
+				assert.Equal(t, false, inputs["union"])
+			}))
+
+	output, err := runCommand(http, false, `pr edit 23 --add-reviewer monalisa`)
+	if err != nil {
+		t.Fatalf("error running command `pr edit`: %v", err)
+	}
+
+	assert.Equal(t, "https://github.com/OWNER/REPO/pull/23\n", output.String())
+}
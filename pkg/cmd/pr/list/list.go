@@ -25,8 +25,15 @@ type ListOptions struct {
 	LimitResults int
 	State        string
 	BaseBranch   string
+	HeadBranch   string
 	Labels       []string
 	Assignee     string
+	Author       string
+	Search       string
+	MergedAt     string
+	Draft        *bool
+
+	Exporter *cmdutil.Exporter
 }
 
 func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Command {
@@ -42,6 +49,9 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 			$ gh pr list --limit 999
 			$ gh pr list --state closed
 			$ gh pr list --label "priority 1" --label "bug"
+			$ gh pr list --author monalisa --draft
+			$ gh pr list --search "status:success review:required"
+			$ gh pr list --state merged --merged-at ">2021-06-01"
 			$ gh pr list --web
     	`),
 		Args: cmdutil.NoArgsQuoteReminder,
@@ -53,6 +63,22 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 				return &cmdutil.FlagError{Err: fmt.Errorf("invalid value for --limit: %v", opts.LimitResults)}
 			}
 
+			if cmd.Flags().Changed("draft") {
+				isDraft, _ := cmd.Flags().GetBool("draft")
+				opts.Draft = &isDraft
+			}
+
+			if opts.MergedAt != "" {
+				if cmd.Flags().Changed("state") && opts.State != "merged" {
+					return &cmdutil.FlagError{Err: fmt.Errorf("--merged-at is only supported with --state merged")}
+				}
+				opts.State = "merged"
+			}
+
+			if opts.WebMode && opts.Exporter != nil {
+				return &cmdutil.FlagError{Err: fmt.Errorf("--json is not supported with --web")}
+			}
+
 			if runF != nil {
 				return runF(opts)
 			}
@@ -64,8 +90,14 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 	cmd.Flags().IntVarP(&opts.LimitResults, "limit", "L", 30, "Maximum number of items to fetch")
 	cmd.Flags().StringVarP(&opts.State, "state", "s", "open", "Filter by state: {open|closed|merged|all}")
 	cmd.Flags().StringVarP(&opts.BaseBranch, "base", "B", "", "Filter by base branch")
+	cmd.Flags().StringVarP(&opts.HeadBranch, "head", "H", "", "Filter by head branch")
 	cmd.Flags().StringSliceVarP(&opts.Labels, "label", "l", nil, "Filter by labels")
 	cmd.Flags().StringVarP(&opts.Assignee, "assignee", "a", "", "Filter by assignee")
+	cmd.Flags().StringVarP(&opts.Author, "author", "A", "", "Filter by author")
+	cmd.Flags().Bool("draft", false, "Filter by draft state")
+	cmd.Flags().StringVarP(&opts.Search, "search", "S", "", "Search pull requests with a query")
+	cmd.Flags().StringVar(&opts.MergedAt, "merged-at", "", "Filter merged pull requests by a date or `range` such as \">2021-06-01\"; implies --state merged")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, shared.PRListFields)
 
 	return cmd
 }
@@ -89,6 +121,7 @@ func listRun(opts *ListOptions) error {
 			State:      opts.State,
 			Assignee:   opts.Assignee,
 			Labels:     opts.Labels,
+			Author:     opts.Author,
 			BaseBranch: opts.BaseBranch,
 		})
 		if err != nil {
@@ -124,23 +157,56 @@ func listRun(opts *ListOptions) error {
 	if opts.BaseBranch != "" {
 		params["baseBranch"] = opts.BaseBranch
 	}
+	if opts.HeadBranch != "" {
+		params["headBranch"] = opts.HeadBranch
+	}
 	if opts.Assignee != "" {
 		params["assignee"] = opts.Assignee
 	}
+	if opts.Author != "" {
+		params["author"] = opts.Author
+	}
+	if opts.Search != "" {
+		params["search"] = opts.Search
+	}
+	if opts.Draft != nil {
+		params["draft"] = *opts.Draft
+	}
+	if opts.MergedAt != "" {
+		params["mergedAt"] = opts.MergedAt
+	}
+
+	if opts.Exporter == nil && !opts.IO.IsStdoutTTY() {
+		// machine output is rendered page by page as results arrive, so very
+		// large listings don't have to be held in memory before the first row
+		// is written
+		return api.PullRequestListPaged(apiClient, baseRepo, params, opts.LimitResults, func(page []api.PullRequest, totalCount int) error {
+			return printPullRequests(opts.IO, page)
+		})
+	}
 
 	listResult, err := api.PullRequestList(apiClient, baseRepo, params, opts.LimitResults)
 	if err != nil {
 		return err
 	}
 
+	if opts.Exporter != nil {
+		return shared.ExportPRs(opts.IO.Out, opts.Exporter.Fields(), listResult.PullRequests)
+	}
+
 	if opts.IO.IsStdoutTTY() {
-		hasFilters := opts.State != "open" || len(opts.Labels) > 0 || opts.BaseBranch != "" || opts.Assignee != ""
+		hasFilters := opts.State != "open" || len(opts.Labels) > 0 || opts.BaseBranch != "" || opts.HeadBranch != "" ||
+			opts.Assignee != "" || opts.Author != "" || opts.Search != "" || opts.Draft != nil
 		title := shared.ListHeader(ghrepo.FullName(baseRepo), "pull request", len(listResult.PullRequests), listResult.TotalCount, hasFilters)
 		fmt.Fprintf(opts.IO.ErrOut, "\n%s\n\n", title)
 	}
 
-	table := utils.NewTablePrinter(opts.IO)
-	for _, pr := range listResult.PullRequests {
+	return printPullRequests(opts.IO, listResult.PullRequests)
+}
+
+func printPullRequests(io *iostreams.IOStreams, prs []api.PullRequest) error {
+	table := utils.NewTablePrinter(io)
+	for _, pr := range prs {
 		prNum := strconv.Itoa(pr.Number)
 		if table.IsTTY() {
 			prNum = "#" + prNum
@@ -153,12 +219,7 @@ func listRun(opts *ListOptions) error {
 		}
 		table.EndRow()
 	}
-	err = table.Render()
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return table.Render()
 }
 
 func prStateWithDraft(pr *api.PullRequest) string {
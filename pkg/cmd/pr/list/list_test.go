@@ -2,6 +2,7 @@ package list
 
 import (
 	"bytes"
+	"encoding/json"
 	"io/ioutil"
 	"net/http"
 	"os/exec"
@@ -95,6 +96,44 @@ Showing 3 of 3 open pull requests in OWNER/REPO
 	}
 }
 
+func TestPRList_json(t *testing.T) {
+	http := initFakeHTTP()
+	defer http.Verify(t)
+
+	http.Register(httpmock.GraphQL(`query PullRequestList\b`), httpmock.FileResponse("./fixtures/prList.json"))
+
+	output, err := runCommand(http, false, `--json number,title,headRefName`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, "", output.Stderr())
+
+	var prs []map[string]interface{}
+	if err := json.Unmarshal(output.OutBuf.Bytes(), &prs); err != nil {
+		t.Fatalf("invalid JSON output: %v", err)
+	}
+	if len(prs) != 3 {
+		t.Fatalf("expected 3 pull requests, got %d", len(prs))
+	}
+	assert.Equal(t, float64(32), prs[0]["number"])
+	assert.Equal(t, "New feature", prs[0]["title"])
+	assert.Equal(t, "feature", prs[0]["headRefName"])
+	if _, ok := prs[0]["state"]; ok {
+		t.Error("expected state to be omitted")
+	}
+}
+
+func TestPRList_jsonInvalidField(t *testing.T) {
+	http := initFakeHTTP()
+	defer http.Verify(t)
+
+	_, err := runCommand(http, false, `--json number,colour`)
+	if err == nil || !strings.HasPrefix(err.Error(), `unknown JSON field "colour"`) {
+		t.Errorf("got error %v", err)
+	}
+}
+
 func TestPRList_nontty(t *testing.T) {
 	http := initFakeHTTP()
 	defer http.Verify(t)
@@ -188,7 +227,7 @@ func TestPRList_filteringAssignee(t *testing.T) {
 	http.Register(
 		httpmock.GraphQL(`query PullRequestList\b`),
 		httpmock.GraphQLQuery(`{}`, func(_ string, params map[string]interface{}) {
-			assert.Equal(t, `repo:OWNER/REPO assignee:hubot is:pr sort:created-desc is:merged label:"needs tests" base:"develop"`, params["q"].(string))
+			assert.Equal(t, `repo:OWNER/REPO is:pr sort:created-desc assignee:hubot is:merged label:"needs tests" base:"develop"`, params["q"].(string))
 		}))
 
 	_, err := runCommand(http, true, `-s merged -l "needs tests" -a hubot -B develop`)
@@ -197,6 +236,91 @@ func TestPRList_filteringAssignee(t *testing.T) {
 	}
 }
 
+func TestPRList_filteringAuthorDraft(t *testing.T) {
+	http := initFakeHTTP()
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query PullRequestList\b`),
+		httpmock.GraphQLQuery(`{}`, func(_ string, params map[string]interface{}) {
+			assert.Equal(t, `repo:OWNER/REPO is:pr sort:created-desc author:monalisa draft:true state:open`, params["q"].(string))
+		}))
+
+	_, err := runCommand(http, true, `-A monalisa --draft`)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPRList_filteringMergedAt(t *testing.T) {
+	http := initFakeHTTP()
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query PullRequestList\b`),
+		httpmock.GraphQLQuery(`{}`, func(_ string, params map[string]interface{}) {
+			assert.Equal(t, `repo:OWNER/REPO is:pr sort:created-desc is:merged merged:>2021-06-01`, params["q"].(string))
+		}))
+
+	_, err := runCommand(http, true, `--state merged --merged-at ">2021-06-01"`)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPRList_mergedAtImpliesMergedState(t *testing.T) {
+	http := initFakeHTTP()
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query PullRequestList\b`),
+		httpmock.GraphQLQuery(`{}`, func(_ string, params map[string]interface{}) {
+			assert.Equal(t, `repo:OWNER/REPO is:pr sort:created-desc is:merged merged:2021-06-01..2021-07-01`, params["q"].(string))
+		}))
+
+	_, err := runCommand(http, true, `--merged-at 2021-06-01..2021-07-01`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = runCommand(http, true, `--state open --merged-at 2021-06-01..2021-07-01`)
+	if err == nil || err.Error() != "--merged-at is only supported with --state merged" {
+		t.Errorf("got error %v", err)
+	}
+}
+
+func TestPRList_filteringSearch(t *testing.T) {
+	http := initFakeHTTP()
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query PullRequestList\b`),
+		httpmock.GraphQLQuery(`{}`, func(_ string, params map[string]interface{}) {
+			assert.Equal(t, `repo:OWNER/REPO is:pr sort:created-desc state:open head:"patch-1" review:required`, params["q"].(string))
+		}))
+
+	_, err := runCommand(http, true, `-H patch-1 -S "review:required"`)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPRList_filteringHeadBranch(t *testing.T) {
+	http := initFakeHTTP()
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query PullRequestList\b`),
+		httpmock.GraphQLQuery(`{}`, func(_ string, params map[string]interface{}) {
+			assert.Equal(t, "patch-1", params["headBranch"].(string))
+		}))
+
+	_, err := runCommand(http, true, `-H patch-1`)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
 func TestPRList_filteringAssigneeLabels(t *testing.T) {
 	http := initFakeHTTP()
 	defer http.Verify(t)
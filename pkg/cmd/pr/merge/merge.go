@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/MakeNowJust/heredoc"
@@ -29,10 +30,16 @@ type MergeOptions struct {
 	Branch     func() (string, error)
 
 	SelectorArg       string
+	SelectorArgs      []string
 	DeleteBranch      bool
 	DeleteLocalBranch bool
 	MergeMethod       api.PullRequestMergeMethod
 	InteractiveMode   bool
+	AutoMerge         bool
+	DisableAutoMerge  bool
+	SquashAuthor      string
+	CoAuthors         bool
+	CoAuthorsSet      bool
 }
 
 func NewCmdMerge(f *cmdutil.Factory, runF func(*MergeOptions) error) *cobra.Command {
@@ -51,7 +58,7 @@ func NewCmdMerge(f *cmdutil.Factory, runF func(*MergeOptions) error) *cobra.Comm
 	)
 
 	cmd := &cobra.Command{
-		Use:   "merge [<number> | <url> | <branch>]",
+		Use:   "merge [<number> | <url> | <branch>]...",
 		Short: "Merge a pull request",
 		Long: heredoc.Doc(`
 			Merge a pull request on GitHub.
@@ -59,7 +66,7 @@ func NewCmdMerge(f *cmdutil.Factory, runF func(*MergeOptions) error) *cobra.Comm
 			By default, the head branch of the pull request will get deleted on both remote and local repositories.
 			To retain the branch, use '--delete-branch=false'.
     	`),
-		Args: cobra.MaximumNArgs(1),
+		Args: cobra.ArbitraryArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// support `-R, --repo` override
 			opts.BaseRepo = f.BaseRepo
@@ -70,6 +77,11 @@ func NewCmdMerge(f *cmdutil.Factory, runF func(*MergeOptions) error) *cobra.Comm
 
 			if len(args) > 0 {
 				opts.SelectorArg = args[0]
+				opts.SelectorArgs = args
+			}
+
+			if opts.AutoMerge && opts.DisableAutoMerge {
+				return &cmdutil.FlagError{Err: errors.New("only one of --auto or --disable-auto can be enabled")}
 			}
 
 			methodFlags := 0
@@ -86,14 +98,22 @@ func NewCmdMerge(f *cmdutil.Factory, runF func(*MergeOptions) error) *cobra.Comm
 				methodFlags++
 			}
 			if methodFlags == 0 {
-				if !opts.IO.CanPrompt() {
+				if opts.AutoMerge {
+					return &cmdutil.FlagError{Err: errors.New("--merge, --rebase, or --squash required with --auto")}
+				}
+				if !opts.DisableAutoMerge && !opts.IO.CanPrompt() {
 					return &cmdutil.FlagError{Err: errors.New("--merge, --rebase, or --squash required when not running interactively")}
 				}
-				opts.InteractiveMode = true
+				opts.InteractiveMode = !opts.DisableAutoMerge
 			} else if methodFlags > 1 {
 				return &cmdutil.FlagError{Err: errors.New("only one of --merge, --rebase, or --squash can be enabled")}
 			}
 
+			if opts.SquashAuthor != "" && opts.SquashAuthor != "author" && opts.SquashAuthor != "merger" {
+				return &cmdutil.FlagError{Err: fmt.Errorf("invalid value for --squash-author: %q", opts.SquashAuthor)}
+			}
+
+			opts.CoAuthorsSet = cmd.Flags().Changed("co-authors")
 			opts.DeleteLocalBranch = !cmd.Flags().Changed("repo")
 
 			if runF != nil {
@@ -107,11 +127,24 @@ func NewCmdMerge(f *cmdutil.Factory, runF func(*MergeOptions) error) *cobra.Comm
 	cmd.Flags().BoolVarP(&flagMerge, "merge", "m", false, "Merge the commits with the base branch")
 	cmd.Flags().BoolVarP(&flagRebase, "rebase", "r", false, "Rebase the commits onto the base branch")
 	cmd.Flags().BoolVarP(&flagSquash, "squash", "s", false, "Squash the commits into one commit and merge it into the base branch")
+	cmd.Flags().BoolVar(&opts.AutoMerge, "auto", false, "Automatically merge only after requirements are met")
+	cmd.Flags().BoolVar(&opts.DisableAutoMerge, "disable-auto", false, "Disable auto-merge for this pull request")
+	cmd.Flags().StringVar(&opts.SquashAuthor, "squash-author", "", "Leave the pull request `author` or the merger out of the squash commit's Co-authored-by trailers")
+	cmd.Flags().BoolVar(&opts.CoAuthors, "co-authors", true, "Append Co-authored-by trailers for the other commit authors when squashing")
 
 	return cmd
 }
 
 func mergeRun(opts *MergeOptions) error {
+	if len(opts.SelectorArgs) > 1 {
+		return shared.BatchRun(opts.IO, opts.SelectorArgs, func(selector string) error {
+			o := *opts
+			o.SelectorArg = selector
+			o.SelectorArgs = []string{selector}
+			return mergeRun(&o)
+		})
+	}
+
 	httpClient, err := opts.HttpClient()
 	if err != nil {
 		return err
@@ -123,6 +156,22 @@ func mergeRun(opts *MergeOptions) error {
 		return err
 	}
 
+	isTerminal := opts.IO.IsStdoutTTY()
+
+	if opts.DisableAutoMerge {
+		if pr.State == "MERGED" {
+			return fmt.Errorf("%s Pull request #%d (%s) was already merged", utils.Red("!"), pr.Number, pr.Title)
+		}
+		err := api.PullRequestAutoMergeDisable(apiClient, baseRepo, pr)
+		if err != nil {
+			return fmt.Errorf("API call failed: %w", err)
+		}
+		if isTerminal {
+			fmt.Fprintf(opts.IO.ErrOut, "%s Auto-merge disabled for pull request #%d (%s)\n", utils.Magenta("✔"), pr.Number, pr.Title)
+		}
+		return nil
+	}
+
 	if pr.Mergeable == "CONFLICTING" {
 		err := fmt.Errorf("%s Pull request #%d (%s) has conflicts and isn't mergeable ", utils.Red("!"), pr.Number, pr.Title)
 		return err
@@ -145,16 +194,35 @@ func mergeRun(opts *MergeOptions) error {
 		}
 	}
 
+	if opts.AutoMerge {
+		err := api.PullRequestAutoMergeEnable(apiClient, baseRepo, pr, mergeMethod)
+		if err != nil {
+			if strings.Contains(err.Error(), "not allowed for this repository") {
+				return fmt.Errorf("auto-merge is not allowed by %s; a repository administrator can enable it in the repository settings", ghrepo.FullName(baseRepo))
+			}
+			return fmt.Errorf("API call failed: %w", err)
+		}
+		if isTerminal {
+			fmt.Fprintf(opts.IO.ErrOut, "%s Auto-merge enabled for pull request #%d (%s); it will merge once requirements are met\n", utils.Magenta("✔"), pr.Number, pr.Title)
+		}
+		return nil
+	}
+
 	var action string
 	if mergeMethod == api.PullRequestMergeMethodRebase {
 		action = "Rebased and merged"
-		err = api.PullRequestMerge(apiClient, baseRepo, pr, api.PullRequestMergeMethodRebase)
+		err = api.PullRequestMerge(apiClient, baseRepo, pr, api.PullRequestMergeMethodRebase, "")
 	} else if mergeMethod == api.PullRequestMergeMethodSquash {
 		action = "Squashed and merged"
-		err = api.PullRequestMerge(apiClient, baseRepo, pr, api.PullRequestMergeMethodSquash)
+		var commitBody string
+		commitBody, err = squashCommitBody(apiClient, baseRepo, pr, opts)
+		if err != nil {
+			return err
+		}
+		err = api.PullRequestMerge(apiClient, baseRepo, pr, api.PullRequestMergeMethodSquash, commitBody)
 	} else if mergeMethod == api.PullRequestMergeMethodMerge {
 		action = "Merged"
-		err = api.PullRequestMerge(apiClient, baseRepo, pr, api.PullRequestMergeMethodMerge)
+		err = api.PullRequestMerge(apiClient, baseRepo, pr, api.PullRequestMergeMethodMerge, "")
 	} else {
 		err = fmt.Errorf("unknown merge method (%d) used", mergeMethod)
 		return err
@@ -164,8 +232,6 @@ func mergeRun(opts *MergeOptions) error {
 		return fmt.Errorf("API call failed: %w", err)
 	}
 
-	isTerminal := opts.IO.IsStdoutTTY()
-
 	if isTerminal {
 		fmt.Fprintf(opts.IO.ErrOut, "%s %s pull request #%d (%s)\n", utils.Magenta("✔"), action, pr.Number, pr.Title)
 	}
@@ -213,6 +279,15 @@ func mergeRun(opts *MergeOptions) error {
 				err = fmt.Errorf("failed to delete remote branch %s: %w", utils.Cyan(pr.HeadRefName), err)
 				return err
 			}
+
+			// prune the stale tracking ref left behind by the remote deletion
+			if opts.DeleteLocalBranch {
+				if remotes, err := opts.Remotes(); err == nil {
+					if baseRemote, err := remotes.FindByRepo(baseRepo.RepoOwner(), baseRepo.RepoName()); err == nil {
+						_ = git.PruneRemoteTrackingRef(baseRemote.Name, pr.HeadRefName)
+					}
+				}
+			}
 		}
 
 		if isTerminal {
@@ -223,6 +298,62 @@ func mergeRun(opts *MergeOptions) error {
 	return nil
 }
 
+// squashCommitBody builds the body of a squash commit: a Co-authored-by
+// trailer for every commit author except the one treated as the primary
+// author. Actual commit authorship is decided by GitHub when it performs the
+// squash; this setting only controls who is left out of the trailers. By
+// default that is the pull request author; with '--squash-author merger' the
+// merging user is left out and the pull request author gets a trailer instead.
+// The defaults can be set per host with the "squash_author" and
+// "squash_co_authors" config settings.
+func squashCommitBody(apiClient *api.Client, baseRepo ghrepo.Interface, pr *api.PullRequest, opts *MergeOptions) (string, error) {
+	cfg, err := opts.Config()
+	if err != nil {
+		return "", err
+	}
+
+	squashAuthor := opts.SquashAuthor
+	if squashAuthor == "" {
+		squashAuthor, _ = cfg.Get(baseRepo.RepoHost(), "squash_author")
+	}
+	if squashAuthor != "" && squashAuthor != "author" && squashAuthor != "merger" {
+		return "", fmt.Errorf("invalid squash_author setting: %q", squashAuthor)
+	}
+
+	coAuthors := opts.CoAuthors
+	if !opts.CoAuthorsSet {
+		if setting, _ := cfg.Get(baseRepo.RepoHost(), "squash_co_authors"); setting == "false" {
+			coAuthors = false
+		}
+	}
+	if !coAuthors {
+		return "", nil
+	}
+
+	primary := pr.Author.Login
+	if squashAuthor == "merger" {
+		primary, err = api.CurrentLoginName(apiClient, baseRepo.RepoHost())
+		if err != nil {
+			return "", err
+		}
+	}
+
+	authors, err := api.PullRequestCommitAuthors(apiClient, baseRepo, pr)
+	if err != nil {
+		return "", err
+	}
+
+	var trailers []string
+	for _, author := range authors {
+		if author.Login != "" && strings.EqualFold(author.Login, primary) {
+			continue
+		}
+		trailers = append(trailers, fmt.Sprintf("Co-authored-by: %s <%s>", author.Name, author.Email))
+	}
+
+	return strings.Join(trailers, "\n"), nil
+}
+
 func prInteractiveMerge(deleteLocalBranch bool, crossRepoPR bool) (api.PullRequestMergeMethod, bool, error) {
 	mergeMethodQuestion := &survey.Question{
 		Name: "mergeMethod",
@@ -301,6 +301,81 @@ func TestPrMerge_withRepoFlag(t *testing.T) {
 	}
 }
 
+func TestPrMerge_auto(t *testing.T) {
+	http := initFakeHTTP()
+	defer http.Verify(t)
+	http.Register(
+		httpmock.GraphQL(`query PullRequestByNumber\b`),
+		httpmock.StringResponse(`
+		{ "data": { "repository": { "pullRequest": {
+			"id": "THE-ID",
+			"number": 1,
+			"title": "The title of the PR",
+			"state": "OPEN",
+			"headRefName": "blueberries",
+			"headRepositoryOwner": {"login": "OWNER"}
+		} } } }`))
+	http.Register(
+		httpmock.GraphQL(`mutation PullRequestAutoMergeEnable\b`),
+		httpmock.GraphQLQuery(`{}`, func(_ string, params map[string]interface{}) {
+			assert.Equal(t, "THE-ID", params["id"].(string))
+			assert.Equal(t, "SQUASH", params["method"].(string))
+		}))
+
+	output, err := runCommand(http, "master", true, "pr merge 1 --auto --squash")
+	if err != nil {
+		t.Fatalf("error running command `pr merge --auto`: %v", err)
+	}
+
+	r := regexp.MustCompile(`Auto-merge enabled for pull request #1 \(The title of the PR\)`)
+
+	if !r.MatchString(output.Stderr()) {
+		t.Fatalf("output did not match regexp /%s/\n> output\n%q\n", r, output.Stderr())
+	}
+}
+
+func TestPrMerge_autoWithoutMethod(t *testing.T) {
+	http := initFakeHTTP()
+	defer http.Verify(t)
+
+	_, err := runCommand(http, "master", true, "pr merge 1 --auto")
+	if err == nil || err.Error() != "--merge, --rebase, or --squash required with --auto" {
+		t.Fatalf("got error %v", err)
+	}
+}
+
+func TestPrMerge_disableAuto(t *testing.T) {
+	http := initFakeHTTP()
+	defer http.Verify(t)
+	http.Register(
+		httpmock.GraphQL(`query PullRequestByNumber\b`),
+		httpmock.StringResponse(`
+		{ "data": { "repository": { "pullRequest": {
+			"id": "THE-ID",
+			"number": 1,
+			"title": "The title of the PR",
+			"state": "OPEN",
+			"headRefName": "blueberries",
+			"headRepositoryOwner": {"login": "OWNER"}
+		} } } }`))
+	http.Register(
+		httpmock.GraphQL(`mutation PullRequestAutoMergeDisable\b`),
+		httpmock.GraphQLQuery(`{}`, func(_ string, params map[string]interface{}) {
+			assert.Equal(t, "THE-ID", params["id"].(string))
+		}))
+
+	output, err := runCommand(http, "master", true, "pr merge 1 --disable-auto")
+	if err != nil {
+		t.Fatalf("error running command `pr merge --disable-auto`: %v", err)
+	}
+
+	r := regexp.MustCompile(`Auto-merge disabled for pull request #1 \(The title of the PR\)`)
+
+	if !r.MatchString(output.Stderr()) {
+		t.Fatalf("output did not match regexp /%s/\n> output\n%q\n", r, output.Stderr())
+	}
+}
+
 func TestPrMerge_deleteBranch(t *testing.T) {
 	http := initFakeHTTP()
 	defer http.Verify(t)
@@ -464,15 +539,31 @@ func TestPrMerge_squash(t *testing.T) {
 			"number": 3,
 			"title": "The title of the PR",
 			"state": "OPEN",
+			"author": {"login": "monalisa"},
 			"headRefName": "blueberries",
 			"headRepositoryOwner": {"login": "OWNER"}
 		} } } }`))
+	http.Register(
+		httpmock.GraphQL(`query PullRequestCommitAuthors\b`),
+		httpmock.StringResponse(`
+		{ "data": { "repository": { "pullRequest": { "commits": {
+			"nodes": [
+				{ "commit": { "authors": { "nodes": [
+					{ "name": "Mona Lisa", "email": "monalisa@example.com", "user": {"login": "monalisa"} }
+				] } } },
+				{ "commit": { "authors": { "nodes": [
+					{ "name": "Hubot", "email": "hubot@example.com", "user": {"login": "hubot"} }
+				] } } }
+			],
+			"pageInfo": { "hasNextPage": false, "endCursor": "" }
+		} } } } }`))
 	http.Register(
 		httpmock.GraphQL(`mutation PullRequestMerge\b`),
 		httpmock.GraphQLMutation(`{}`, func(input map[string]interface{}) {
 			assert.Equal(t, "THE-ID", input["pullRequestId"].(string))
 			assert.Equal(t, "SQUASH", input["mergeMethod"].(string))
 			assert.Equal(t, "The title of the PR (#3)", input["commitHeadline"].(string))
+			assert.Equal(t, "Co-authored-by: Hubot <hubot@example.com>", input["commitBody"].(string))
 		}))
 	http.Register(
 		httpmock.REST("DELETE", "repos/OWNER/REPO/git/refs/heads/blueberries"),
@@ -494,6 +585,107 @@ func TestPrMerge_squash(t *testing.T) {
 	test.ExpectLines(t, output.Stderr(), "Squashed and merged pull request #3", `Deleted branch.*blueberries`)
 }
 
+func TestPrMerge_squashAuthorMerger(t *testing.T) {
+	http := initFakeHTTP()
+	defer http.Verify(t)
+	http.Register(
+		httpmock.GraphQL(`query PullRequestByNumber\b`),
+		httpmock.StringResponse(`
+		{ "data": { "repository": { "pullRequest": {
+			"id": "THE-ID",
+			"number": 3,
+			"title": "The title of the PR",
+			"state": "OPEN",
+			"author": {"login": "monalisa"},
+			"headRefName": "blueberries",
+			"headRepositoryOwner": {"login": "OWNER"}
+		} } } }`))
+	http.Register(
+		httpmock.GraphQL(`query UserCurrent\b`),
+		httpmock.StringResponse(`
+		{ "data": { "viewer": { "login": "hubot" } } }`))
+	http.Register(
+		httpmock.GraphQL(`query PullRequestCommitAuthors\b`),
+		httpmock.StringResponse(`
+		{ "data": { "repository": { "pullRequest": { "commits": {
+			"nodes": [
+				{ "commit": { "authors": { "nodes": [
+					{ "name": "Mona Lisa", "email": "monalisa@example.com", "user": {"login": "monalisa"} }
+				] } } },
+				{ "commit": { "authors": { "nodes": [
+					{ "name": "Hubot", "email": "hubot@example.com", "user": {"login": "hubot"} }
+				] } } }
+			],
+			"pageInfo": { "hasNextPage": false, "endCursor": "" }
+		} } } } }`))
+	http.Register(
+		httpmock.GraphQL(`mutation PullRequestMerge\b`),
+		httpmock.GraphQLMutation(`{}`, func(input map[string]interface{}) {
+			assert.Equal(t, "SQUASH", input["mergeMethod"].(string))
+			assert.Equal(t, "Co-authored-by: Mona Lisa <monalisa@example.com>", input["commitBody"].(string))
+		}))
+	http.Register(
+		httpmock.REST("DELETE", "repos/OWNER/REPO/git/refs/heads/blueberries"),
+		httpmock.StringResponse(`{}`))
+
+	cs, cmdTeardown := test.InitCmdStubber()
+	defer cmdTeardown()
+
+	cs.Stub("") // git config --get-regexp ^branch\.blueberries\.(remote|merge)$
+	cs.Stub("") // git symbolic-ref --quiet --short HEAD
+	cs.Stub("") // git checkout master
+	cs.Stub("") // git branch -d
+
+	output, err := runCommand(http, "master", true, "pr merge 3 --squash --squash-author merger")
+	if err != nil {
+		t.Fatalf("error running command `pr merge`: %v", err)
+	}
+
+	test.ExpectLines(t, output.Stderr(), "Squashed and merged pull request #3")
+}
+
+func TestPrMerge_squashNoCoAuthors(t *testing.T) {
+	http := initFakeHTTP()
+	defer http.Verify(t)
+	http.Register(
+		httpmock.GraphQL(`query PullRequestByNumber\b`),
+		httpmock.StringResponse(`
+		{ "data": { "repository": { "pullRequest": {
+			"id": "THE-ID",
+			"number": 3,
+			"title": "The title of the PR",
+			"state": "OPEN",
+			"author": {"login": "monalisa"},
+			"headRefName": "blueberries",
+			"headRepositoryOwner": {"login": "OWNER"}
+		} } } }`))
+	http.Register(
+		httpmock.GraphQL(`mutation PullRequestMerge\b`),
+		httpmock.GraphQLMutation(`{}`, func(input map[string]interface{}) {
+			assert.Equal(t, "SQUASH", input["mergeMethod"].(string))
+			_, hasBody := input["commitBody"]
+			assert.Equal(t, false, hasBody)
+		}))
+	http.Register(
+		httpmock.REST("DELETE", "repos/OWNER/REPO/git/refs/heads/blueberries"),
+		httpmock.StringResponse(`{}`))
+
+	cs, cmdTeardown := test.InitCmdStubber()
+	defer cmdTeardown()
+
+	cs.Stub("") // git config --get-regexp ^branch\.blueberries\.(remote|merge)$
+	cs.Stub("") // git symbolic-ref --quiet --short HEAD
+	cs.Stub("") // git checkout master
+	cs.Stub("") // git branch -d
+
+	output, err := runCommand(http, "master", true, "pr merge 3 --squash --co-authors=false")
+	if err != nil {
+		t.Fatalf("error running command `pr merge`: %v", err)
+	}
+
+	test.ExpectLines(t, output.Stderr(), "Squashed and merged pull request #3")
+}
+
 func TestPrMerge_alreadyMerged(t *testing.T) {
 	http := initFakeHTTP()
 	defer http.Verify(t)
@@ -5,8 +5,10 @@ import (
 	cmdCheckout "github.com/cli/cli/pkg/cmd/pr/checkout"
 	cmdChecks "github.com/cli/cli/pkg/cmd/pr/checks"
 	cmdClose "github.com/cli/cli/pkg/cmd/pr/close"
+	cmdComment "github.com/cli/cli/pkg/cmd/pr/comment"
 	cmdCreate "github.com/cli/cli/pkg/cmd/pr/create"
 	cmdDiff "github.com/cli/cli/pkg/cmd/pr/diff"
+	cmdEdit "github.com/cli/cli/pkg/cmd/pr/edit"
 	cmdList "github.com/cli/cli/pkg/cmd/pr/list"
 	cmdMerge "github.com/cli/cli/pkg/cmd/pr/merge"
 	cmdReady "github.com/cli/cli/pkg/cmd/pr/ready"
@@ -43,8 +45,10 @@ func NewCmdPR(f *cmdutil.Factory) *cobra.Command {
 
 	cmd.AddCommand(cmdCheckout.NewCmdCheckout(f, nil))
 	cmd.AddCommand(cmdClose.NewCmdClose(f, nil))
+	cmd.AddCommand(cmdComment.NewCmdComment(f, nil))
 	cmd.AddCommand(cmdCreate.NewCmdCreate(f, nil))
 	cmd.AddCommand(cmdDiff.NewCmdDiff(f, nil))
+	cmd.AddCommand(cmdEdit.NewCmdEdit(f, nil))
 	cmd.AddCommand(cmdList.NewCmdList(f, nil))
 	cmd.AddCommand(cmdMerge.NewCmdMerge(f, nil))
 	cmd.AddCommand(cmdReady.NewCmdReady(f, nil))
@@ -25,6 +25,7 @@ type ReadyOptions struct {
 	Branch     func() (string, error)
 
 	SelectorArg string
+	Undo        bool
 }
 
 func NewCmdReady(f *cmdutil.Factory, runF func(*ReadyOptions) error) *cobra.Command {
@@ -59,6 +60,8 @@ func NewCmdReady(f *cmdutil.Factory, runF func(*ReadyOptions) error) *cobra.Comm
 		},
 	}
 
+	cmd.Flags().BoolVar(&opts.Undo, "undo", false, "Convert a pull request back to a draft")
+
 	return cmd
 }
 
@@ -74,6 +77,10 @@ func readyRun(opts *ReadyOptions) error {
 		return err
 	}
 
+	if opts.Undo {
+		return undoRun(opts, apiClient, baseRepo, pr)
+	}
+
 	if pr.Closed {
 		fmt.Fprintf(opts.IO.ErrOut, "%s Pull request #%d is closed. Only draft pull requests can be marked as \"ready for review\"", utils.Red("!"), pr.Number)
 		return cmdutil.SilentError
@@ -91,3 +98,22 @@ func readyRun(opts *ReadyOptions) error {
 
 	return nil
 }
+
+func undoRun(opts *ReadyOptions, apiClient *api.Client, baseRepo ghrepo.Interface, pr *api.PullRequest) error {
+	if pr.Closed {
+		fmt.Fprintf(opts.IO.ErrOut, "%s Pull request #%d is closed. Only open pull requests can be converted to drafts", utils.Red("!"), pr.Number)
+		return cmdutil.SilentError
+	} else if pr.IsDraft {
+		fmt.Fprintf(opts.IO.ErrOut, "%s Pull request #%d is already a draft\n", utils.Yellow("!"), pr.Number)
+		return nil
+	}
+
+	err := api.PullRequestConvertToDraft(apiClient, baseRepo, pr)
+	if err != nil {
+		return fmt.Errorf("API call failed: %w", err)
+	}
+
+	fmt.Fprintf(opts.IO.ErrOut, "%s Pull request #%d is converted to a draft\n", utils.Green("✔"), pr.Number)
+
+	return nil
+}
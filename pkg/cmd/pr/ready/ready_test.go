@@ -184,6 +184,51 @@ func TestPRReady_alreadyReady(t *testing.T) {
 	}
 }
 
+func TestPRReady_undo(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.StubResponse(200, bytes.NewBufferString(`
+	{ "data": { "repository": {
+		"pullRequest": { "number": 447, "closed": false, "isDraft": false}
+	} } }
+	`))
+	http.StubResponse(200, bytes.NewBufferString(`{"data": {"convertPullRequestToDraft": {"pullRequest": {"id": "THE-ID"}}}}`))
+
+	output, err := runCommand(http, true, "447 --undo")
+	if err != nil {
+		t.Fatalf("error running command `pr ready --undo`: %v", err)
+	}
+
+	r := regexp.MustCompile(`Pull request #447 is converted to a draft`)
+
+	if !r.MatchString(output.Stderr()) {
+		t.Fatalf("output did not match regexp /%s/\n> output\n%q\n", r, output.Stderr())
+	}
+}
+
+func TestPRReady_undo_alreadyDraft(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.StubResponse(200, bytes.NewBufferString(`
+	{ "data": { "repository": {
+		"pullRequest": { "number": 448, "closed": false, "isDraft": true}
+	} } }
+	`))
+
+	output, err := runCommand(http, true, "448 --undo")
+	if err != nil {
+		t.Fatalf("error running command `pr ready --undo`: %v", err)
+	}
+
+	r := regexp.MustCompile(`Pull request #448 is already a draft`)
+
+	if !r.MatchString(output.Stderr()) {
+		t.Fatalf("output did not match regexp /%s/\n> output\n%q\n", r, output.Stderr())
+	}
+}
+
 func TestPRReady_closed(t *testing.T) {
 	http := &httpmock.Registry{}
 	defer http.Verify(t)
@@ -21,6 +21,7 @@ type ReopenOptions struct {
 	BaseRepo   func() (ghrepo.Interface, error)
 
 	SelectorArg string
+	Comment     string
 }
 
 func NewCmdReopen(f *cmdutil.Factory, runF func(*ReopenOptions) error) *cobra.Command {
@@ -49,6 +50,8 @@ func NewCmdReopen(f *cmdutil.Factory, runF func(*ReopenOptions) error) *cobra.Co
 		},
 	}
 
+	cmd.Flags().StringVarP(&opts.Comment, "comment", "c", "", "Leave a comment when reopening")
+
 	return cmd
 }
 
@@ -79,6 +82,13 @@ func reopenRun(opts *ReopenOptions) error {
 		return fmt.Errorf("API call failed: %w", err)
 	}
 
+	if opts.Comment != "" {
+		err := api.CommentCreate(apiClient, baseRepo, pr.ID, opts.Comment)
+		if err != nil {
+			return fmt.Errorf("API call failed: %w", err)
+		}
+	}
+
 	fmt.Fprintf(opts.IO.ErrOut, "%s Reopened pull request #%d (%s)\n", utils.Green("✔"), pr.Number, pr.Title)
 
 	return nil
@@ -3,6 +3,7 @@ package review
 import (
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 
 	"github.com/AlecAivazis/survey/v2"
@@ -29,9 +30,12 @@ type ReviewOptions struct {
 	Branch     func() (string, error)
 
 	SelectorArg     string
+	SelectorArgs    []string
 	InteractiveMode bool
 	ReviewType      api.PullRequestReviewState
 	Body            string
+	BodyFile        string
+	Preview         bool
 }
 
 func NewCmdReview(f *cmdutil.Factory, runF func(*ReviewOptions) error) *cobra.Command {
@@ -50,7 +54,7 @@ func NewCmdReview(f *cmdutil.Factory, runF func(*ReviewOptions) error) *cobra.Co
 	)
 
 	cmd := &cobra.Command{
-		Use:   "review [<number> | <url> | <branch>]",
+		Use:   "review [<number> | <url> | <branch>]...",
 		Short: "Add a review to a pull request",
 		Long: heredoc.Doc(`
 			Add a review to a pull request.
@@ -69,8 +73,11 @@ func NewCmdReview(f *cmdutil.Factory, runF func(*ReviewOptions) error) *cobra.Co
 			
 			# request changes on a specific pull request
 			$ gh pr review 123 -r -b "needs more ASCII art"
+
+			# approve with a review body read from a file
+			$ gh pr review 123 --approve --body-file review.md
 		`),
-		Args: cobra.MaximumNArgs(1),
+		Args: cobra.ArbitraryArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// support `-R, --repo` override
 			opts.BaseRepo = f.BaseRepo
@@ -81,7 +88,13 @@ func NewCmdReview(f *cmdutil.Factory, runF func(*ReviewOptions) error) *cobra.Co
 
 			if len(args) > 0 {
 				opts.SelectorArg = args[0]
+				opts.SelectorArgs = args
+			}
+
+			if opts.Body != "" && opts.BodyFile != "" {
+				return &cmdutil.FlagError{Err: errors.New("specify only one of --body or --body-file")}
 			}
+			bodyProvided := opts.Body != "" || opts.BodyFile != ""
 
 			found := 0
 			if flagApprove {
@@ -91,29 +104,36 @@ func NewCmdReview(f *cmdutil.Factory, runF func(*ReviewOptions) error) *cobra.Co
 			if flagRequestChanges {
 				found++
 				opts.ReviewType = api.ReviewRequestChanges
-				if opts.Body == "" {
+				if !bodyProvided {
 					return &cmdutil.FlagError{Err: errors.New("body cannot be blank for request-changes review")}
 				}
 			}
 			if flagComment {
 				found++
 				opts.ReviewType = api.ReviewComment
-				if opts.Body == "" {
+				if !bodyProvided {
 					return &cmdutil.FlagError{Err: errors.New("body cannot be blank for comment review")}
 				}
 			}
 
-			if found == 0 && opts.Body == "" {
+			if found == 0 && !bodyProvided {
 				if !opts.IO.CanPrompt() {
 					return &cmdutil.FlagError{Err: errors.New("--approve, --request-changes, or --comment required when not running interactively")}
 				}
+				if len(args) > 1 {
+					return &cmdutil.FlagError{Err: errors.New("--approve, --request-changes, or --comment required when reviewing multiple pull requests")}
+				}
 				opts.InteractiveMode = true
-			} else if found == 0 && opts.Body != "" {
+			} else if found == 0 && bodyProvided {
 				return &cmdutil.FlagError{Err: errors.New("--body unsupported without --approve, --request-changes, or --comment")}
 			} else if found > 1 {
 				return &cmdutil.FlagError{Err: errors.New("need exactly one of --approve, --request-changes, or --comment")}
 			}
 
+			if opts.Preview && !opts.IO.CanPrompt() {
+				return &cmdutil.FlagError{Err: errors.New("--preview is only supported when running interactively")}
+			}
+
 			if runF != nil {
 				return runF(opts)
 			}
@@ -125,11 +145,22 @@ func NewCmdReview(f *cmdutil.Factory, runF func(*ReviewOptions) error) *cobra.Co
 	cmd.Flags().BoolVarP(&flagRequestChanges, "request-changes", "r", false, "Request changes on a pull request")
 	cmd.Flags().BoolVarP(&flagComment, "comment", "c", false, "Comment on a pull request")
 	cmd.Flags().StringVarP(&opts.Body, "body", "b", "", "Specify the body of a review")
+	cmd.Flags().StringVarP(&opts.BodyFile, "body-file", "F", "", "Read body text from `file` (use \"-\" to read from standard input)")
+	cmd.Flags().BoolVar(&opts.Preview, "preview", false, "Preview the review body as rendered markdown and confirm before submitting")
 
 	return cmd
 }
 
 func reviewRun(opts *ReviewOptions) error {
+	if len(opts.SelectorArgs) > 1 {
+		return shared.BatchRun(opts.IO, opts.SelectorArgs, func(selector string) error {
+			o := *opts
+			o.SelectorArg = selector
+			o.SelectorArgs = []string{selector}
+			return reviewRun(&o)
+		})
+	}
+
 	httpClient, err := opts.HttpClient()
 	if err != nil {
 		return err
@@ -156,9 +187,40 @@ func reviewRun(opts *ReviewOptions) error {
 			return nil
 		}
 	} else {
+		body := opts.Body
+		if opts.BodyFile != "" {
+			var contents []byte
+			if opts.BodyFile == "-" {
+				contents, err = ioutil.ReadAll(opts.IO.In)
+			} else {
+				contents, err = ioutil.ReadFile(opts.BodyFile)
+			}
+			if err != nil {
+				return err
+			}
+			body = string(contents)
+		}
 		reviewData = &api.PullRequestReviewInput{
 			State: opts.ReviewType,
-			Body:  opts.Body,
+			Body:  body,
+		}
+
+		if opts.Preview {
+			if len(reviewData.Body) > 0 {
+				renderedBody, err := utils.RenderMarkdown(reviewData.Body)
+				if err != nil {
+					return err
+				}
+				fmt.Fprintf(opts.IO.Out, "Got:\n%s", renderedBody)
+			}
+			confirm := true
+			if err := prompt.Confirm("Submit?", &confirm); err != nil {
+				return err
+			}
+			if !confirm {
+				fmt.Fprint(opts.IO.ErrOut, "Discarding.\n")
+				return nil
+			}
 		}
 	}
 
@@ -3,11 +3,14 @@ package review
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
 	"net/http"
+	"os"
 	"regexp"
 	"testing"
 
+	"github.com/cli/cli/api"
 	"github.com/cli/cli/context"
 	"github.com/cli/cli/git"
 	"github.com/cli/cli/internal/config"
@@ -86,6 +89,28 @@ func Test_NewCmdReview(t *testing.T) {
 			isTTY:   true,
 			wantErr: "--body unsupported without --approve, --request-changes, or --comment",
 		},
+		{
+			name:  "body from file",
+			args:  `--comment --body-file review.md 123`,
+			isTTY: true,
+			want: ReviewOptions{
+				SelectorArg: "123",
+				ReviewType:  api.ReviewComment,
+				BodyFile:    "review.md",
+			},
+		},
+		{
+			name:    "body and body-file flags",
+			args:    `--comment -b hello --body-file review.md`,
+			isTTY:   true,
+			wantErr: "specify only one of --body or --body-file",
+		},
+		{
+			name:    "preview in non-interactive mode",
+			args:    `--approve --preview 123`,
+			isTTY:   false,
+			wantErr: "--preview is only supported when running interactively",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -123,6 +148,7 @@ func Test_NewCmdReview(t *testing.T) {
 
 			assert.Equal(t, tt.want.SelectorArg, opts.SelectorArg)
 			assert.Equal(t, tt.want.Body, opts.Body)
+			assert.Equal(t, tt.want.BodyFile, opts.BodyFile)
 		})
 	}
 }
@@ -395,6 +421,137 @@ func TestPRReview_nontty(t *testing.T) {
 	assert.Equal(t, "cool", reqBody.Variables.Input.Body)
 }
 
+func TestPRReview_bodyFile(t *testing.T) {
+	bodyFile, err := ioutil.TempFile("", "review-body")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(bodyFile.Name())
+	_, _ = bodyFile.WriteString("from file")
+	_ = bodyFile.Close()
+
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+	http.StubResponse(200, bytes.NewBufferString(`
+		{ "data": { "repository": { "pullRequests": { "nodes": [
+			{ "url": "https://github.com/OWNER/REPO/pull/123",
+			  "number": 123,
+			  "id": "foobar123",
+			  "headRefName": "feature",
+				"baseRefName": "master" }
+		] } } } }
+	`))
+	http.StubResponse(200, bytes.NewBufferString(`{"data": {} }`))
+
+	_, err = runCommand(http, nil, false, fmt.Sprintf("--comment --body-file %q", bodyFile.Name()))
+	if err != nil {
+		t.Fatalf("unexpected error running command: %s", err)
+	}
+
+	bodyBytes, _ := ioutil.ReadAll(http.Requests[1].Body)
+	reqBody := struct {
+		Variables struct {
+			Input struct {
+				Event string
+				Body  string
+			}
+		}
+	}{}
+	_ = json.Unmarshal(bodyBytes, &reqBody)
+
+	assert.Equal(t, "COMMENT", reqBody.Variables.Input.Event)
+	assert.Equal(t, "from file", reqBody.Variables.Input.Body)
+}
+
+func TestPRReview_bodyFileStdin(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.StubResponse(200, bytes.NewBufferString(`
+		{ "data": { "repository": { "pullRequests": { "nodes": [
+			{ "url": "https://github.com/OWNER/REPO/pull/123",
+			  "number": 123,
+			  "id": "foobar123",
+			  "headRefName": "feature",
+				"baseRefName": "master" }
+		] } } } }
+	`))
+	reg.StubResponse(200, bytes.NewBufferString(`{"data": {} }`))
+
+	io, stdin, _, _ := iostreams.Test()
+	stdin.WriteString("from stdin")
+
+	factory := &cmdutil.Factory{
+		IOStreams: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Config: func() (config.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+		Remotes: func() (context.Remotes, error) {
+			return context.Remotes{
+				{
+					Remote: &git.Remote{Name: "origin"},
+					Repo:   ghrepo.New("OWNER", "REPO"),
+				},
+			}, nil
+		},
+		Branch: func() (string, error) {
+			return "feature", nil
+		},
+	}
+
+	cmd := NewCmdReview(factory, nil)
+	cmd.SetArgs([]string{"--comment", "--body-file", "-"})
+	cmd.SetIn(&bytes.Buffer{})
+	cmd.SetOut(ioutil.Discard)
+	cmd.SetErr(ioutil.Discard)
+
+	if _, err := cmd.ExecuteC(); err != nil {
+		t.Fatalf("unexpected error running command: %s", err)
+	}
+
+	bodyBytes, _ := ioutil.ReadAll(reg.Requests[1].Body)
+	reqBody := struct {
+		Variables struct {
+			Input struct {
+				Event string
+				Body  string
+			}
+		}
+	}{}
+	_ = json.Unmarshal(bodyBytes, &reqBody)
+
+	assert.Equal(t, "COMMENT", reqBody.Variables.Input.Event)
+	assert.Equal(t, "from stdin", reqBody.Variables.Input.Body)
+}
+
+func TestPRReview_previewDeclined(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+	http.StubResponse(200, bytes.NewBufferString(`
+		{ "data": { "repository": { "pullRequests": { "nodes": [
+			{ "url": "https://github.com/OWNER/REPO/pull/123",
+			  "number": 123,
+			  "id": "foobar123",
+			  "headRefName": "feature",
+				"baseRefName": "master" }
+		] } } } }
+	`))
+
+	defer prompt.StubConfirm(false)()
+
+	output, err := runCommand(http, nil, true, `--comment -b "looks good" --preview`)
+	if err != nil {
+		t.Fatalf("unexpected error running command: %s", err)
+	}
+
+	assert.Equal(t, "Discarding.\n", output.Stderr())
+}
+
 func TestPRReview_interactive(t *testing.T) {
 	http := &httpmock.Registry{}
 	defer http.Verify(t)
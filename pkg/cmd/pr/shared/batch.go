@@ -0,0 +1,30 @@
+package shared
+
+import (
+	"fmt"
+
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/cli/cli/utils"
+)
+
+// BatchRun applies an operation to several pull request selectors in
+// sequence, continuing past individual failures so one bad item doesn't
+// abort the rest of the queue. Failures are reported per item followed by a
+// tally, and SilentError is returned when any item failed.
+func BatchRun(io *iostreams.IOStreams, selectors []string, fn func(selector string) error) error {
+	failed := 0
+	for _, selector := range selectors {
+		if err := fn(selector); err != nil {
+			failed++
+			if err != cmdutil.SilentError {
+				fmt.Fprintf(io.ErrOut, "%s %s: %v\n", utils.Red("!"), selector, err)
+			}
+		}
+	}
+	if failed > 0 {
+		fmt.Fprintf(io.ErrOut, "%s %s failed\n", utils.Red("!"), utils.Pluralize(failed, "pull request"))
+		return cmdutil.SilentError
+	}
+	return nil
+}
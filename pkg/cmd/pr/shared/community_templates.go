@@ -0,0 +1,118 @@
+package shared
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/internal/ghrepo"
+)
+
+// repoContent is the GitHub API v3 representation of a file or directory
+// entry in a repository
+type repoContent struct {
+	Name    string `json:"name"`
+	Path    string `json:"path"`
+	Type    string `json:"type"`
+	Content string `json:"content"`
+}
+
+// CommunityTemplatesDir downloads issue or pull request templates from the
+// owner's ".github" community health repository into a temporary directory,
+// matching web behavior for repositories that have no templates of their own.
+// The caller is responsible for removing the directory. An empty string is
+// returned when the owner has no such repository or it has no templates.
+func CommunityTemplatesDir(client *api.Client, repo ghrepo.Interface, name string) string {
+	communityRepo := ghrepo.NewWithHost(repo.RepoOwner(), ".github", repo.RepoHost())
+
+	// most owners have no ".github" repository; check before probing the
+	// individual template locations
+	var repoProbe struct {
+		Name string
+	}
+	if err := client.REST(communityRepo.RepoHost(), "GET", fmt.Sprintf("repos/%s", ghrepo.FullName(communityRepo)), nil, &repoProbe); err != nil {
+		return ""
+	}
+
+	tempDir, err := ioutil.TempDir("", "gh-templates")
+	if err != nil {
+		return ""
+	}
+
+	found := false
+	for _, dir := range []string{name, ".github/" + name, "docs/" + name} {
+		var entries []repoContent
+		if err := fetchContents(client, communityRepo, dir, &entries); err != nil {
+			continue
+		}
+		for _, entry := range entries {
+			if entry.Type != "file" {
+				continue
+			}
+			if err := downloadContent(client, communityRepo, entry.Path, filepath.Join(tempDir, name, entry.Name)); err == nil {
+				found = true
+			}
+		}
+		if found {
+			break
+		}
+	}
+
+	if !found {
+		// a single legacy template file, e.g. PULL_REQUEST_TEMPLATE.md
+		for _, dir := range []string{"", ".github", "docs"} {
+			for _, ext := range []string{".md", ".txt", ""} {
+				remotePath := path.Join(dir, name+ext)
+				var file repoContent
+				if err := fetchContents(client, communityRepo, remotePath, &file); err != nil || file.Type != "file" {
+					continue
+				}
+				if err := writeContent(&file, filepath.Join(tempDir, file.Name)); err == nil {
+					found = true
+					break
+				}
+			}
+			if found {
+				break
+			}
+		}
+	}
+
+	if !found {
+		_ = os.RemoveAll(tempDir)
+		return ""
+	}
+	return tempDir
+}
+
+func fetchContents(client *api.Client, repo ghrepo.Interface, remotePath string, data interface{}) error {
+	apiPath := fmt.Sprintf("repos/%s/contents/%s", ghrepo.FullName(repo), remotePath)
+	return client.REST(repo.RepoHost(), "GET", apiPath, nil, data)
+}
+
+// downloadContent fetches a single file through the contents API and writes
+// its decoded contents to destPath
+func downloadContent(client *api.Client, repo ghrepo.Interface, remotePath, destPath string) error {
+	var file repoContent
+	if err := fetchContents(client, repo, remotePath, &file); err != nil {
+		return err
+	}
+	return writeContent(&file, destPath)
+}
+
+func writeContent(file *repoContent, destPath string) error {
+	// the API returns base64 with embedded newlines
+	decoded, err := base64.StdEncoding.DecodeString(strings.ReplaceAll(file.Content, "\n", ""))
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(destPath), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(destPath, decoded, 0644)
+}
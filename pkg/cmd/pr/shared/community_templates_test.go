@@ -0,0 +1,90 @@
+package shared
+
+import (
+	"encoding/base64"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCommunityTemplatesDir(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/.github"),
+		httpmock.StringResponse(`{"name": ".github"}`))
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/.github/contents/ISSUE_TEMPLATE"),
+		httpmock.StringResponse(`[
+			{"name": "bug_report.md", "path": "ISSUE_TEMPLATE/bug_report.md", "type": "file"},
+			{"name": "assets", "path": "ISSUE_TEMPLATE/assets", "type": "dir"}
+		]`))
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/.github/contents/ISSUE_TEMPLATE/bug_report.md"),
+		httpmock.StringResponse(`{
+			"name": "bug_report.md",
+			"path": "ISSUE_TEMPLATE/bug_report.md",
+			"type": "file",
+			"content": "`+base64.StdEncoding.EncodeToString([]byte("---\nname: Bug report\n---\nSteps to reproduce"))+`"
+		}`))
+
+	client := api.NewClientFromHTTP(&http.Client{Transport: reg})
+	repo := ghrepo.New("OWNER", "REPO")
+
+	dir := CommunityTemplatesDir(client, repo, "ISSUE_TEMPLATE")
+	if dir == "" {
+		t.Fatal("expected a template directory")
+	}
+	defer os.RemoveAll(dir)
+
+	contents, err := ioutil.ReadFile(filepath.Join(dir, "ISSUE_TEMPLATE", "bug_report.md"))
+	assert.NoError(t, err)
+	assert.Equal(t, "---\nname: Bug report\n---\nSteps to reproduce", string(contents))
+}
+
+func TestCommunityTemplatesDir_legacyFile(t *testing.T) {
+	reg := &httpmock.Registry{}
+
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/.github"),
+		httpmock.StringResponse(`{"name": ".github"}`))
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/.github/contents/PULL_REQUEST_TEMPLATE.md"),
+		httpmock.StringResponse(`{
+			"name": "PULL_REQUEST_TEMPLATE.md",
+			"path": "PULL_REQUEST_TEMPLATE.md",
+			"type": "file",
+			"content": "`+base64.StdEncoding.EncodeToString([]byte("## Checklist"))+`"
+		}`))
+
+	client := api.NewClientFromHTTP(&http.Client{Transport: reg})
+	repo := ghrepo.New("OWNER", "REPO")
+
+	dir := CommunityTemplatesDir(client, repo, "PULL_REQUEST_TEMPLATE")
+	if dir == "" {
+		t.Fatal("expected a template directory")
+	}
+	defer os.RemoveAll(dir)
+
+	contents, err := ioutil.ReadFile(filepath.Join(dir, "PULL_REQUEST_TEMPLATE.md"))
+	assert.NoError(t, err)
+	assert.Equal(t, "## Checklist", string(contents))
+}
+
+func TestCommunityTemplatesDir_none(t *testing.T) {
+	reg := &httpmock.Registry{}
+
+	client := api.NewClientFromHTTP(&http.Client{Transport: reg})
+	repo := ghrepo.New("OWNER", "REPO")
+
+	dir := CommunityTemplatesDir(client, repo, "ISSUE_TEMPLATE")
+	assert.Equal(t, "", dir)
+}
@@ -0,0 +1,151 @@
+package shared
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/pkg/cmdutil"
+)
+
+// PRListFields are the fields that `pr list --json` can output
+var PRListFields = []cmdutil.JSONField{
+	{Name: "number", Type: "number", Example: `123`},
+	{Name: "title", Type: "string", Example: `"Fix crash on startup"`},
+	{Name: "state", Type: "string", Example: `"OPEN"`},
+	{Name: "url", Type: "string", Example: `"https://github.com/OWNER/REPO/pull/123"`},
+	{Name: "baseRefName", Type: "string", Example: `"master"`},
+	{Name: "headRefName", Type: "string", Example: `"patch-1"`},
+	{Name: "headRepositoryOwner", Type: "object", Example: `{"login": "octocat"}`},
+	{Name: "isCrossRepository", Type: "boolean", Example: `false`},
+	{Name: "isDraft", Type: "boolean", Example: `false`},
+	{Name: "author", Type: "object", Example: `{"login": "octocat"}`},
+	{Name: "labels", Type: "array", Example: `[{"name": "bug"}]`},
+	{Name: "reviewDecision", Type: "string", Example: `"APPROVED"`},
+	{Name: "statusCheckRollup", Type: "array", Example: `[{"context": "ci", "state": "SUCCESS"}]`},
+}
+
+// PRViewFields are the fields that `pr view --json` can output
+var PRViewFields = append([]cmdutil.JSONField{
+	{Name: "body", Type: "string", Example: `"Closes #122 by checking for nil"`},
+	{Name: "closed", Type: "boolean", Example: `false`},
+	{Name: "mergeable", Type: "string", Example: `"MERGEABLE"`},
+	{Name: "maintainerCanModify", Type: "boolean", Example: `true`},
+	{Name: "assignees", Type: "array", Example: `[{"login": "octocat"}]`},
+	{Name: "projectCards", Type: "array", Example: `[{"project": "Roadmap", "column": "To do"}]`},
+	{Name: "milestone", Type: "object", Example: `{"title": "v1.0"}`},
+	{Name: "reviewRequests", Type: "array", Example: `[{"type": "User", "login": "hubot"}]`},
+}, PRListFields...)
+
+// ExportPRs writes prs to w as a JSON array limited to the selected fields
+func ExportPRs(w io.Writer, fields []string, prs []api.PullRequest) error {
+	data := make([]map[string]interface{}, 0, len(prs))
+	for i := range prs {
+		data = append(data, exportPR(&prs[i], fields))
+	}
+	return json.NewEncoder(w).Encode(data)
+}
+
+// ExportPR writes pr to w as a JSON object limited to the selected fields
+func ExportPR(w io.Writer, fields []string, pr *api.PullRequest) error {
+	return json.NewEncoder(w).Encode(exportPR(pr, fields))
+}
+
+func exportPR(pr *api.PullRequest, fields []string) map[string]interface{} {
+	data := make(map[string]interface{}, len(fields))
+	for _, f := range fields {
+		switch f {
+		case "number":
+			data[f] = pr.Number
+		case "title":
+			data[f] = pr.Title
+		case "state":
+			data[f] = pr.State
+		case "closed":
+			data[f] = pr.Closed
+		case "url":
+			data[f] = pr.URL
+		case "body":
+			data[f] = pr.Body
+		case "baseRefName":
+			data[f] = pr.BaseRefName
+		case "headRefName":
+			data[f] = pr.HeadRefName
+		case "headRepositoryOwner":
+			data[f] = map[string]string{"login": pr.HeadRepositoryOwner.Login}
+		case "isCrossRepository":
+			data[f] = pr.IsCrossRepository
+		case "isDraft":
+			data[f] = pr.IsDraft
+		case "maintainerCanModify":
+			data[f] = pr.MaintainerCanModify
+		case "mergeable":
+			data[f] = pr.Mergeable
+		case "reviewDecision":
+			data[f] = pr.ReviewDecision
+		case "author":
+			data[f] = map[string]string{"login": pr.Author.Login}
+		case "assignees":
+			assignees := make([]map[string]string, 0, len(pr.Assignees.Nodes))
+			for _, a := range pr.Assignees.Nodes {
+				assignees = append(assignees, map[string]string{"login": a.Login})
+			}
+			data[f] = assignees
+		case "labels":
+			labels := make([]map[string]string, 0, len(pr.Labels.Nodes))
+			for _, l := range pr.Labels.Nodes {
+				labels = append(labels, map[string]string{"name": l.Name})
+			}
+			data[f] = labels
+		case "milestone":
+			if pr.Milestone.Title == "" {
+				data[f] = nil
+			} else {
+				data[f] = map[string]string{"title": pr.Milestone.Title}
+			}
+		case "projectCards":
+			cards := make([]map[string]string, 0, len(pr.ProjectCards.Nodes))
+			for _, c := range pr.ProjectCards.Nodes {
+				cards = append(cards, map[string]string{
+					"project": c.Project.Name,
+					"column":  c.Column.Name,
+				})
+			}
+			data[f] = cards
+		case "reviewRequests":
+			requests := make([]map[string]string, 0, len(pr.ReviewRequests.Nodes))
+			for _, rr := range pr.ReviewRequests.Nodes {
+				reviewer := rr.RequestedReviewer
+				if reviewer.TypeName == "Team" {
+					requests = append(requests, map[string]string{
+						"type": "Team",
+						"name": reviewer.Name,
+						"slug": reviewer.Slug,
+					})
+				} else {
+					requests = append(requests, map[string]string{
+						"type":  "User",
+						"login": reviewer.Login,
+					})
+				}
+			}
+			data[f] = requests
+		case "statusCheckRollup":
+			checks := make([]map[string]string, 0)
+			if len(pr.Commits.Nodes) > 0 {
+				commit := pr.Commits.Nodes[len(pr.Commits.Nodes)-1].Commit
+				for _, c := range commit.StatusCheckRollup.Contexts.Nodes {
+					checks = append(checks, map[string]string{
+						"name":       c.Name,
+						"context":    c.Context,
+						"state":      c.State,
+						"status":     c.Status,
+						"conclusion": c.Conclusion,
+					})
+				}
+			}
+			data[f] = checks
+		}
+	}
+	return data
+}
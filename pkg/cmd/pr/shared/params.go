@@ -42,6 +42,17 @@ func AddMetadataToIssueParams(client *api.Client, baseRepo ghrepo.Interface, par
 		return nil
 	}
 
+	for i, assignee := range tb.Assignees {
+		if assignee != "@me" {
+			continue
+		}
+		login, err := api.CurrentLoginName(client, baseRepo.RepoHost())
+		if err != nil {
+			return fmt.Errorf("could not determine the current user: %w", err)
+		}
+		tb.Assignees[i] = login
+	}
+
 	if tb.MetadataResult == nil {
 		resolveInput := api.RepoResolveInput{
 			Reviewers:  tb.Reviewers,
@@ -122,6 +133,7 @@ type FilterOptions struct {
 	BaseBranch string
 	Mention    string
 	Milestone  string
+	Search     string
 }
 
 func ListURLWithQuery(listURL string, options FilterOptions) (string, error) {
@@ -151,6 +163,9 @@ func ListURLWithQuery(listURL string, options FilterOptions) (string, error) {
 	if options.Milestone != "" {
 		query += fmt.Sprintf("milestone:%s ", quoteValueForQuery(options.Milestone))
 	}
+	if options.Search != "" {
+		query += options.Search + " "
+	}
 	q := u.Query()
 	q.Set("q", strings.TrimSuffix(query, " "))
 	u.RawQuery = q.Encode()
@@ -0,0 +1,123 @@
+package shared
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/cli/cli/utils"
+)
+
+// https://docs.github.com/articles/closing-issues-using-keywords
+var closingKeywordRE = regexp.MustCompile(`(?i)\b(?:close[sd]?|fix(?:e[sd])?|resolve[sd]?)\b:?\s+((?:[\w.-]+/[\w.-]+)?#\d+)`)
+
+// IssueReference is an issue mentioned by a closing keyword in the body of a
+// pull request.
+type IssueReference struct {
+	// the "OWNER/REPO" part of a cross-repository reference, or blank
+	RepoName string
+	Number   int
+}
+
+// ParseClosingIssueReferences scans text for closing keywords such as "fixes #123"
+// or "closes owner/repo#45" and returns the referenced issues in order of
+// appearance, without duplicates.
+func ParseClosingIssueReferences(body string) []IssueReference {
+	var refs []IssueReference
+	seen := map[string]bool{}
+	for _, m := range closingKeywordRE.FindAllStringSubmatch(body, -1) {
+		ref := m[1]
+		if seen[ref] {
+			continue
+		}
+		seen[ref] = true
+
+		repoName := ""
+		numPart := ref
+		if idx := strings.IndexRune(ref, '#'); idx > 0 {
+			repoName = ref[:idx]
+			numPart = ref[idx:]
+		}
+		number, err := strconv.Atoi(strings.TrimPrefix(numPart, "#"))
+		if err != nil {
+			continue
+		}
+		refs = append(refs, IssueReference{RepoName: repoName, Number: number})
+	}
+	return refs
+}
+
+type autolink struct {
+	KeyPrefix   string `json:"key_prefix"`
+	URLTemplate string `json:"url_template"`
+}
+
+// SurfaceIssueReferences reports which issues a pull request body will close and
+// which configured autolink references it contains. Issues that cannot be found
+// produce a warning but do not abort submission.
+func SurfaceIssueReferences(client *api.Client, baseRepo ghrepo.Interface, io *iostreams.IOStreams, body string) {
+	out := io.ErrOut
+
+	for _, ref := range ParseClosingIssueReferences(body) {
+		repo := baseRepo
+		if ref.RepoName != "" {
+			var err error
+			repo, err = ghrepo.FromFullName(ref.RepoName)
+			if err != nil {
+				continue
+			}
+		}
+
+		issue, err := api.IssueByNumber(client, repo, ref.Number)
+		if err != nil {
+			fmt.Fprintf(out, "%s could not find %s to close: %s\n", utils.Yellow("!"), displayReference(baseRepo, repo, ref.Number), err)
+			continue
+		}
+		fmt.Fprintf(out, "%s This pull request will close %s (%s)\n", utils.Green("✓"), displayReference(baseRepo, repo, ref.Number), issue.Title)
+	}
+
+	// only hit the autolinks endpoint when the body contains something that
+	// could plausibly be an autolink reference
+	if autolinkCandidateRE.MatchString(body) {
+		for _, match := range matchAutolinks(client, baseRepo, body) {
+			fmt.Fprintf(out, "%s Autolink reference %s resolves to %s\n", utils.Green("✓"), match[0], match[1])
+		}
+	}
+}
+
+var autolinkCandidateRE = regexp.MustCompile(`\b[A-Z][A-Z0-9_]*-\d+`)
+
+// matchAutolinks finds occurrences of the repository's configured autolink key
+// prefixes in body and returns [reference, expanded URL] pairs. Errors fetching
+// autolinks are ignored since the endpoint requires admin access.
+func matchAutolinks(client *api.Client, repo ghrepo.Interface, body string) [][2]string {
+	var autolinks []autolink
+	path := fmt.Sprintf("repos/%s/autolinks", ghrepo.FullName(repo))
+	if err := client.REST(repo.RepoHost(), "GET", path, nil, &autolinks); err != nil {
+		return nil
+	}
+
+	var matches [][2]string
+	for _, al := range autolinks {
+		re, err := regexp.Compile(regexp.QuoteMeta(al.KeyPrefix) + `(\d+)`)
+		if err != nil {
+			continue
+		}
+		for _, m := range re.FindAllStringSubmatch(body, -1) {
+			url := strings.ReplaceAll(al.URLTemplate, "<num>", m[1])
+			matches = append(matches, [2]string{m[0], url})
+		}
+	}
+	return matches
+}
+
+func displayReference(baseRepo, repo ghrepo.Interface, number int) string {
+	if ghrepo.IsSame(baseRepo, repo) {
+		return fmt.Sprintf("#%d", number)
+	}
+	return fmt.Sprintf("%s#%d", ghrepo.FullName(repo), number)
+}
@@ -0,0 +1,53 @@
+package shared
+
+import (
+	"reflect"
+	"testing"
+)
+
+func Test_ParseClosingIssueReferences(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want []IssueReference
+	}{
+		{
+			name: "no references",
+			body: "makes the foo more resilient",
+			want: nil,
+		},
+		{
+			name: "single reference",
+			body: "does stuff\n\nFixes #123",
+			want: []IssueReference{{Number: 123}},
+		},
+		{
+			name: "multiple keywords",
+			body: "closes #1, fixed #2 and resolves: #3",
+			want: []IssueReference{{Number: 1}, {Number: 2}, {Number: 3}},
+		},
+		{
+			name: "cross repository reference",
+			body: "Closes octocat/spoon-knife#42",
+			want: []IssueReference{{RepoName: "octocat/spoon-knife", Number: 42}},
+		},
+		{
+			name: "duplicates collapsed",
+			body: "fixes #5\n\nFixes #5",
+			want: []IssueReference{{Number: 5}},
+		},
+		{
+			name: "keyword without number",
+			body: "this fixes the bug",
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ParseClosingIssueReferences(tt.body)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseClosingIssueReferences() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
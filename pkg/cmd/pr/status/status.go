@@ -117,6 +117,38 @@ func statusRun(opts *StatusOptions) error {
 	}
 	fmt.Fprintln(out)
 
+	printSearchSections(out, prPayload)
+
+	// aggregate any other repositories the user has configured into the dashboard
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+	extraRepos, _ := cfg.Get("", "pr_status_repos")
+	for _, repoName := range strings.Fields(extraRepos) {
+		extraRepo, err := ghrepo.FromFullName(repoName)
+		if err != nil {
+			fmt.Fprintf(opts.IO.ErrOut, "invalid repository %q in pr_status_repos: %v\n", repoName, err)
+			continue
+		}
+		if ghrepo.IsSame(extraRepo, baseRepo) {
+			continue
+		}
+
+		extraPayload, err := api.PullRequests(apiClient, extraRepo, 0, "", currentUser)
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(out, "Relevant pull requests in %s\n", ghrepo.FullName(extraRepo))
+		fmt.Fprintln(out, "")
+		printSearchSections(out, extraPayload)
+	}
+
+	return nil
+}
+
+func printSearchSections(out io.Writer, prPayload *api.PullRequestsPayload) {
 	shared.PrintHeader(out, "Created by you")
 	if prPayload.ViewerCreated.TotalCount > 0 {
 		printPrs(out, prPayload.ViewerCreated.TotalCount, prPayload.ViewerCreated.PullRequests...)
@@ -132,8 +164,6 @@ func statusRun(opts *StatusOptions) error {
 		shared.PrintMessage(out, "  You have no pull requests to review")
 	}
 	fmt.Fprintln(out)
-
-	return nil
 }
 
 func prSelectorForCurrentBranch(baseRepo ghrepo.Interface, prHeadRef string, rem context.Remotes) (prNumber int, selector string, err error) {
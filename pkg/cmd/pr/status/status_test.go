@@ -20,6 +20,10 @@ import (
 )
 
 func runCommand(rt http.RoundTripper, branch string, isTTY bool, cli string) (*test.CmdOut, error) {
+	return runCommandWithConfig(rt, branch, isTTY, cli, config.NewBlankConfig())
+}
+
+func runCommandWithConfig(rt http.RoundTripper, branch string, isTTY bool, cli string, cfg config.Config) (*test.CmdOut, error) {
 	io, _, stdout, stderr := iostreams.Test()
 	io.SetStdoutTTY(isTTY)
 	io.SetStdinTTY(isTTY)
@@ -31,7 +35,7 @@ func runCommand(rt http.RoundTripper, branch string, isTTY bool, cli string) (*t
 			return &http.Client{Transport: rt}, nil
 		},
 		Config: func() (config.Config, error) {
-			return config.NewBlankConfig(), nil
+			return cfg, nil
 		},
 		BaseRepo: func() (ghrepo.Interface, error) {
 			return ghrepo.New("OWNER", "REPO"), nil
@@ -100,6 +104,26 @@ func TestPRStatus(t *testing.T) {
 	}
 }
 
+func TestPRStatus_configuredRepoSet(t *testing.T) {
+	http := initFakeHTTP()
+	defer http.Verify(t)
+	http.Register(httpmock.GraphQL(`query PullRequestStatus\b`), httpmock.FileResponse("./fixtures/prStatus.json"))
+	http.Register(httpmock.GraphQL(`query PullRequestStatus\b`), httpmock.FileResponse("./fixtures/prStatus.json"))
+
+	cfg := config.NewFromString("pr_status_repos: hubot/other\n")
+	output, err := runCommandWithConfig(http, "blueberries", true, "", cfg)
+	if err != nil {
+		t.Errorf("error running command `pr status`: %v", err)
+	}
+
+	if !strings.Contains(output.String(), "Relevant pull requests in OWNER/REPO") {
+		t.Errorf("output did not mention the base repository:\n%s", output.String())
+	}
+	if !strings.Contains(output.String(), "Relevant pull requests in hubot/other") {
+		t.Errorf("output did not mention the configured repository:\n%s", output.String())
+	}
+}
+
 func TestPRStatus_reviewsAndChecks(t *testing.T) {
 	http := initFakeHTTP()
 	defer http.Verify(t)
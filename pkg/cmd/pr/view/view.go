@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/api"
@@ -30,6 +31,9 @@ type ViewOptions struct {
 
 	SelectorArg string
 	BrowserMode bool
+	Comments    bool
+
+	Exporter *cmdutil.Exporter
 }
 
 func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Command {
@@ -50,7 +54,9 @@ func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Comman
 			Without an argument, the pull request that belongs to the current branch
 			is displayed.
 
-			With '--web', open the pull request in a web browser instead.
+			With '--web', open the pull request in a web browser instead. The browser
+			to launch can be configured with 'gh config set browser <command>' or the
+			BROWSER environment variable.
     	`),
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -65,6 +71,10 @@ func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Comman
 				opts.SelectorArg = args[0]
 			}
 
+			if opts.BrowserMode && opts.Exporter != nil {
+				return &cmdutil.FlagError{Err: errors.New("--json is not supported with --web")}
+			}
+
 			if runF != nil {
 				return runF(opts)
 			}
@@ -73,6 +83,8 @@ func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Comman
 	}
 
 	cmd.Flags().BoolVarP(&opts.BrowserMode, "web", "w", false, "Open a pull request in the browser")
+	cmd.Flags().BoolVarP(&opts.Comments, "comments", "c", false, "View pull request comments")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, shared.PRViewFields)
 
 	return cmd
 }
@@ -84,11 +96,15 @@ func viewRun(opts *ViewOptions) error {
 	}
 	apiClient := api.NewClientFromHTTP(httpClient)
 
-	pr, _, err := shared.PRFromArgs(apiClient, opts.BaseRepo, opts.Branch, opts.Remotes, opts.SelectorArg)
+	pr, baseRepo, err := shared.PRFromArgs(apiClient, opts.BaseRepo, opts.Branch, opts.Remotes, opts.SelectorArg)
 	if err != nil {
 		return err
 	}
 
+	if opts.Exporter != nil {
+		return shared.ExportPR(opts.IO.Out, opts.Exporter.Fields(), pr)
+	}
+
 	openURL := pr.URL
 	connectedToTerminal := opts.IO.IsStdoutTTY() && opts.IO.IsStderrTTY()
 
@@ -99,10 +115,63 @@ func viewRun(opts *ViewOptions) error {
 		return utils.OpenInBrowser(openURL)
 	}
 
+	var comments *api.CommentsAndTotalCount
+	if opts.Comments {
+		comments, err = api.PullRequestComments(apiClient, baseRepo, pr.Number)
+		if err != nil {
+			return err
+		}
+	}
+
 	if connectedToTerminal {
-		return printHumanPrPreview(opts.IO.Out, pr)
+		if err := printHumanPrPreview(opts.IO.Out, pr); err != nil {
+			return err
+		}
+		if comments != nil {
+			return printHumanComments(opts.IO.Out, comments)
+		}
+		return nil
+	}
+
+	if err := printRawPrPreview(opts.IO.Out, pr); err != nil {
+		return err
+	}
+	if comments != nil {
+		return printRawComments(opts.IO.Out, comments)
+	}
+	return nil
+}
+
+func printRawComments(out io.Writer, comments *api.CommentsAndTotalCount) error {
+	for _, comment := range comments.Comments {
+		fmt.Fprintln(out, "--")
+		fmt.Fprintf(out, "author:\t%s\n", comment.Author.Login)
+		fmt.Fprintf(out, "association:\t%s\n", strings.ToLower(comment.AuthorAssociation))
+		fmt.Fprintf(out, "time:\t%s\n", comment.CreatedAt.Format(time.RFC3339))
+		if comment.ReviewState != "" {
+			fmt.Fprintf(out, "review:\t%s\n", strings.ToLower(comment.ReviewState))
+		}
+		fmt.Fprintln(out, comment.Body)
 	}
-	return printRawPrPreview(opts.IO.Out, pr)
+	return nil
+}
+
+func printHumanComments(out io.Writer, comments *api.CommentsAndTotalCount) error {
+	fmt.Fprintln(out, utils.Bold(fmt.Sprintf("Showing %s", utils.Pluralize(comments.TotalCount, "comment"))))
+	for _, comment := range comments.Comments {
+		fmt.Fprintln(out)
+		header := fmt.Sprintf("%s • %s", comment.Author.Login, utils.FuzzyAgo(time.Since(comment.CreatedAt)))
+		if comment.ReviewState != "" {
+			header += fmt.Sprintf(" • %s", strings.ReplaceAll(strings.ToLower(comment.ReviewState), "_", " "))
+		}
+		fmt.Fprintln(out, utils.Gray(header))
+		md, err := utils.RenderMarkdown(comment.Body)
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(out, md)
+	}
+	return nil
 }
 
 func printRawPrPreview(out io.Writer, pr *api.PullRequest) error {
@@ -139,6 +208,11 @@ func printHumanPrPreview(out io.Writer, pr *api.PullRequest) error {
 		pr.BaseRefName,
 		pr.HeadRefName,
 	)))
+	if pr.State == "OPEN" {
+		if summary := prCISummary(pr); summary != "" {
+			fmt.Fprintln(out, summary)
+		}
+	}
 	fmt.Fprintln(out)
 
 	// Metadata
@@ -179,6 +253,47 @@ func printHumanPrPreview(out io.Writer, pr *api.PullRequest) error {
 	return nil
 }
 
+// prCISummary aggregates the check rollup, review decision, and mergeability
+// of a pull request into one line, so whether it is landable can be judged
+// without opening the browser
+func prCISummary(pr *api.PullRequest) string {
+	var parts []string
+
+	if checks := pr.ChecksStatus(); checks.Total > 0 {
+		summary := fmt.Sprintf("%d failing, %d pending, %d passing checks", checks.Failing, checks.Pending, checks.Passing)
+		switch {
+		case checks.Failing > 0:
+			summary = utils.Red("× " + summary)
+		case checks.Pending > 0:
+			summary = utils.Yellow("- " + summary)
+		default:
+			summary = utils.Green("✓ " + summary)
+		}
+		parts = append(parts, summary)
+	}
+
+	reviews := pr.ReviewStatus()
+	switch {
+	case reviews.ChangesRequested:
+		parts = append(parts, utils.Red("+ Changes requested"))
+	case reviews.ReviewRequired:
+		parts = append(parts, utils.Yellow("- Review required"))
+	case reviews.Approved:
+		parts = append(parts, utils.Green("✓ Approved"))
+	}
+
+	switch pr.Mergeable {
+	case "MERGEABLE":
+		parts = append(parts, utils.Green("✓ No merge conflicts"))
+	case "CONFLICTING":
+		parts = append(parts, utils.Red("× Merge conflicts"))
+	case "UNKNOWN":
+		parts = append(parts, utils.Yellow("- Merge conflicts unknown"))
+	}
+
+	return strings.Join(parts, " • ")
+}
+
 // Ref. https://developer.github.com/v4/enum/pullrequestreviewstate/
 const (
 	requestedReviewState        = "REQUESTED" // This is our own state for review request
@@ -2,6 +2,7 @@ package view
 
 import (
 	"bytes"
+	"encoding/json"
 	"io/ioutil"
 	"net/http"
 	"os/exec"
@@ -339,6 +340,87 @@ func TestPRView_Preview_nontty(t *testing.T) {
 	}
 }
 
+func TestPRView_json(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+	http.Register(httpmock.GraphQL(`query PullRequestByNumber\b`), httpmock.FileResponse("./fixtures/prViewPreview.json"))
+
+	output, err := runCommand(http, "master", false, "12 --json number,title,body,author")
+	if err != nil {
+		t.Fatalf("error running command `pr view --json`: %v", err)
+	}
+
+	var pr map[string]interface{}
+	if err := json.Unmarshal(output.OutBuf.Bytes(), &pr); err != nil {
+		t.Fatalf("invalid JSON output: %v", err)
+	}
+	assert.Equal(t, float64(12), pr["number"])
+	assert.Equal(t, "Blueberries are from a fork", pr["title"])
+	assert.Equal(t, "**blueberries taste good**", pr["body"])
+	assert.Equal(t, map[string]interface{}{"login": "nobody"}, pr["author"])
+	if _, ok := pr["state"]; ok {
+		t.Error("expected state to be omitted")
+	}
+}
+
+func TestPRView_jsonWithWeb(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	_, err := runCommand(http, "master", false, "12 --json number --web")
+	if err == nil || err.Error() != "--json is not supported with --web" {
+		t.Errorf("got error %v", err)
+	}
+}
+
+func TestPRView_Comments(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+	http.Register(httpmock.GraphQL(`query PullRequestByNumber\b`), httpmock.FileResponse("./fixtures/prViewPreview.json"))
+	http.Register(httpmock.GraphQL(`query PullRequestComments\b`), httpmock.FileResponse("./fixtures/prViewComments.json"))
+
+	output, err := runCommand(http, "master", true, "12 --comments")
+	if err != nil {
+		t.Fatalf("error running command `pr view --comments`: %v", err)
+	}
+
+	expectedOutputs := []string{
+		`Blueberries are from a fork`,
+		`Showing 3 comments`,
+		`monalisa •`,
+		`have you tried turning it off and on again`,
+		`hubot •.*approved`,
+		`this looks great`,
+		`octocat •`,
+		`works on my machine`,
+	}
+	test.ExpectLines(t, output.String(), expectedOutputs...)
+}
+
+func TestPRView_Comments_nontty(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+	http.Register(httpmock.GraphQL(`query PullRequestByNumber\b`), httpmock.FileResponse("./fixtures/prViewPreview.json"))
+	http.Register(httpmock.GraphQL(`query PullRequestComments\b`), httpmock.FileResponse("./fixtures/prViewComments.json"))
+
+	output, err := runCommand(http, "master", false, "12 --comments")
+	if err != nil {
+		t.Fatalf("error running command `pr view --comments`: %v", err)
+	}
+
+	expectedOutputs := []string{
+		`title:\tBlueberries are from a fork`,
+		`author:\tmonalisa`,
+		`association:\tmember`,
+		`time:\t2020-01-01T12:00:00Z`,
+		`have you tried turning it off and on again`,
+		`review:\tapproved`,
+		`this looks great`,
+		`works on my machine`,
+	}
+	test.ExpectLines(t, output.String(), expectedOutputs...)
+}
+
 func TestPRView_Preview(t *testing.T) {
 	tests := map[string]struct {
 		branch          string
@@ -357,6 +439,18 @@ func TestPRView_Preview(t *testing.T) {
 				`View this pull request on GitHub: https://github.com/OWNER/REPO/pull/12`,
 			},
 		},
+		"Open PR with checks and reviews": {
+			branch:  "master",
+			args:    "12",
+			fixture: "./fixtures/prViewPreviewWithCISummary.json",
+			expectedOutputs: []string{
+				`Blueberries are from a fork`,
+				`Open.*nobody wants to merge 12 commits into master from blueberries`,
+				`1 failing, 1 pending, 1 passing checks.*Changes requested.*Merge conflicts`,
+				`blueberries taste good`,
+				`View this pull request on GitHub: https://github.com/OWNER/REPO/pull/12`,
+			},
+		},
 		"Open PR with metadata by number": {
 			branch:  "master",
 			args:    "12",
@@ -14,6 +14,7 @@ import (
 	"github.com/cli/cli/internal/config"
 	"github.com/cli/cli/internal/ghrepo"
 	"github.com/cli/cli/internal/run"
+	"github.com/cli/cli/pkg/clipboard"
 	"github.com/cli/cli/pkg/cmd/release/shared"
 	"github.com/cli/cli/pkg/cmdutil"
 	"github.com/cli/cli/pkg/iostreams"
@@ -29,13 +30,20 @@ type CreateOptions struct {
 	HttpClient func() (*http.Client, error)
 	BaseRepo   func() (ghrepo.Interface, error)
 
-	TagName      string
-	Target       string
-	Name         string
-	Body         string
-	BodyProvided bool
-	Draft        bool
-	Prerelease   bool
+	TagName       string
+	Target        string
+	Name          string
+	Body          string
+	BodyProvided  bool
+	Draft         bool
+	Prerelease    bool
+	Copy          bool
+	Checksums     bool
+	GenerateNotes bool
+	NotesStartTag string
+
+	// name of the discussion category to create alongside the release
+	DiscussionCategory string
 
 	Assets []*shared.AssetForUpload
 
@@ -75,6 +83,9 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 
 			# upload a release asset with a display label
 			$ gh release create v1.2.3 '/path/to/asset.zip#My display label'
+
+			# use automatically generated release notes
+			$ gh release create v1.2.3 --generate-notes
 		`),
 		Args: cobra.MinimumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -90,9 +101,21 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 				return err
 			}
 
+			if opts.Checksums && len(opts.Assets) == 0 {
+				return &cmdutil.FlagError{Err: fmt.Errorf("`--checksums` requires at least one asset file")}
+			}
+
+			if opts.NotesStartTag != "" && !opts.GenerateNotes {
+				return &cmdutil.FlagError{Err: fmt.Errorf("`--notes-start-tag` is only supported with `--generate-notes`")}
+			}
+
+			if opts.DiscussionCategory != "" && opts.Draft {
+				return &cmdutil.FlagError{Err: fmt.Errorf("discussions for draft releases not supported")}
+			}
+
 			opts.Concurrency = 5
 
-			opts.BodyProvided = cmd.Flags().Changed("notes")
+			opts.BodyProvided = cmd.Flags().Changed("notes") || opts.GenerateNotes
 			if notesFile != "" {
 				var b []byte
 				if notesFile == "-" {
@@ -120,6 +143,11 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 	cmd.Flags().StringVarP(&opts.Name, "title", "t", "", "Release title")
 	cmd.Flags().StringVarP(&opts.Body, "notes", "n", "", "Release notes")
 	cmd.Flags().StringVarP(&notesFile, "notes-file", "F", "", "Read release notes from `file`")
+	cmd.Flags().BoolVar(&opts.Copy, "copy", false, "Copy the release URL to the clipboard")
+	cmd.Flags().BoolVar(&opts.Checksums, "checksums", false, "Generate and upload a SHA256SUMS file for the assets")
+	cmd.Flags().BoolVar(&opts.GenerateNotes, "generate-notes", false, "Automatically generate title and notes for the release")
+	cmd.Flags().StringVar(&opts.NotesStartTag, "notes-start-tag", "", "Tag to use as the starting point for generating release notes")
+	cmd.Flags().StringVar(&opts.DiscussionCategory, "discussion-category", "", "Start a discussion of the specified category")
 
 	return cmd
 }
@@ -266,12 +294,40 @@ func createRun(opts *CreateOptions) error {
 	if opts.Target != "" {
 		params["target_commitish"] = opts.Target
 	}
+	if opts.DiscussionCategory != "" {
+		params["discussion_category_name"] = opts.DiscussionCategory
+	}
+
+	if opts.GenerateNotes {
+		generatedNotes, err := generateReleaseNotes(httpClient, baseRepo, opts.TagName, opts.Target, opts.NotesStartTag)
+		if err != nil {
+			return err
+		}
+		if opts.Name == "" {
+			params["name"] = generatedNotes.Name
+		}
+		if opts.Body == "" {
+			params["body"] = generatedNotes.Body
+		} else {
+			params["body"] = opts.Body + "\n" + generatedNotes.Body
+		}
+	}
+
+	if opts.Checksums && len(opts.Assets) > 0 {
+		checksumsAsset, err := shared.ChecksumsAsset(opts.Assets)
+		if err != nil {
+			return err
+		}
+		opts.Assets = append(opts.Assets, checksumsAsset)
+	}
 
 	hasAssets := len(opts.Assets) > 0
 
 	// Avoid publishing the release until all assets have finished uploading
 	if hasAssets {
 		params["draft"] = true
+		// the discussion is created when the release is published
+		delete(params, "discussion_category_name")
 	}
 
 	newRelease, err := createRelease(httpClient, baseRepo, params)
@@ -286,14 +342,14 @@ func createRun(opts *CreateOptions) error {
 		}
 
 		opts.IO.StartProgressIndicator()
-		err = shared.ConcurrentUpload(httpClient, uploadURL, opts.Concurrency, opts.Assets)
+		err = shared.ConcurrentUpload(httpClient, uploadURL, opts.Concurrency, opts.Assets, nil)
 		opts.IO.StopProgressIndicator()
 		if err != nil {
 			return err
 		}
 
 		if !opts.Draft {
-			rel, err := publishRelease(httpClient, newRelease.APIURL)
+			rel, err := publishRelease(httpClient, newRelease.APIURL, opts.DiscussionCategory)
 			if err != nil {
 				return err
 			}
@@ -303,6 +359,12 @@ func createRun(opts *CreateOptions) error {
 
 	fmt.Fprintf(opts.IO.Out, "%s\n", newRelease.HTMLURL)
 
+	if opts.Copy {
+		if copyErr := clipboard.Copy(newRelease.HTMLURL); copyErr != nil {
+			fmt.Fprintf(opts.IO.ErrOut, "warning: could not copy to clipboard: %v\n", copyErr)
+		}
+	}
+
 	return nil
 }
 
@@ -154,12 +154,61 @@ func Test_NewCmdCreate(t *testing.T) {
 				Assets:       []*shared.AssetForUpload(nil),
 			},
 		},
+		{
+			name:  "generate notes",
+			args:  "v1.2.3 --generate-notes --notes-start-tag v1.1.0",
+			isTTY: true,
+			want: CreateOptions{
+				TagName:       "v1.2.3",
+				Target:        "",
+				Name:          "",
+				Body:          "",
+				BodyProvided:  true,
+				Draft:         false,
+				Prerelease:    false,
+				GenerateNotes: true,
+				NotesStartTag: "v1.1.0",
+				RepoOverride:  "",
+				Concurrency:   5,
+				Assets:        []*shared.AssetForUpload(nil),
+			},
+		},
 		{
 			name:    "no arguments",
 			args:    "",
 			isTTY:   true,
 			wantErr: "requires at least 1 arg(s), only received 0",
 		},
+		{
+			name:    "notes start tag without generate notes",
+			args:    "v1.2.3 --notes-start-tag v1.1.0",
+			isTTY:   true,
+			wantErr: "`--notes-start-tag` is only supported with `--generate-notes`",
+		},
+		{
+			name:  "discussion category",
+			args:  "v1.2.3 --discussion-category 'General'",
+			isTTY: true,
+			want: CreateOptions{
+				TagName:            "v1.2.3",
+				Target:             "",
+				Name:               "",
+				Body:               "",
+				BodyProvided:       false,
+				Draft:              false,
+				Prerelease:         false,
+				DiscussionCategory: "General",
+				RepoOverride:       "",
+				Concurrency:        5,
+				Assets:             []*shared.AssetForUpload(nil),
+			},
+		},
+		{
+			name:    "discussion category for draft release",
+			args:    "v1.2.3 -d --discussion-category 'General'",
+			isTTY:   true,
+			wantErr: "discussions for draft releases not supported",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -207,6 +256,9 @@ func Test_NewCmdCreate(t *testing.T) {
 			assert.Equal(t, tt.want.BodyProvided, opts.BodyProvided)
 			assert.Equal(t, tt.want.Draft, opts.Draft)
 			assert.Equal(t, tt.want.Prerelease, opts.Prerelease)
+			assert.Equal(t, tt.want.GenerateNotes, opts.GenerateNotes)
+			assert.Equal(t, tt.want.NotesStartTag, opts.NotesStartTag)
+			assert.Equal(t, tt.want.DiscussionCategory, opts.DiscussionCategory)
 			assert.Equal(t, tt.want.Concurrency, opts.Concurrency)
 			assert.Equal(t, tt.want.RepoOverride, opts.RepoOverride)
 
@@ -291,6 +343,28 @@ func Test_createRun(t *testing.T) {
 			wantStdout: "https://github.com/OWNER/REPO/releases/tag/v1.2.3\n",
 			wantStderr: ``,
 		},
+		{
+			name:  "with discussion category",
+			isTTY: true,
+			opts: CreateOptions{
+				TagName:            "v1.2.3",
+				Name:               "",
+				Body:               "",
+				BodyProvided:       true,
+				Target:             "",
+				DiscussionCategory: "General",
+			},
+			wantParams: map[string]interface{}{
+				"tag_name":                 "v1.2.3",
+				"name":                     "",
+				"body":                     "",
+				"draft":                    false,
+				"prerelease":               false,
+				"discussion_category_name": "General",
+			},
+			wantStdout: "https://github.com/OWNER/REPO/releases/tag/v1.2.3\n",
+			wantStderr: ``,
+		},
 		{
 			name:  "publish after uploading files",
 			isTTY: true,
@@ -381,3 +455,64 @@ func Test_createRun(t *testing.T) {
 		})
 	}
 }
+
+func Test_createRun_generateNotes(t *testing.T) {
+	io, _, stdout, _ := iostreams.Test()
+	io.SetStdoutTTY(true)
+	io.SetStdinTTY(true)
+	io.SetStderrTTY(true)
+
+	fakeHTTP := &httpmock.Registry{}
+	defer fakeHTTP.Verify(t)
+	fakeHTTP.Register(httpmock.REST("POST", "repos/OWNER/REPO/releases/generate-notes"), httpmock.StatusStringResponse(200, `{
+		"name": "generated name",
+		"body": "generated body"
+	}`))
+	fakeHTTP.Register(httpmock.REST("POST", "repos/OWNER/REPO/releases"), httpmock.StatusStringResponse(201, `{
+		"url": "https://api.github.com/releases/123",
+		"upload_url": "https://api.github.com/assets/upload",
+		"html_url": "https://github.com/OWNER/REPO/releases/tag/v1.2.3"
+	}`))
+
+	opts := CreateOptions{
+		IO:            io,
+		TagName:       "v1.2.3",
+		BodyProvided:  true,
+		GenerateNotes: true,
+		NotesStartTag: "v1.1.0",
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: fakeHTTP}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.FromFullName("OWNER/REPO")
+		},
+	}
+
+	err := createRun(&opts)
+	require.NoError(t, err)
+
+	bb, err := ioutil.ReadAll(fakeHTTP.Requests[0].Body)
+	require.NoError(t, err)
+	var notesParams interface{}
+	err = json.Unmarshal(bb, &notesParams)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"tag_name":          "v1.2.3",
+		"previous_tag_name": "v1.1.0",
+	}, notesParams)
+
+	bb, err = ioutil.ReadAll(fakeHTTP.Requests[1].Body)
+	require.NoError(t, err)
+	var createParams interface{}
+	err = json.Unmarshal(bb, &createParams)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"tag_name":   "v1.2.3",
+		"name":       "generated name",
+		"body":       "generated body",
+		"draft":      false,
+		"prerelease": false,
+	}, createParams)
+
+	assert.Equal(t, "https://github.com/OWNER/REPO/releases/tag/v1.2.3\n", stdout.String())
+}
@@ -2,10 +2,12 @@ package download
 
 import (
 	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/api"
@@ -24,6 +26,8 @@ type DownloadOptions struct {
 	TagName      string
 	FilePatterns []string
 	Destination  string
+	ArchiveType  string
+	Verify       bool
 
 	// maximum number of simultaneous downloads
 	Concurrency int
@@ -42,17 +46,21 @@ func NewCmdDownload(f *cmdutil.Factory, runF func(*DownloadOptions) error) *cobr
 			Download assets from a GitHub release.
 
 			Without an explicit tag name argument, assets are downloaded from the
-			latest release in the project. In this case, '--pattern' is required.
+			latest release in the project. In this case, '--pattern' or '--archive'
+			is required.
 		`),
 		Example: heredoc.Doc(`
 			# download all assets from a specific release
 			$ gh release download v1.2.3
-			
+
 			# download only Debian packages for the latest release
 			$ gh release download --pattern '*.deb'
-			
+
 			# specify multiple file patterns
 			$ gh release download -p '*.deb' -p '*.rpm'
+
+			# download the source archive for a specific release
+			$ gh release download v1.2.3 --archive=tar.gz
 		`),
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -60,13 +68,25 @@ func NewCmdDownload(f *cmdutil.Factory, runF func(*DownloadOptions) error) *cobr
 			opts.BaseRepo = f.BaseRepo
 
 			if len(args) == 0 {
-				if len(opts.FilePatterns) == 0 {
-					return &cmdutil.FlagError{Err: errors.New("the '--pattern' flag is required when downloading the latest release")}
+				if len(opts.FilePatterns) == 0 && opts.ArchiveType == "" {
+					return &cmdutil.FlagError{Err: errors.New("the '--pattern' or '--archive' flag is required when downloading the latest release")}
 				}
 			} else {
 				opts.TagName = args[0]
 			}
 
+			if opts.ArchiveType != "" {
+				if opts.ArchiveType != "zip" && opts.ArchiveType != "tar.gz" {
+					return &cmdutil.FlagError{Err: errors.New(`the value for '--archive' must be "zip" or "tar.gz"`)}
+				}
+				if len(opts.FilePatterns) > 0 {
+					return &cmdutil.FlagError{Err: errors.New("specify only one of '--pattern' or '--archive'")}
+				}
+				if opts.Verify {
+					return &cmdutil.FlagError{Err: errors.New("`--verify` is not supported with `--archive`")}
+				}
+			}
+
 			opts.Concurrency = 5
 
 			if runF != nil {
@@ -78,6 +98,8 @@ func NewCmdDownload(f *cmdutil.Factory, runF func(*DownloadOptions) error) *cobr
 
 	cmd.Flags().StringVarP(&opts.Destination, "dir", "D", ".", "The directory to download files into")
 	cmd.Flags().StringArrayVarP(&opts.FilePatterns, "pattern", "p", nil, "Download only assets that match a glob pattern")
+	cmd.Flags().StringVarP(&opts.ArchiveType, "archive", "A", "", "Download the source code archive in the specified `format` (zip or tar.gz)")
+	cmd.Flags().BoolVar(&opts.Verify, "verify", false, "Verify downloaded assets against the checksums asset of the release")
 
 	return cmd
 }
@@ -108,11 +130,25 @@ func downloadRun(opts *DownloadOptions) error {
 	}
 
 	var toDownload []shared.ReleaseAsset
-	for _, a := range release.Assets {
-		if len(opts.FilePatterns) > 0 && !matchAny(opts.FilePatterns, a.Name) {
-			continue
+	if opts.ArchiveType != "" {
+		archiveURL := release.ZipballURL
+		ext := "zip"
+		if opts.ArchiveType == "tar.gz" {
+			archiveURL = release.TarballURL
+			ext = "tar.gz"
+		}
+		name := fmt.Sprintf("%s-%s.%s", baseRepo.RepoName(), strings.TrimPrefix(release.TagName, "v"), ext)
+		toDownload = append(toDownload, shared.ReleaseAsset{
+			Name:   name,
+			APIURL: archiveURL,
+		})
+	} else {
+		for _, a := range release.Assets {
+			if len(opts.FilePatterns) > 0 && !matchAny(opts.FilePatterns, a.Name) {
+				continue
+			}
+			toDownload = append(toDownload, a)
 		}
-		toDownload = append(toDownload, a)
 	}
 
 	if len(toDownload) == 0 {
@@ -132,7 +168,14 @@ func downloadRun(opts *DownloadOptions) error {
 	opts.IO.StartProgressIndicator()
 	err = downloadAssets(httpClient, toDownload, opts.Destination, opts.Concurrency)
 	opts.IO.StopProgressIndicator()
-	return err
+	if err != nil {
+		return err
+	}
+
+	if opts.Verify {
+		return verifyAssets(httpClient, release, toDownload, opts)
+	}
+	return nil
 }
 
 func matchAny(patterns []string, name string) bool {
@@ -8,6 +8,7 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/internal/ghrepo"
 	"github.com/cli/cli/pkg/cmdutil"
 	"github.com/cli/cli/pkg/httpmock"
@@ -80,11 +81,35 @@ func Test_NewCmdDownload(t *testing.T) {
 				Concurrency:  5,
 			},
 		},
+		{
+			name:  "source archive",
+			args:  "v1.2.3 --archive=zip",
+			isTTY: true,
+			want: DownloadOptions{
+				TagName:      "v1.2.3",
+				FilePatterns: []string(nil),
+				Destination:  ".",
+				ArchiveType:  "zip",
+				Concurrency:  5,
+			},
+		},
 		{
 			name:    "no arguments",
 			args:    "",
 			isTTY:   true,
-			wantErr: "the '--pattern' flag is required when downloading the latest release",
+			wantErr: "the '--pattern' or '--archive' flag is required when downloading the latest release",
+		},
+		{
+			name:    "invalid archive type",
+			args:    "v1.2.3 --archive=gz",
+			isTTY:   true,
+			wantErr: `the value for '--archive' must be "zip" or "tar.gz"`,
+		},
+		{
+			name:    "archive with pattern",
+			args:    "v1.2.3 --archive=zip -p *.tgz",
+			isTTY:   true,
+			wantErr: "specify only one of '--pattern' or '--archive'",
 		},
 	}
 	for _, tt := range tests {
@@ -124,6 +149,7 @@ func Test_NewCmdDownload(t *testing.T) {
 			assert.Equal(t, tt.want.TagName, opts.TagName)
 			assert.Equal(t, tt.want.FilePatterns, opts.FilePatterns)
 			assert.Equal(t, tt.want.Destination, opts.Destination)
+			assert.Equal(t, tt.want.ArchiveType, opts.ArchiveType)
 			assert.Equal(t, tt.want.Concurrency, opts.Concurrency)
 		})
 	}
@@ -171,6 +197,21 @@ func Test_downloadRun(t *testing.T) {
 				"tmp/assets/windows-64bit.zip",
 			},
 		},
+		{
+			name:  "download source archive",
+			isTTY: true,
+			opts: DownloadOptions{
+				TagName:     "v1.2.3",
+				ArchiveType: "tar.gz",
+				Destination: ".",
+				Concurrency: 2,
+			},
+			wantStdout: ``,
+			wantStderr: ``,
+			wantFiles: []string{
+				"REPO-1.2.3.tar.gz",
+			},
+		},
 		{
 			name:  "no match for pattern",
 			isTTY: true,
@@ -197,6 +238,9 @@ func Test_downloadRun(t *testing.T) {
 
 			fakeHTTP := &httpmock.Registry{}
 			fakeHTTP.Register(httpmock.REST("GET", "repos/OWNER/REPO/releases/tags/v1.2.3"), httpmock.StringResponse(`{
+				"tag_name": "v1.2.3",
+				"zipball_url": "https://api.github.com/repos/OWNER/REPO/zipball/v1.2.3",
+				"tarball_url": "https://api.github.com/repos/OWNER/REPO/tarball/v1.2.3",
 				"assets": [
 					{ "name": "windows-32bit.zip", "size": 12,
 					  "url": "https://api.github.com/assets/1234" },
@@ -209,6 +253,8 @@ func Test_downloadRun(t *testing.T) {
 			fakeHTTP.Register(httpmock.REST("GET", "assets/1234"), httpmock.StringResponse(`1234`))
 			fakeHTTP.Register(httpmock.REST("GET", "assets/3456"), httpmock.StringResponse(`3456`))
 			fakeHTTP.Register(httpmock.REST("GET", "assets/5678"), httpmock.StringResponse(`5678`))
+			fakeHTTP.Register(httpmock.REST("GET", "repos/OWNER/REPO/zipball/v1.2.3"), httpmock.StringResponse(`zip`))
+			fakeHTTP.Register(httpmock.REST("GET", "repos/OWNER/REPO/tarball/v1.2.3"), httpmock.StringResponse(`tarball`))
 
 			tt.opts.IO = io
 			tt.opts.HttpClient = func() (*http.Client, error) {
@@ -238,6 +284,87 @@ func Test_downloadRun(t *testing.T) {
 	}
 }
 
+func Test_downloadRun_verify(t *testing.T) {
+	goodSums := heredoc.Doc(`
+		03ac674216f3e15c761ee1a5e255f067953623c8b388b4459e13f978d7c846f4  windows-32bit.zip
+		ceaa28bba4caba687dc31b1bbe79eca3c70c33f871f1ce8f528cf9ab5cfd76dd  windows-64bit.zip
+		f8638b979b2f4f793ddb6dbd197e0ee25a7a6ea32b0ae22f5e3c5d119d839e75  linux.tgz
+	`)
+	badSums := heredoc.Doc(`
+		03ac674216f3e15c761ee1a5e255f067953623c8b388b4459e13f978d7c846f4  windows-32bit.zip
+		ceaa28bba4caba687dc31b1bbe79eca3c70c33f871f1ce8f528cf9ab5cfd76dd  windows-64bit.zip
+		0000000000000000000000000000000000000000000000000000000000000000  linux.tgz
+	`)
+
+	tests := []struct {
+		name       string
+		checksums  string
+		wantErr    string
+		wantStderr string
+	}{
+		{
+			name:       "checksums match",
+			checksums:  goodSums,
+			wantStderr: "✓ Verified the checksums of 3 assets\n",
+		},
+		{
+			name:      "checksum mismatch",
+			checksums: badSums,
+			wantErr:   `checksum mismatch for "linux.tgz": expected 0000000000000000000000000000000000000000000000000000000000000000, got f8638b979b2f4f793ddb6dbd197e0ee25a7a6ea32b0ae22f5e3c5d119d839e75`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, _, stderr := iostreams.Test()
+			io.SetStdoutTTY(true)
+			io.SetStdinTTY(true)
+			io.SetStderrTTY(true)
+
+			fakeHTTP := &httpmock.Registry{}
+			fakeHTTP.Register(httpmock.REST("GET", "repos/OWNER/REPO/releases/tags/v1.2.3"), httpmock.StringResponse(`{
+				"tag_name": "v1.2.3",
+				"assets": [
+					{ "name": "windows-32bit.zip", "size": 12,
+					  "url": "https://api.github.com/assets/1234" },
+					{ "name": "windows-64bit.zip", "size": 34,
+					  "url": "https://api.github.com/assets/3456" },
+					{ "name": "linux.tgz", "size": 56,
+					  "url": "https://api.github.com/assets/5678" },
+					{ "name": "SHA256SUMS", "size": 78,
+					  "url": "https://api.github.com/assets/7890" }
+				]
+			}`))
+			fakeHTTP.Register(httpmock.REST("GET", "assets/1234"), httpmock.StringResponse(`1234`))
+			fakeHTTP.Register(httpmock.REST("GET", "assets/3456"), httpmock.StringResponse(`3456`))
+			fakeHTTP.Register(httpmock.REST("GET", "assets/5678"), httpmock.StringResponse(`5678`))
+			fakeHTTP.Register(httpmock.REST("GET", "assets/7890"), httpmock.StringResponse(tt.checksums))
+
+			opts := DownloadOptions{
+				TagName:      "v1.2.3",
+				FilePatterns: []string{"windows-*.zip", "*.tgz"},
+				Destination:  t.TempDir(),
+				Verify:       true,
+				Concurrency:  2,
+				IO:           io,
+				HttpClient: func() (*http.Client, error) {
+					return &http.Client{Transport: fakeHTTP}, nil
+				},
+				BaseRepo: func() (ghrepo.Interface, error) {
+					return ghrepo.FromFullName("OWNER/REPO")
+				},
+			}
+
+			err := downloadRun(&opts)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantStderr, stderr.String())
+		})
+	}
+}
+
 func listFiles(dir string) ([]string, error) {
 	var files []string
 	err := filepath.Walk(dir, func(p string, f os.FileInfo, err error) error {
@@ -0,0 +1,134 @@
+package download
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/cli/cli/internal/run"
+	"github.com/cli/cli/pkg/cmd/release/shared"
+	"github.com/cli/cli/utils"
+)
+
+// verifyAssets checks each downloaded asset against the checksums asset of the
+// release. If a detached signature for the checksums file is among the release
+// assets, the signature is verified with gpg first.
+func verifyAssets(httpClient *http.Client, release *shared.Release, downloaded []shared.ReleaseAsset, opts *DownloadOptions) error {
+	checksumsAsset := findChecksumsAsset(release.Assets)
+	if checksumsAsset == nil {
+		return fmt.Errorf("unable to verify: release %s has no checksums asset", release.TagName)
+	}
+
+	checksumsPath := filepath.Join(opts.Destination, checksumsAsset.Name)
+	if _, err := os.Stat(checksumsPath); os.IsNotExist(err) {
+		if err := downloadAsset(httpClient, checksumsAsset.APIURL, checksumsPath); err != nil {
+			return err
+		}
+	}
+
+	if sigAsset := findSignatureAsset(release.Assets, checksumsAsset.Name); sigAsset != nil {
+		sigPath := filepath.Join(opts.Destination, sigAsset.Name)
+		if _, err := os.Stat(sigPath); os.IsNotExist(err) {
+			if err := downloadAsset(httpClient, sigAsset.APIURL, sigPath); err != nil {
+				return err
+			}
+		}
+
+		cmd := exec.Command("gpg", "--verify", sigPath, checksumsPath)
+		if err := run.PrepareCmd(cmd).Run(); err != nil {
+			return fmt.Errorf("signature verification of %s failed: %w", checksumsAsset.Name, err)
+		}
+		if opts.IO.IsStdoutTTY() {
+			fmt.Fprintf(opts.IO.ErrOut, "%s Verified the signature of %s\n", utils.GreenCheck(), checksumsAsset.Name)
+		}
+	}
+
+	checksums, err := parseChecksums(checksumsPath)
+	if err != nil {
+		return err
+	}
+
+	verified := 0
+	for _, a := range downloaded {
+		if a.Name == checksumsAsset.Name {
+			continue
+		}
+		expected, ok := checksums[a.Name]
+		if !ok {
+			return fmt.Errorf("no checksum found for %q in %s", a.Name, checksumsAsset.Name)
+		}
+		actual, err := fileChecksum(filepath.Join(opts.Destination, a.Name))
+		if err != nil {
+			return err
+		}
+		if !strings.EqualFold(actual, expected) {
+			return fmt.Errorf("checksum mismatch for %q: expected %s, got %s", a.Name, expected, actual)
+		}
+		verified++
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		fmt.Fprintf(opts.IO.ErrOut, "%s Verified the checksums of %s\n", utils.GreenCheck(), utils.Pluralize(verified, "asset"))
+	}
+	return nil
+}
+
+func findChecksumsAsset(assets []shared.ReleaseAsset) *shared.ReleaseAsset {
+	for i, a := range assets {
+		switch strings.ToLower(a.Name) {
+		case "sha256sums", "sha256sums.txt", "checksums.txt":
+			return &assets[i]
+		}
+	}
+	return nil
+}
+
+func findSignatureAsset(assets []shared.ReleaseAsset, checksumsName string) *shared.ReleaseAsset {
+	for i, a := range assets {
+		if a.Name == checksumsName+".sig" || a.Name == checksumsName+".asc" {
+			return &assets[i]
+		}
+	}
+	return nil
+}
+
+// parseChecksums reads a file in the format produced by sha256sum: one line
+// per file consisting of a hex digest and a file name.
+func parseChecksums(path string) (map[string]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	checksums := map[string]string{}
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		fields := strings.Fields(s.Text())
+		if len(fields) != 2 {
+			continue
+		}
+		checksums[strings.TrimPrefix(fields[1], "*")] = fields[0]
+	}
+	return checksums, s.Err()
+}
+
+func fileChecksum(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%x", h.Sum(nil)), nil
+}
@@ -0,0 +1,119 @@
+package publish
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/cmd/release/shared"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type PublishOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	TagName string
+}
+
+func NewCmdPublish(f *cmdutil.Factory, runF func(*PublishOptions) error) *cobra.Command {
+	opts := &PublishOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "publish <tag>",
+		Short: "Publish a draft or prerelease as the latest release",
+		Long: heredoc.Doc(`
+			Promote a draft or prerelease to a full release.
+
+			The release is marked as published and its prerelease flag is cleared,
+			making it the latest release of the repository.
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+
+			opts.TagName = args[0]
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return publishRun(opts)
+		},
+	}
+
+	return cmd
+}
+
+func publishRun(opts *PublishOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	release, err := shared.FetchRelease(httpClient, baseRepo, opts.TagName)
+	if err != nil {
+		return err
+	}
+
+	if !release.IsDraft && !release.IsPrerelease {
+		return fmt.Errorf("release %s is already published", release.TagName)
+	}
+
+	release, err = publishRelease(httpClient, release.APIURL)
+	if err != nil {
+		return err
+	}
+
+	if opts.IO.IsStdoutTTY() && opts.IO.IsStderrTTY() {
+		iofmt := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.ErrOut, "%s Published release %s\n", iofmt.SuccessIcon(), release.TagName)
+	}
+	fmt.Fprintf(opts.IO.Out, "%s\n", release.HTMLURL)
+
+	return nil
+}
+
+func publishRelease(httpClient *http.Client, releaseURL string) (*shared.Release, error) {
+	req, err := http.NewRequest("PATCH", releaseURL, bytes.NewBufferString(`{"draft":false,"prerelease":false}`))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", "application/json")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode > 299 {
+		return nil, api.HandleHTTPError(resp)
+	}
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var release shared.Release
+	err = json.Unmarshal(b, &release)
+	return &release, err
+}
@@ -0,0 +1,103 @@
+package publish
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/httpmock"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_publishRun(t *testing.T) {
+	tests := []struct {
+		name       string
+		isTTY      bool
+		release    string
+		wantErr    string
+		wantStdout string
+		wantStderr string
+	}{
+		{
+			name:  "publish a draft",
+			isTTY: true,
+			release: `{
+				"tag_name": "v1.2.3",
+				"draft": true,
+				"url": "https://api.github.com/repos/OWNER/REPO/releases/23456"
+			}`,
+			wantStdout: "https://github.com/OWNER/REPO/releases/tag/v1.2.3\n",
+			wantStderr: "✓ Published release v1.2.3\n",
+		},
+		{
+			name:  "promote a prerelease",
+			isTTY: false,
+			release: `{
+				"tag_name": "v1.2.3",
+				"draft": false,
+				"prerelease": true,
+				"url": "https://api.github.com/repos/OWNER/REPO/releases/23456"
+			}`,
+			wantStdout: "https://github.com/OWNER/REPO/releases/tag/v1.2.3\n",
+			wantStderr: ``,
+		},
+		{
+			name:  "already published",
+			isTTY: true,
+			release: `{
+				"tag_name": "v1.2.3",
+				"draft": false,
+				"prerelease": false,
+				"url": "https://api.github.com/repos/OWNER/REPO/releases/23456"
+			}`,
+			wantErr: "release v1.2.3 is already published",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, stdout, stderr := iostreams.Test()
+			io.SetStdoutTTY(tt.isTTY)
+			io.SetStdinTTY(tt.isTTY)
+			io.SetStderrTTY(tt.isTTY)
+
+			fakeHTTP := &httpmock.Registry{}
+			fakeHTTP.Register(httpmock.REST("GET", "repos/OWNER/REPO/releases/tags/v1.2.3"), httpmock.StringResponse(tt.release))
+			fakeHTTP.Register(httpmock.REST("PATCH", "repos/OWNER/REPO/releases/23456"), httpmock.StatusStringResponse(200, `{
+				"tag_name": "v1.2.3",
+				"html_url": "https://github.com/OWNER/REPO/releases/tag/v1.2.3"
+			}`))
+
+			opts := PublishOptions{
+				TagName: "v1.2.3",
+				IO:      io,
+				HttpClient: func() (*http.Client, error) {
+					return &http.Client{Transport: fakeHTTP}, nil
+				},
+				BaseRepo: func() (ghrepo.Interface, error) {
+					return ghrepo.FromFullName("OWNER/REPO")
+				},
+			}
+
+			err := publishRun(&opts)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			bb, err := ioutil.ReadAll(fakeHTTP.Requests[1].Body)
+			require.NoError(t, err)
+			var params interface{}
+			err = json.Unmarshal(bb, &params)
+			require.NoError(t, err)
+			assert.Equal(t, map[string]interface{}{"draft": false, "prerelease": false}, params)
+
+			assert.Equal(t, tt.wantStdout, stdout.String())
+			assert.Equal(t, tt.wantStderr, stderr.String())
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	cmdDelete "github.com/cli/cli/pkg/cmd/release/delete"
 	cmdDownload "github.com/cli/cli/pkg/cmd/release/download"
 	cmdList "github.com/cli/cli/pkg/cmd/release/list"
+	cmdPublish "github.com/cli/cli/pkg/cmd/release/publish"
 	cmdUpload "github.com/cli/cli/pkg/cmd/release/upload"
 	cmdView "github.com/cli/cli/pkg/cmd/release/view"
 	"github.com/cli/cli/pkg/cmdutil"
@@ -26,6 +27,7 @@ func NewCmdRelease(f *cmdutil.Factory) *cobra.Command {
 	cmd.AddCommand(cmdDelete.NewCmdDelete(f, nil))
 	cmd.AddCommand(cmdDownload.NewCmdDownload(f, nil))
 	cmd.AddCommand(cmdList.NewCmdList(f, nil))
+	cmd.AddCommand(cmdPublish.NewCmdPublish(f, nil))
 	cmd.AddCommand(cmdView.NewCmdView(f, nil))
 	cmd.AddCommand(cmdUpload.NewCmdUpload(f, nil))
 
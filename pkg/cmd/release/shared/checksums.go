@@ -0,0 +1,39 @@
+package shared
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"io/ioutil"
+)
+
+// ChecksumsAsset computes the SHA-256 checksum of each asset and bundles the
+// results up as a SHA256SUMS file ready for upload, in the same format that
+// `sha256sum` produces
+func ChecksumsAsset(assets []*AssetForUpload) (*AssetForUpload, error) {
+	buf := &bytes.Buffer{}
+	for _, a := range assets {
+		f, err := a.Open()
+		if err != nil {
+			return nil, err
+		}
+		h := sha256.New()
+		_, err = io.Copy(h, f)
+		f.Close()
+		if err != nil {
+			return nil, err
+		}
+		fmt.Fprintf(buf, "%x  %s\n", h.Sum(nil), a.Name)
+	}
+
+	contents := buf.Bytes()
+	return &AssetForUpload{
+		Name:     "SHA256SUMS",
+		Size:     int64(len(contents)),
+		MIMEType: "text/plain",
+		Open: func() (io.ReadCloser, error) {
+			return ioutil.NopCloser(bytes.NewReader(contents)), nil
+		},
+	}, nil
+}
@@ -0,0 +1,56 @@
+package shared
+
+import (
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+func Test_ChecksumsAsset(t *testing.T) {
+	assets := []*AssetForUpload{
+		{
+			Name: "windows.zip",
+			Open: func() (io.ReadCloser, error) {
+				return ioutil.NopCloser(strings.NewReader("hello")), nil
+			},
+		},
+		{
+			Name: "linux.tgz",
+			Open: func() (io.ReadCloser, error) {
+				return ioutil.NopCloser(strings.NewReader("world")), nil
+			},
+		},
+	}
+
+	checksums, err := ChecksumsAsset(assets)
+	if err != nil {
+		t.Fatalf("ChecksumsAsset() error: %v", err)
+	}
+
+	if checksums.Name != "SHA256SUMS" {
+		t.Errorf("expected asset name %q, got %q", "SHA256SUMS", checksums.Name)
+	}
+	if checksums.MIMEType != "text/plain" {
+		t.Errorf("expected MIME type %q, got %q", "text/plain", checksums.MIMEType)
+	}
+
+	f, err := checksums.Open()
+	if err != nil {
+		t.Fatalf("Open() error: %v", err)
+	}
+	defer f.Close()
+	contents, err := ioutil.ReadAll(f)
+	if err != nil {
+		t.Fatalf("ReadAll() error: %v", err)
+	}
+
+	expected := "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824  windows.zip\n" +
+		"486ea46224d1bb4fb680f34f7c9ad96a8f24ec88be73ea8e5a6c65260e9cb8a7  linux.tgz\n"
+	if string(contents) != expected {
+		t.Errorf("unexpected contents:\n%s", contents)
+	}
+	if checksums.Size != int64(len(expected)) {
+		t.Errorf("expected size %d, got %d", len(expected), checksums.Size)
+	}
+}
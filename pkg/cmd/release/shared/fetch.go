@@ -22,10 +22,12 @@ type Release struct {
 	CreatedAt    time.Time `json:"created_at"`
 	PublishedAt  time.Time `json:"published_at"`
 
-	APIURL    string `json:"url"`
-	UploadURL string `json:"upload_url"`
-	HTMLURL   string `json:"html_url"`
-	Assets    []ReleaseAsset
+	APIURL     string `json:"url"`
+	UploadURL  string `json:"upload_url"`
+	HTMLURL    string `json:"html_url"`
+	ZipballURL string `json:"zipball_url"`
+	TarballURL string `json:"tarball_url"`
+	Assets     []ReleaseAsset
 
 	Author struct {
 		Login string
@@ -89,13 +89,21 @@ func fileExt(fn string) string {
 	return path.Ext(fn)
 }
 
-func ConcurrentUpload(httpClient *http.Client, uploadURL string, numWorkers int, assets []*AssetForUpload) error {
+type uploadResult struct {
+	name string
+	err  error
+}
+
+// ConcurrentUpload uploads assets to a release using a pool of workers. The
+// progress callback, if non-nil, is invoked from a single goroutine as each
+// asset finishes uploading.
+func ConcurrentUpload(httpClient *http.Client, uploadURL string, numWorkers int, assets []*AssetForUpload, progress func(name string)) error {
 	if numWorkers == 0 {
 		return errors.New("the number of concurrent workers needs to be greater than 0")
 	}
 
 	jobs := make(chan AssetForUpload, len(assets))
-	results := make(chan error, len(assets))
+	results := make(chan uploadResult, len(assets))
 
 	if len(assets) < numWorkers {
 		numWorkers = len(assets)
@@ -104,7 +112,7 @@ func ConcurrentUpload(httpClient *http.Client, uploadURL string, numWorkers int,
 	for w := 1; w <= numWorkers; w++ {
 		go func() {
 			for a := range jobs {
-				results <- uploadWithDelete(httpClient, uploadURL, a)
+				results <- uploadResult{name: a.Name, err: uploadWithDelete(httpClient, uploadURL, a)}
 			}
 		}()
 	}
@@ -116,8 +124,10 @@ func ConcurrentUpload(httpClient *http.Client, uploadURL string, numWorkers int,
 
 	var uploadError error
 	for i := 0; i < len(assets); i++ {
-		if err := <-results; err != nil {
-			uploadError = err
+		if r := <-results; r.err != nil {
+			uploadError = r.err
+		} else if progress != nil {
+			progress(r.name)
 		}
 	}
 	return uploadError
@@ -138,7 +148,7 @@ func uploadWithDelete(httpClient *http.Client, uploadURL string, a AssetForUploa
 		var httpError api.HTTPError
 		_, err := uploadAsset(httpClient, uploadURL, a)
 		// retry upload several times upon receiving HTTP 5xx
-		if err == nil || !errors.As(err, &httpError) || httpError.StatusCode < 500 || retries < maxRetries {
+		if err == nil || !errors.As(err, &httpError) || httpError.StatusCode < 500 || retries >= maxRetries {
 			return err
 		}
 		retries++
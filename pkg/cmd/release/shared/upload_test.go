@@ -1,6 +1,14 @@
 package shared
 
-import "testing"
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/cli/cli/pkg/httpmock"
+)
 
 func Test_typeForFilename(t *testing.T) {
 	tests := []struct {
@@ -67,3 +75,36 @@ func Test_typeForFilename(t *testing.T) {
 		})
 	}
 }
+
+func Test_ConcurrentUpload_retries(t *testing.T) {
+	reg := &httpmock.Registry{}
+	reg.Register(
+		httpmock.REST("POST", "uploads"),
+		httpmock.StatusStringResponse(500, `{}`))
+	reg.Register(
+		httpmock.REST("POST", "uploads"),
+		httpmock.StatusStringResponse(201, `{"name": "ball.tgz"}`))
+
+	asset := &AssetForUpload{
+		Name:     "ball.tgz",
+		MIMEType: "application/x-gtar",
+		Open: func() (io.ReadCloser, error) {
+			return ioutil.NopCloser(strings.NewReader("gunk")), nil
+		},
+	}
+
+	var completed []string
+	err := ConcurrentUpload(&http.Client{Transport: reg}, "https://example.com/uploads", 1, []*AssetForUpload{asset}, func(name string) {
+		completed = append(completed, name)
+	})
+	if err != nil {
+		t.Fatalf("ConcurrentUpload() error: %v", err)
+	}
+
+	if len(reg.Requests) != 2 {
+		t.Errorf("expected 2 HTTP requests, got %d", len(reg.Requests))
+	}
+	if len(completed) != 1 || completed[0] != "ball.tgz" {
+		t.Errorf("unexpected progress calls: %v", completed)
+	}
+}
@@ -105,9 +105,17 @@ func uploadRun(opts *UploadOptions) error {
 		return fmt.Errorf("asset under the same name already exists: %v", existingNames)
 	}
 
-	opts.IO.StartProgressIndicator()
-	err = shared.ConcurrentUpload(httpClient, uploadURL, opts.Concurrency, opts.Assets)
-	opts.IO.StopProgressIndicator()
+	var progress func(name string)
+	if opts.IO.IsStdoutTTY() {
+		progress = func(name string) {
+			fmt.Fprintf(opts.IO.ErrOut, "%s Uploaded %s\n", utils.GreenCheck(), name)
+		}
+	} else {
+		opts.IO.StartProgressIndicator()
+		defer opts.IO.StopProgressIndicator()
+	}
+
+	err = shared.ConcurrentUpload(httpClient, uploadURL, opts.Concurrency, opts.Assets, progress)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,129 @@
+package archive
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/internal/ghinstance"
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/cli/cli/pkg/prompt"
+	"github.com/cli/cli/utils"
+	"github.com/spf13/cobra"
+)
+
+type ArchiveOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	RepoArg   string
+	Confirmed bool
+}
+
+func NewCmdArchive(f *cmdutil.Factory, runF func(*ArchiveOptions) error) *cobra.Command {
+	opts := &ArchiveOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		BaseRepo:   f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "archive [<repository>]",
+		Short: "Archive a repository",
+		Long: heredoc.Doc(`
+			Archive a GitHub repository.
+
+			An archived repository becomes read-only: issues, pull requests, and wiki
+			pages can no longer be updated until the repository is unarchived.
+
+			With no argument, archives the repository for the current directory.
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.RepoArg = args[0]
+			}
+
+			if !opts.IO.CanPrompt() && !opts.Confirmed {
+				return &cmdutil.FlagError{Err: fmt.Errorf("could not prompt: confirmation with prompt or --yes flag required")}
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return archiveRun(opts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.Confirmed, "yes", false, "Skip the confirmation prompt")
+
+	return cmd
+}
+
+func archiveRun(opts *ArchiveOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	var toArchive ghrepo.Interface
+	if opts.RepoArg == "" {
+		toArchive, err = opts.BaseRepo()
+		if err != nil {
+			return err
+		}
+	} else {
+		repoSelector := opts.RepoArg
+		if !strings.Contains(repoSelector, "/") {
+			currentUser, err := api.CurrentLoginName(apiClient, ghinstance.Default())
+			if err != nil {
+				return err
+			}
+			repoSelector = currentUser + "/" + repoSelector
+		}
+		toArchive, err = ghrepo.FromFullName(repoSelector)
+		if err != nil {
+			return fmt.Errorf("argument error: %w", err)
+		}
+	}
+
+	fullName := ghrepo.FullName(toArchive)
+
+	repo, err := api.GitHubRepo(apiClient, toArchive)
+	if err != nil {
+		return err
+	}
+
+	if repo.IsArchived {
+		fmt.Fprintf(opts.IO.ErrOut, "%s Repository %s is already archived\n", utils.Yellow("!"), fullName)
+		return nil
+	}
+
+	if !opts.Confirmed {
+		var confirmed bool
+		err := prompt.Confirm(fmt.Sprintf("Archive %s? (the repository will become read-only)", fullName), &confirmed)
+		if err != nil {
+			return fmt.Errorf("could not prompt: %w", err)
+		}
+		if !confirmed {
+			return nil
+		}
+	}
+
+	err = api.RepoArchive(apiClient, repo)
+	if err != nil {
+		return fmt.Errorf("could not archive repository: %w", err)
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		fmt.Fprintf(opts.IO.Out, "%s Archived repository %s\n", utils.GreenCheck(), fullName)
+	}
+
+	return nil
+}
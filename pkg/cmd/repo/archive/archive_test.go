@@ -0,0 +1,111 @@
+package archive
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/httpmock"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/cli/cli/pkg/prompt"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_archiveRun(t *testing.T) {
+	tests := []struct {
+		name       string
+		opts       *ArchiveOptions
+		httpStubs  func(*httpmock.Registry)
+		confirm    bool
+		isTTY      bool
+		wantStdout string
+		wantStderr string
+	}{
+		{
+			name:    "confirming archive interactively",
+			opts:    &ArchiveOptions{RepoArg: "OWNER/REPO"},
+			isTTY:   true,
+			confirm: true,
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query RepositoryInfo\b`),
+					httpmock.StringResponse(`
+					{ "data": { "repository": {
+						"id": "THE-ID",
+						"isArchived": false
+					} } }`))
+				reg.Register(
+					httpmock.GraphQL(`mutation RepositoryArchive\b`),
+					httpmock.StringResponse(`
+					{ "data": { "archiveRepository": { "repository": {
+						"id": "THE-ID"
+					} } } }`))
+			},
+			wantStdout: "✓ Archived repository OWNER/REPO\n",
+		},
+		{
+			name: "skipping confirmation with --yes",
+			opts: &ArchiveOptions{RepoArg: "OWNER/REPO", Confirmed: true},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query RepositoryInfo\b`),
+					httpmock.StringResponse(`
+					{ "data": { "repository": {
+						"id": "THE-ID",
+						"isArchived": false
+					} } }`))
+				reg.Register(
+					httpmock.GraphQL(`mutation RepositoryArchive\b`),
+					httpmock.StringResponse(`
+					{ "data": { "archiveRepository": { "repository": {
+						"id": "THE-ID"
+					} } } }`))
+			},
+		},
+		{
+			name: "repository already archived",
+			opts: &ArchiveOptions{RepoArg: "OWNER/REPO", Confirmed: true},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query RepositoryInfo\b`),
+					httpmock.StringResponse(`
+					{ "data": { "repository": {
+						"id": "THE-ID",
+						"isArchived": true
+					} } }`))
+			},
+			wantStderr: "! Repository OWNER/REPO is already archived\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			if tt.httpStubs != nil {
+				tt.httpStubs(reg)
+			}
+			tt.opts.HttpClient = func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			}
+			tt.opts.BaseRepo = func() (ghrepo.Interface, error) {
+				return ghrepo.New("OWNER", "REPO"), nil
+			}
+
+			io, _, stdout, stderr := iostreams.Test()
+			io.SetStdinTTY(tt.isTTY)
+			io.SetStdoutTTY(tt.isTTY)
+			tt.opts.IO = io
+
+			if tt.confirm {
+				defer prompt.StubConfirm(true)()
+			}
+
+			err := archiveRun(tt.opts)
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.wantStdout, stdout.String())
+			assert.Equal(t, tt.wantStderr, stderr.String())
+			reg.Verify(t)
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package autolink
+
+import (
+	autolinkCreateCmd "github.com/cli/cli/pkg/cmd/repo/autolink/create"
+	autolinkDeleteCmd "github.com/cli/cli/pkg/cmd/repo/autolink/delete"
+	autolinkListCmd "github.com/cli/cli/pkg/cmd/repo/autolink/list"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdAutolink(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "autolink <command>",
+		Short: "Manage autolink references for a repository",
+		Long:  "Autolinks turn external references like \"JIRA-123\" in issues, pull requests, and commits into links.",
+	}
+
+	cmdutil.EnableRepoOverride(cmd, f)
+
+	cmd.AddCommand(autolinkListCmd.NewCmdList(f, nil))
+	cmd.AddCommand(autolinkCreateCmd.NewCmdCreate(f, nil))
+	cmd.AddCommand(autolinkDeleteCmd.NewCmdDelete(f, nil))
+
+	return cmd
+}
@@ -0,0 +1,99 @@
+package create
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/cli/cli/utils"
+	"github.com/spf13/cobra"
+)
+
+type CreateOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	KeyPrefix   string
+	URLTemplate string
+}
+
+func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Command {
+	opts := &CreateOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "create <key-prefix> <url-template>",
+		Short: "Create a new autolink reference for a repository",
+		Long: heredoc.Doc(`
+			Create an autolink so that references like "<key-prefix><number>" link to an
+			external resource. The URL template must contain "<num>" as a placeholder
+			for the reference number.
+		`),
+		Example: heredoc.Doc(`
+			$ gh repo autolink create JIRA- "https://example.atlassian.net/browse/JIRA-<num>"
+		`),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+
+			opts.KeyPrefix = args[0]
+			opts.URLTemplate = args[1]
+
+			if !strings.Contains(opts.URLTemplate, "<num>") {
+				return &cmdutil.FlagError{Err: fmt.Errorf("URL template must contain the \"<num>\" placeholder")}
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return createRun(opts)
+		},
+	}
+
+	return cmd
+}
+
+func createRun(opts *CreateOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	body := &bytes.Buffer{}
+	err = json.NewEncoder(body).Encode(map[string]string{
+		"key_prefix":   opts.KeyPrefix,
+		"url_template": opts.URLTemplate,
+	})
+	if err != nil {
+		return err
+	}
+
+	apiClient := api.NewClientFromHTTP(httpClient)
+	path := fmt.Sprintf("repos/%s/autolinks", ghrepo.FullName(baseRepo))
+	err = apiClient.REST(baseRepo.RepoHost(), "POST", path, body, nil)
+	if err != nil {
+		return err
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		fmt.Fprintf(opts.IO.Out, "%s Autolink %q created in %s\n", utils.GreenCheck(), opts.KeyPrefix, ghrepo.FullName(baseRepo))
+	}
+
+	return nil
+}
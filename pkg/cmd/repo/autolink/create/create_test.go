@@ -0,0 +1,49 @@
+package create
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/httpmock"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_createRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	reg.Register(
+		httpmock.REST("POST", "repos/OWNER/REPO/autolinks"),
+		httpmock.StatusStringResponse(201, "{}"))
+
+	io, _, stdout, _ := iostreams.Test()
+	io.SetStdoutTTY(true)
+
+	opts := &CreateOptions{
+		KeyPrefix:   "JIRA-",
+		URLTemplate: "https://example.atlassian.net/browse/JIRA-<num>",
+		IO:          io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+	}
+
+	err := createRun(opts)
+	assert.NoError(t, err)
+
+	bodyBytes, _ := ioutil.ReadAll(reg.Requests[0].Body)
+	reqBody := map[string]string{}
+	err = json.Unmarshal(bodyBytes, &reqBody)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"key_prefix":   "JIRA-",
+		"url_template": "https://example.atlassian.net/browse/JIRA-<num>",
+	}, reqBody)
+	assert.Equal(t, "✓ Autolink \"JIRA-\" created in OWNER/REPO\n", stdout.String())
+	reg.Verify(t)
+}
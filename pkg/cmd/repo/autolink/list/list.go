@@ -0,0 +1,83 @@
+package list
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/cli/cli/utils"
+	"github.com/spf13/cobra"
+)
+
+type ListOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+}
+
+func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Command {
+	opts := &ListOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List autolink references for a repository",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return listRun(opts)
+		},
+	}
+
+	return cmd
+}
+
+type autolink struct {
+	ID          int    `json:"id"`
+	KeyPrefix   string `json:"key_prefix"`
+	URLTemplate string `json:"url_template"`
+}
+
+func listRun(opts *ListOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	apiClient := api.NewClientFromHTTP(httpClient)
+	var autolinks []autolink
+	path := fmt.Sprintf("repos/%s/autolinks", ghrepo.FullName(baseRepo))
+	err = apiClient.REST(baseRepo.RepoHost(), "GET", path, nil, &autolinks)
+	if err != nil {
+		return err
+	}
+
+	if len(autolinks) == 0 {
+		return fmt.Errorf("no autolinks found in %s", ghrepo.FullName(baseRepo))
+	}
+
+	table := utils.NewTablePrinter(opts.IO)
+	for _, a := range autolinks {
+		table.AddField(strconv.Itoa(a.ID), nil, nil)
+		table.AddField(a.KeyPrefix, nil, nil)
+		table.AddField(a.URLTemplate, nil, nil)
+		table.EndRow()
+	}
+	return table.Render()
+}
@@ -0,0 +1,68 @@
+package list
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/httpmock"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_listRun(t *testing.T) {
+	tests := []struct {
+		name      string
+		emptyList bool
+		wantOut   string
+		wantErr   string
+	}{
+		{
+			name:    "lists autolinks",
+			wantOut: "123\tJIRA-\thttps://example.atlassian.net/browse/JIRA-<num>\n",
+		},
+		{
+			name:      "no autolinks",
+			emptyList: true,
+			wantErr:   "no autolinks found in OWNER/REPO",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			if tt.emptyList {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/autolinks"),
+					httpmock.StringResponse(`[]`))
+			} else {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/autolinks"),
+					httpmock.StringResponse(`[
+						{"id": 123, "key_prefix": "JIRA-", "url_template": "https://example.atlassian.net/browse/JIRA-<num>"}
+					]`))
+			}
+
+			io, _, stdout, _ := iostreams.Test()
+
+			opts := &ListOptions{
+				IO: io,
+				HttpClient: func() (*http.Client, error) {
+					return &http.Client{Transport: reg}, nil
+				},
+				BaseRepo: func() (ghrepo.Interface, error) {
+					return ghrepo.New("OWNER", "REPO"), nil
+				},
+			}
+
+			err := listRun(opts)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantOut, stdout.String())
+			reg.Verify(t)
+		})
+	}
+}
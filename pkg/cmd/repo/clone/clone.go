@@ -3,6 +3,7 @@ package clone
 import (
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/MakeNowJust/heredoc"
@@ -25,6 +26,9 @@ type CloneOptions struct {
 	GitArgs    []string
 	Directory  string
 	Repository string
+	Depth      int
+	Filter     string
+	Sparse     bool
 }
 
 func NewCmdClone(f *cmdutil.Factory, runF func(*CloneOptions) error) *cobra.Command {
@@ -45,7 +49,11 @@ func NewCmdClone(f *cmdutil.Factory, runF func(*CloneOptions) error) *cobra.Comm
 
 			If the "OWNER/" portion of the "OWNER/REPO" repository argument is omitted, it
 			defaults to the name of the authenticating user.
-			
+
+			For cloning large repositories quickly, '--depth', '--filter', and '--sparse'
+			are passed through to git. The "clone_depth" and "clone_filter" configuration
+			values supply defaults for the first two.
+
 			Pass additional 'git clone' flags by listing them after '--'.
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -60,6 +68,10 @@ func NewCmdClone(f *cmdutil.Factory, runF func(*CloneOptions) error) *cobra.Comm
 		},
 	}
 
+	cmd.Flags().IntVar(&opts.Depth, "depth", 0, "Create a shallow clone truncated to the given number of commits")
+	cmd.Flags().StringVar(&opts.Filter, "filter", "", "Create a partial clone using the given filter `spec`, e.g. \"blob:none\"")
+	cmd.Flags().BoolVar(&opts.Sparse, "sparse", false, "Initialize the sparse-checkout file to include only top-level files")
+
 	cmd.SetFlagErrorFunc(func(cmd *cobra.Command, err error) error {
 		if err == pflag.ErrHelp {
 			return err
@@ -104,7 +116,7 @@ func cloneRun(opts *CloneOptions) error {
 	}
 
 	var repo ghrepo.Interface
-	var parentRepo ghrepo.Interface
+	var parentRepo *api.Repository
 
 	// TODO: consider caching and reusing `git.ParseSSHConfig().Translator()`
 	// here to handle hostname aliases in SSH remotes
@@ -119,7 +131,29 @@ func cloneRun(opts *CloneOptions) error {
 		}
 	}
 
-	cloneDir, err := git.RunClone(cloneURL, opts.GitArgs)
+	gitArgs := opts.GitArgs
+	if opts.Depth == 0 {
+		if v, _ := cfg.Get("", "clone_depth"); v != "" {
+			opts.Depth, err = strconv.Atoi(v)
+			if err != nil {
+				return fmt.Errorf("invalid clone_depth configuration value: %q", v)
+			}
+		}
+	}
+	if opts.Filter == "" {
+		opts.Filter, _ = cfg.Get("", "clone_filter")
+	}
+	if opts.Depth > 0 {
+		gitArgs = append(gitArgs, "--depth", strconv.Itoa(opts.Depth))
+	}
+	if opts.Filter != "" {
+		gitArgs = append(gitArgs, "--filter="+opts.Filter)
+	}
+	if opts.Sparse {
+		gitArgs = append(gitArgs, "--sparse")
+	}
+
+	cloneDir, err := git.RunClone(cloneURL, gitArgs)
 	if err != nil {
 		return err
 	}
@@ -135,6 +169,15 @@ func cloneRun(opts *CloneOptions) error {
 		if err != nil {
 			return err
 		}
+
+		// have `git pull` on the default branch merge from the parent
+		// repository out of the box
+		if branch := parentRepo.DefaultBranchRef.Name; branch != "" {
+			err = git.SetBranchUpstream(cloneDir, "upstream", branch)
+			if err != nil {
+				return err
+			}
+		}
 	}
 
 	return nil
@@ -5,6 +5,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/internal/config"
 	"github.com/cli/cli/pkg/cmdutil"
 	"github.com/cli/cli/pkg/httpmock"
@@ -15,6 +16,10 @@ import (
 )
 
 func runCloneCommand(httpClient *http.Client, cli string) (*test.CmdOut, error) {
+	return runCloneCommandWithConfig(httpClient, config.NewBlankConfig(), cli)
+}
+
+func runCloneCommandWithConfig(httpClient *http.Client, cfg config.Config, cli string) (*test.CmdOut, error) {
 	io, stdin, stdout, stderr := iostreams.Test()
 	fac := &cmdutil.Factory{
 		IOStreams: io,
@@ -22,7 +27,7 @@ func runCloneCommand(httpClient *http.Client, cli string) (*test.CmdOut, error)
 			return httpClient, nil
 		},
 		Config: func() (config.Config, error) {
-			return config.NewBlankConfig(), nil
+			return cfg, nil
 		},
 	}
 
@@ -74,6 +79,11 @@ func Test_RepoClone(t *testing.T) {
 			args: "OWNER/REPO target_directory -- -o upstream --depth 1",
 			want: "git clone -o upstream --depth 1 https://github.com/OWNER/REPO.git target_directory",
 		},
+		{
+			name: "partial clone flags",
+			args: "--depth 1 --filter blob:none --sparse OWNER/REPO",
+			want: "git clone --depth 1 --filter=blob:none --sparse https://github.com/OWNER/REPO.git",
+		},
 		{
 			name: "HTTPS URL",
 			args: "https://github.com/OWNER/REPO",
@@ -125,7 +135,8 @@ func Test_RepoClone_hasParent(t *testing.T) {
 		{ "data": { "repository": {
 			"parent": {
 				"owner": {"login": "hubot"},
-				"name": "ORIG"
+				"name": "ORIG",
+				"defaultBranchRef": {"name": "trunk"}
 			}
 		} } }
 		`))
@@ -137,14 +148,18 @@ func Test_RepoClone_hasParent(t *testing.T) {
 
 	cs.Stub("") // git clone
 	cs.Stub("") // git remote add
+	cs.Stub("") // git config branch.trunk.remote
+	cs.Stub("") // git config branch.trunk.merge
 
 	_, err := runCloneCommand(httpClient, "OWNER/REPO")
 	if err != nil {
 		t.Fatalf("error running command `repo clone`: %v", err)
 	}
 
-	assert.Equal(t, 2, cs.Count)
+	assert.Equal(t, 4, cs.Count)
 	assert.Equal(t, "git -C REPO remote add -f upstream https://github.com/hubot/ORIG.git", strings.Join(cs.Calls[1].Args, " "))
+	assert.Equal(t, "git -C REPO config branch.trunk.remote upstream", strings.Join(cs.Calls[2].Args, " "))
+	assert.Equal(t, "git -C REPO config branch.trunk.merge refs/heads/trunk", strings.Join(cs.Calls[3].Args, " "))
 }
 
 func Test_RepoClone_withoutUsername(t *testing.T) {
@@ -181,8 +196,40 @@ func Test_RepoClone_withoutUsername(t *testing.T) {
 }
 
 func Test_RepoClone_flagError(t *testing.T) {
-	_, err := runCloneCommand(nil, "--depth 1 OWNER/REPO")
-	if err == nil || err.Error() != "unknown flag: --depth\nSeparate git clone flags with '--'." {
+	_, err := runCloneCommand(nil, "--mirror OWNER/REPO")
+	if err == nil || err.Error() != "unknown flag: --mirror\nSeparate git clone flags with '--'." {
 		t.Errorf("unexpected error %v", err)
 	}
 }
+
+func Test_RepoClone_configDefaults(t *testing.T) {
+	reg := &httpmock.Registry{}
+	reg.Register(
+		httpmock.GraphQL(`query RepositoryFindParent\b`),
+		httpmock.StringResponse(`
+		{ "data": { "repository": {
+			"parent": null
+		} } }
+		`))
+
+	httpClient := &http.Client{Transport: reg}
+
+	cfg := config.NewFromString(heredoc.Doc(`
+		clone_depth: "50"
+		clone_filter: blob:none
+	`))
+
+	cs, restore := test.InitCmdStubber()
+	defer restore()
+
+	cs.Stub("") // git clone
+
+	_, err := runCloneCommandWithConfig(httpClient, cfg, "OWNER/REPO")
+	if err != nil {
+		t.Fatalf("error running command `repo clone`: %v", err)
+	}
+
+	assert.Equal(t, 1, cs.Count)
+	assert.Equal(t, "git clone --depth 50 --filter=blob:none https://github.com/OWNER/REPO.git", strings.Join(cs.Calls[0].Args, " "))
+	reg.Verify(t)
+}
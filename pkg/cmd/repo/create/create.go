@@ -27,17 +27,22 @@ type CreateOptions struct {
 	Config     func() (config.Config, error)
 	IO         *iostreams.IOStreams
 
-	Name          string
-	Description   string
-	Homepage      string
-	Team          string
-	Template      string
-	EnableIssues  bool
-	EnableWiki    bool
-	Public        bool
-	Private       bool
-	Internal      bool
-	ConfirmSubmit bool
+	Name               string
+	Description        string
+	Homepage           string
+	Team               string
+	Template           string
+	IncludeAllBranches bool
+	GitIgnoreTemplate  string
+	LicenseTemplate    string
+	AddReadme          bool
+	Clone              bool
+	EnableIssues       bool
+	EnableWiki         bool
+	Public             bool
+	Private            bool
+	Internal           bool
+	ConfirmSubmit      bool
 }
 
 func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Command {
@@ -91,6 +96,14 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 				return &cmdutil.FlagError{Err: errors.New(`The '--template' option is not supported with '--homepage, --team, --enable-issues or --enable-wiki'`)}
 			}
 
+			if opts.Template != "" && (opts.GitIgnoreTemplate != "" || opts.LicenseTemplate != "" || opts.AddReadme) {
+				return &cmdutil.FlagError{Err: errors.New(`The '--template' option is not supported with '--gitignore, --license or --add-readme'`)}
+			}
+
+			if opts.IncludeAllBranches && opts.Template == "" {
+				return &cmdutil.FlagError{Err: errors.New(`The '--include-all-branches' option is only supported when using '--template'`)}
+			}
+
 			return createRun(opts)
 		},
 	}
@@ -99,6 +112,11 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 	cmd.Flags().StringVarP(&opts.Homepage, "homepage", "h", "", "Repository home page URL")
 	cmd.Flags().StringVarP(&opts.Team, "team", "t", "", "The name of the organization team to be granted access")
 	cmd.Flags().StringVarP(&opts.Template, "template", "p", "", "Make the new repository based on a template repository")
+	cmd.Flags().BoolVar(&opts.IncludeAllBranches, "include-all-branches", false, "Include all branches from template repository")
+	cmd.Flags().StringVarP(&opts.GitIgnoreTemplate, "gitignore", "g", "", "Specify a gitignore template for the repository")
+	cmd.Flags().StringVarP(&opts.LicenseTemplate, "license", "l", "", "Specify an Open Source License for the repository")
+	cmd.Flags().BoolVar(&opts.AddReadme, "add-readme", false, "Add a README file to the new repository")
+	cmd.Flags().BoolVarP(&opts.Clone, "clone", "c", false, "Clone the new repository to the current directory")
 	cmd.Flags().BoolVar(&opts.EnableIssues, "enable-issues", true, "Enable issues in the new repository")
 	cmd.Flags().BoolVar(&opts.EnableWiki, "enable-wiki", true, "Enable wiki in the new repository")
 	cmd.Flags().BoolVar(&opts.Public, "public", false, "Make the new repository public")
@@ -172,6 +190,15 @@ func createRun(opts *CreateOptions) error {
 		if newVisibility != "" {
 			visibility = newVisibility
 		}
+
+		httpClient, err := opts.HttpClient()
+		if err != nil {
+			return err
+		}
+		err = interactiveRepoInit(api.NewClientFromHTTP(httpClient), repoToCreate.RepoHost(), opts)
+		if err != nil {
+			return err
+		}
 	} else {
 		// Go for a prompt only if visibility isn't passed
 		if !isVisibilityPassed {
@@ -215,14 +242,19 @@ func createRun(opts *CreateOptions) error {
 	}
 
 	input := repoCreateInput{
-		Name:             repoToCreate.RepoName(),
-		Visibility:       visibility,
-		OwnerID:          repoToCreate.RepoOwner(),
-		TeamID:           opts.Team,
-		Description:      opts.Description,
-		HomepageURL:      opts.Homepage,
-		HasIssuesEnabled: opts.EnableIssues,
-		HasWikiEnabled:   opts.EnableWiki,
+		Name:              repoToCreate.RepoName(),
+		Visibility:        visibility,
+		OwnerID:           repoToCreate.RepoOwner(),
+		TeamID:            opts.Team,
+		Description:       opts.Description,
+		HomepageURL:       opts.Homepage,
+		HasIssuesEnabled:  opts.EnableIssues,
+		HasWikiEnabled:    opts.EnableWiki,
+		GitIgnoreTemplate: opts.GitIgnoreTemplate,
+		LicenseTemplate:   opts.LicenseTemplate,
+		AutoInit:          opts.AddReadme,
+
+		IncludeAllBranches: opts.IncludeAllBranches,
 	}
 
 	httpClient, err := opts.HttpClient()
@@ -266,6 +298,10 @@ func createRun(opts *CreateOptions) error {
 		}
 		remoteURL := ghrepo.FormatRemoteURL(repo, protocol)
 
+		// a repository seeded with a README, gitignore, or license has
+		// content worth cloning right away
+		initialized := opts.AddReadme || opts.GitIgnoreTemplate != "" || opts.LicenseTemplate != ""
+
 		if projectDirErr == nil {
 			_, err = git.AddRemote("origin", remoteURL)
 			if err != nil {
@@ -274,6 +310,24 @@ func createRun(opts *CreateOptions) error {
 			if isTTY {
 				fmt.Fprintf(stderr, "%s Added remote %s\n", greenCheck, remoteURL)
 			}
+		} else if opts.Clone || (initialized && opts.IO.CanPrompt()) {
+			doClone := opts.Clone
+			if !doClone {
+				err := prompt.Confirm(fmt.Sprintf("Clone %s locally?", ghrepo.FullName(repo)), &doClone)
+				if err != nil {
+					return err
+				}
+			}
+			if doClone {
+				gitClone := git.GitCommand("clone", remoteURL)
+				gitClone.Stdout = stdout
+				gitClone.Stderr = stderr
+				err = run.PrepareCmd(gitClone).Run()
+				if err != nil {
+					return err
+				}
+				fmt.Fprintf(stderr, "%s Cloned repository into './%s/'\n", greenCheck, repo.Name)
+			}
 		} else if opts.IO.CanPrompt() {
 			doSetup := createLocalDirectory
 			if !doSetup {
@@ -359,6 +413,69 @@ func interactiveRepoCreate(isDescEmpty bool, isVisibilityPassed bool, repoName s
 
 }
 
+// interactiveRepoInit walks through the repository initialization choices: a
+// .gitignore template, an open source license, and whether to seed the new
+// repository with a README. Choices already made with flags are not asked
+// again.
+func interactiveRepoInit(client *api.Client, hostname string, opts *CreateOptions) error {
+	if opts.GitIgnoreTemplate == "" {
+		var addGitIgnore bool
+		err := prompt.Confirm("Would you like to add a .gitignore?", &addGitIgnore)
+		if err != nil {
+			return err
+		}
+		if addGitIgnore {
+			templates, err := listGitIgnoreTemplates(client, hostname)
+			if err != nil {
+				return err
+			}
+			err = prompt.SurveyAskOne(&survey.Select{
+				Message: "Choose a .gitignore template",
+				Options: templates,
+			}, &opts.GitIgnoreTemplate)
+			if err != nil {
+				return fmt.Errorf("could not prompt: %w", err)
+			}
+		}
+	}
+
+	if opts.LicenseTemplate == "" {
+		var addLicense bool
+		err := prompt.Confirm("Would you like to add a license?", &addLicense)
+		if err != nil {
+			return err
+		}
+		if addLicense {
+			licenses, err := listLicenseTemplates(client, hostname)
+			if err != nil {
+				return err
+			}
+			licenseNames := make([]string, 0, len(licenses))
+			for _, l := range licenses {
+				licenseNames = append(licenseNames, l.Name)
+			}
+			var selected int
+			err = prompt.SurveyAskOne(&survey.Select{
+				Message: "Choose a license",
+				Options: licenseNames,
+			}, &selected)
+			if err != nil {
+				return fmt.Errorf("could not prompt: %w", err)
+			}
+			opts.LicenseTemplate = licenses[selected].Key
+		}
+	}
+
+	if !opts.AddReadme {
+		err := prompt.Confirm("Would you like to add a README file?", &opts.AddReadme)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func confirmSubmission(repoName string, repoOwner string, isConfirmFlagPassed *bool) (bool, error) {
 	qs := []*survey.Question{}
 
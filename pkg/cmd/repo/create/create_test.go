@@ -396,3 +396,140 @@ func TestRepoCreate_template(t *testing.T) {
 		t.Errorf("expected %q, got %q", "OWNERID", ownerId)
 	}
 }
+
+func TestRepoCreate_templateIncludeAllBranches(t *testing.T) {
+	reg := &httpmock.Registry{}
+	reg.Register(
+		httpmock.GraphQL(`mutation CloneTemplateRepository\b`),
+		httpmock.StringResponse(`
+		{ "data": { "cloneTemplateRepository": {
+			"repository": {
+				"id": "REPOID",
+				"name": "REPO",
+				"owner": {
+					"login": "OWNER"
+				},
+				"url": "https://github.com/OWNER/REPO"
+			}
+		} } }`))
+
+	reg.Register(
+		httpmock.GraphQL(`query RepositoryInfo\b`),
+		httpmock.StringResponse(`
+	{ "data": {
+		"repository": {
+			"id": "REPOID",
+			"description": "DESCRIPTION"
+	} } }`))
+
+	reg.Register(
+		httpmock.GraphQL(`query UserCurrent\b`),
+		httpmock.StringResponse(`{"data":{"viewer":{"ID":"OWNERID"}}}`))
+
+	httpClient := &http.Client{Transport: reg}
+
+	restoreCmd := run.SetPrepareCmd(func(cmd *exec.Cmd) run.Runnable {
+		return &test.OutputStub{}
+	})
+	defer restoreCmd()
+
+	as, surveyTearDown := prompt.InitAskStubber()
+	defer surveyTearDown()
+
+	as.Stub([]*prompt.QuestionStub{
+		{
+			Name:  "confirmSubmit",
+			Value: true,
+		},
+	})
+
+	_, err := runCommand(httpClient, "REPO --private --template='OWNER/REPO' --include-all-branches")
+	if err != nil {
+		t.Errorf("error running command `repo create`: %v", err)
+	}
+
+	var reqBody struct {
+		Query     string
+		Variables struct {
+			Input map[string]interface{}
+		}
+	}
+
+	if len(reg.Requests) != 3 {
+		t.Fatalf("expected 3 HTTP requests, got %d", len(reg.Requests))
+	}
+
+	bodyBytes, _ := ioutil.ReadAll(reg.Requests[2].Body)
+	_ = json.Unmarshal(bodyBytes, &reqBody)
+	if includeAllBranches := reqBody.Variables.Input["includeAllBranches"].(bool); !includeAllBranches {
+		t.Errorf("expected includeAllBranches to be true")
+	}
+}
+
+func TestRepoCreate_includeAllBranchesWithoutTemplate(t *testing.T) {
+	httpClient := &http.Client{}
+
+	_, err := runCommand(httpClient, "REPO --private --include-all-branches")
+	if err == nil {
+		t.Fatal("expected an error, got none")
+	}
+	assert.Equal(t, `The '--include-all-branches' option is only supported when using '--template'`, err.Error())
+}
+
+func TestRepoCreate_withInitOptions(t *testing.T) {
+	reg := &httpmock.Registry{}
+	reg.Register(
+		httpmock.REST("POST", "user/repos"),
+		httpmock.StringResponse(`
+		{
+			"node_id": "REPOID",
+			"name": "REPO",
+			"html_url": "https://github.com/OWNER/REPO",
+			"owner": {
+				"login": "OWNER"
+			}
+		}`))
+
+	httpClient := &http.Client{Transport: reg}
+
+	var seenCmd *exec.Cmd
+	restoreCmd := run.SetPrepareCmd(func(cmd *exec.Cmd) run.Runnable {
+		seenCmd = cmd
+		return &test.OutputStub{}
+	})
+	defer restoreCmd()
+
+	output, err := runCommand(httpClient, "REPO --private --confirm --add-readme --gitignore Go --license mit")
+	if err != nil {
+		t.Errorf("error running command `repo create`: %v", err)
+	}
+
+	assert.Equal(t, "", output.String())
+	assert.Equal(t, "✓ Created repository OWNER/REPO on GitHub\n✓ Added remote https://github.com/OWNER/REPO.git\n", output.Stderr())
+
+	if seenCmd == nil {
+		t.Fatal("expected a command to run")
+	}
+
+	if len(reg.Requests) != 1 {
+		t.Fatalf("expected 1 HTTP request, got %d", len(reg.Requests))
+	}
+
+	var reqBody map[string]interface{}
+	bodyBytes, _ := ioutil.ReadAll(reg.Requests[0].Body)
+	_ = json.Unmarshal(bodyBytes, &reqBody)
+	assert.Equal(t, "REPO", reqBody["name"])
+	assert.Equal(t, true, reqBody["private"])
+	assert.Equal(t, true, reqBody["auto_init"])
+	assert.Equal(t, "Go", reqBody["gitignore_template"])
+	assert.Equal(t, "mit", reqBody["license_template"])
+}
+
+func TestRepoCreate_templateWithInitOptions(t *testing.T) {
+	httpClient := &http.Client{Transport: &httpmock.Registry{}}
+
+	_, err := runCommand(httpClient, "REPO --template OWNER/TEMPLATE --add-readme")
+	if err == nil || err.Error() != `The '--template' option is not supported with '--gitignore, --license or --add-readme'` {
+		t.Fatalf("got error: %v", err)
+	}
+}
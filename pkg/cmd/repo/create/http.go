@@ -1,6 +1,8 @@
 package create
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"net/http"
 
@@ -19,6 +21,31 @@ type repoCreateInput struct {
 
 	HasIssuesEnabled bool `json:"hasIssuesEnabled"`
 	HasWikiEnabled   bool `json:"hasWikiEnabled"`
+
+	// initialization options only exist in the v3 API, so they are not
+	// part of the GraphQL mutation input
+	GitIgnoreTemplate string `json:"-"`
+	LicenseTemplate   string `json:"-"`
+	AutoInit          bool   `json:"-"`
+
+	// only applies when generating from a template repository
+	IncludeAllBranches bool `json:"-"`
+}
+
+// repoCreateRESTInput is the v3 API equivalent of repoCreateInput, used when
+// the repository needs to be seeded with a README, gitignore, or license
+type repoCreateRESTInput struct {
+	Name              string `json:"name"`
+	Description       string `json:"description,omitempty"`
+	Homepage          string `json:"homepage,omitempty"`
+	Private           bool   `json:"private"`
+	Visibility        string `json:"visibility,omitempty"`
+	TeamID            int    `json:"team_id,omitempty"`
+	HasIssues         bool   `json:"has_issues"`
+	HasWiki           bool   `json:"has_wiki"`
+	GitIgnoreTemplate string `json:"gitignore_template,omitempty"`
+	LicenseTemplate   string `json:"license_template,omitempty"`
+	AutoInit          bool   `json:"auto_init"`
 }
 
 type repoTemplateInput struct {
@@ -26,14 +53,19 @@ type repoTemplateInput struct {
 	Visibility string `json:"visibility"`
 	OwnerID    string `json:"ownerId,omitempty"`
 
-	RepositoryID string `json:"repositoryId,omitempty"`
-	Description  string `json:"description,omitempty"`
+	RepositoryID       string `json:"repositoryId,omitempty"`
+	Description        string `json:"description,omitempty"`
+	IncludeAllBranches bool   `json:"includeAllBranches,omitempty"`
 }
 
 // repoCreate creates a new GitHub repository
 func repoCreate(client *http.Client, hostname string, input repoCreateInput, templateRepositoryID string) (*api.Repository, error) {
 	apiClient := api.NewClientFromHTTP(client)
 
+	if input.GitIgnoreTemplate != "" || input.LicenseTemplate != "" || input.AutoInit {
+		return repoCreateREST(apiClient, hostname, input)
+	}
+
 	if input.TeamID != "" {
 		orgID, teamID, err := resolveOrganizationTeam(apiClient, hostname, input.OwnerID, input.TeamID)
 		if err != nil {
@@ -65,10 +97,11 @@ func repoCreate(client *http.Client, hostname string, input repoCreateInput, tem
 		}
 
 		templateInput := repoTemplateInput{
-			Name:         input.Name,
-			Visibility:   input.Visibility,
-			OwnerID:      input.OwnerID,
-			RepositoryID: templateRepositoryID,
+			Name:               input.Name,
+			Visibility:         input.Visibility,
+			OwnerID:            input.OwnerID,
+			RepositoryID:       templateRepositoryID,
+			IncludeAllBranches: input.IncludeAllBranches,
 		}
 
 		variables := map[string]interface{}{
@@ -123,6 +156,94 @@ func repoCreate(client *http.Client, hostname string, input repoCreateInput, tem
 	return api.InitRepoHostname(&response.CreateRepository.Repository, hostname), nil
 }
 
+// repoCreateREST creates the repository via the v3 API, which, unlike the
+// GraphQL mutation, can initialize it with a README, gitignore, and license
+func repoCreateREST(apiClient *api.Client, hostname string, input repoCreateInput) (*api.Repository, error) {
+	path := "user/repos"
+	if input.OwnerID != "" {
+		path = fmt.Sprintf("orgs/%s/repos", input.OwnerID)
+	}
+
+	restInput := repoCreateRESTInput{
+		Name:              input.Name,
+		Description:       input.Description,
+		Homepage:          input.HomepageURL,
+		Private:           input.Visibility == "PRIVATE",
+		HasIssues:         input.HasIssuesEnabled,
+		HasWiki:           input.HasWikiEnabled,
+		GitIgnoreTemplate: input.GitIgnoreTemplate,
+		LicenseTemplate:   input.LicenseTemplate,
+		AutoInit:          input.AutoInit,
+	}
+	if input.Visibility == "INTERNAL" {
+		restInput.Visibility = "internal"
+	}
+
+	if input.TeamID != "" {
+		teamID, err := resolveTeamNumericID(apiClient, hostname, input.OwnerID, input.TeamID)
+		if err != nil {
+			return nil, err
+		}
+		restInput.TeamID = teamID
+	}
+
+	requestBody, err := json.Marshal(restInput)
+	if err != nil {
+		return nil, err
+	}
+
+	var result struct {
+		NodeID  string `json:"node_id"`
+		Name    string `json:"name"`
+		HTMLURL string `json:"html_url"`
+		Owner   struct {
+			Login string `json:"login"`
+		} `json:"owner"`
+	}
+	err = apiClient.REST(hostname, "POST", path, bytes.NewReader(requestBody), &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.InitRepoHostname(&api.Repository{
+		ID:    result.NodeID,
+		Name:  result.Name,
+		URL:   result.HTMLURL,
+		Owner: api.RepositoryOwner{Login: result.Owner.Login},
+	}, hostname), nil
+}
+
+// listGitIgnoreTemplates returns the gitignore templates available to seed a
+// new repository with
+func listGitIgnoreTemplates(client *api.Client, hostname string) ([]string, error) {
+	var templates []string
+	err := client.REST(hostname, "GET", "gitignore/templates", nil, &templates)
+	return templates, err
+}
+
+type licenseTemplate struct {
+	Key  string `json:"key"`
+	Name string `json:"name"`
+}
+
+// listLicenseTemplates returns the open source licenses available to seed a
+// new repository with
+func listLicenseTemplates(client *api.Client, hostname string) ([]licenseTemplate, error) {
+	var licenses []licenseTemplate
+	err := client.REST(hostname, "GET", "licenses", nil, &licenses)
+	return licenses, err
+}
+
+// the repository creation endpoint wants the team's numeric ID, not the node
+// ID that the GraphQL mutation takes
+func resolveTeamNumericID(client *api.Client, hostname, orgName, teamSlug string) (int, error) {
+	var response struct {
+		ID int `json:"id"`
+	}
+	err := client.REST(hostname, "GET", fmt.Sprintf("orgs/%s/teams/%s", orgName, teamSlug), nil, &response)
+	return response.ID, err
+}
+
 // using API v3 here because the equivalent in GraphQL needs `read:org` scope
 func resolveOrganization(client *api.Client, hostname, orgName string) (string, error) {
 	var response struct {
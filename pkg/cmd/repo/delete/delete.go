@@ -0,0 +1,131 @@
+package delete
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/internal/ghinstance"
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/cli/cli/pkg/prompt"
+	"github.com/cli/cli/utils"
+	"github.com/spf13/cobra"
+)
+
+type DeleteOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	RepoArg   string
+	Confirmed bool
+}
+
+func NewCmdDelete(f *cmdutil.Factory, runF func(*DeleteOptions) error) *cobra.Command {
+	opts := &DeleteOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		BaseRepo:   f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "delete [<repository>]",
+		Short: "Delete a repository",
+		Long: heredoc.Doc(`
+			Delete a GitHub repository.
+
+			With no argument, deletes the repository for the current directory.
+
+			Deletion requires authorization with the "delete_repo" scope. To authorize, run
+			"gh auth refresh -s delete_repo".
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.RepoArg = args[0]
+			}
+
+			if !opts.IO.CanPrompt() && !opts.Confirmed {
+				return &cmdutil.FlagError{Err: fmt.Errorf("could not prompt: confirmation with prompt or --yes flag required")}
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return deleteRun(opts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.Confirmed, "yes", false, "Skip the confirmation prompt")
+
+	return cmd
+}
+
+func deleteRun(opts *DeleteOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	var toDelete ghrepo.Interface
+	if opts.RepoArg == "" {
+		toDelete, err = opts.BaseRepo()
+		if err != nil {
+			return err
+		}
+	} else {
+		repoSelector := opts.RepoArg
+		if !strings.Contains(repoSelector, "/") {
+			currentUser, err := api.CurrentLoginName(apiClient, ghinstance.Default())
+			if err != nil {
+				return err
+			}
+			repoSelector = currentUser + "/" + repoSelector
+		}
+		toDelete, err = ghrepo.FromFullName(repoSelector)
+		if err != nil {
+			return fmt.Errorf("argument error: %w", err)
+		}
+	}
+
+	fullName := ghrepo.FullName(toDelete)
+
+	if !opts.Confirmed {
+		var valid string
+		err := prompt.SurveyAskOne(
+			&survey.Input{Message: fmt.Sprintf("Type %s to confirm deletion:", fullName)},
+			&valid,
+			survey.WithValidator(
+				func(val interface{}) error {
+					if str := val.(string); !strings.EqualFold(str, fullName) {
+						return fmt.Errorf("You entered %s", str)
+					}
+					return nil
+				}))
+		if err != nil {
+			return fmt.Errorf("could not prompt: %w", err)
+		}
+	}
+
+	err = deleteRepo(httpClient, toDelete)
+	if err != nil {
+		var httpErr api.HTTPError
+		if errors.As(err, &httpErr) && httpErr.StatusCode == 403 {
+			return fmt.Errorf("you do not have the required \"delete_repo\" scope; to authorize, run: gh auth refresh -s delete_repo")
+		}
+		return err
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		fmt.Fprintf(opts.IO.Out, "%s Deleted repository %s\n", utils.GreenCheck(), fullName)
+	}
+
+	return nil
+}
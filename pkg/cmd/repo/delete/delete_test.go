@@ -0,0 +1,123 @@
+package delete
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/httpmock"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/cli/cli/pkg/prompt"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_deleteRun(t *testing.T) {
+	tests := []struct {
+		name       string
+		opts       *DeleteOptions
+		httpStubs  func(*httpmock.Registry)
+		askStubs   func(*prompt.AskStubber)
+		isTTY      bool
+		wantStdout string
+		wantErr    string
+	}{
+		{
+			name:  "confirming deletion interactively",
+			opts:  &DeleteOptions{RepoArg: "OWNER/REPO"},
+			isTTY: true,
+			askStubs: func(as *prompt.AskStubber) {
+				as.StubOne("OWNER/REPO")
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("DELETE", "repos/OWNER/REPO"),
+					httpmock.StatusStringResponse(204, "{}"))
+			},
+			wantStdout: "✓ Deleted repository OWNER/REPO\n",
+		},
+		{
+			name: "skipping confirmation with --yes",
+			opts: &DeleteOptions{RepoArg: "OWNER/REPO", Confirmed: true},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("DELETE", "repos/OWNER/REPO"),
+					httpmock.StatusStringResponse(204, "{}"))
+			},
+		},
+		{
+			name: "repo argument without owner",
+			opts: &DeleteOptions{RepoArg: "REPO", Confirmed: true},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query UserCurrent\b`),
+					httpmock.StringResponse(`{"data":{"viewer":{"login":"OWNER"}}}`))
+				reg.Register(
+					httpmock.REST("DELETE", "repos/OWNER/REPO"),
+					httpmock.StatusStringResponse(204, "{}"))
+			},
+		},
+		{
+			name: "missing delete_repo scope",
+			opts: &DeleteOptions{RepoArg: "OWNER/REPO", Confirmed: true},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("DELETE", "repos/OWNER/REPO"),
+					httpmock.StatusStringResponse(403, `{"message":"Must have admin rights to Repository."}`))
+			},
+			wantErr: `you do not have the required "delete_repo" scope; to authorize, run: gh auth refresh -s delete_repo`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			if tt.httpStubs != nil {
+				tt.httpStubs(reg)
+			}
+			tt.opts.HttpClient = func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			}
+			tt.opts.BaseRepo = func() (ghrepo.Interface, error) {
+				return ghrepo.New("OWNER", "REPO"), nil
+			}
+
+			io, _, stdout, _ := iostreams.Test()
+			io.SetStdinTTY(tt.isTTY)
+			io.SetStdoutTTY(tt.isTTY)
+			tt.opts.IO = io
+
+			as, teardown := prompt.InitAskStubber()
+			defer teardown()
+			if tt.askStubs != nil {
+				tt.askStubs(as)
+			}
+
+			err := deleteRun(tt.opts)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStdout, stdout.String())
+			reg.Verify(t)
+		})
+	}
+}
+
+func TestNewCmdDelete_nonInteractive(t *testing.T) {
+	io, _, _, _ := iostreams.Test()
+	io.SetStdinTTY(false)
+	io.SetStdoutTTY(false)
+
+	cmd := NewCmdDelete(&cmdutil.Factory{IOStreams: io}, func(opts *DeleteOptions) error {
+		return nil
+	})
+	cmd.SetArgs([]string{"OWNER/REPO"})
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	_, err := cmd.ExecuteC()
+	assert.EqualError(t, err, "could not prompt: confirmation with prompt or --yes flag required")
+}
@@ -0,0 +1,14 @@
+package delete
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/internal/ghrepo"
+)
+
+func deleteRepo(client *http.Client, repo ghrepo.Interface) error {
+	apiClient := api.NewClientFromHTTP(client)
+	return apiClient.REST(repo.RepoHost(), "DELETE", fmt.Sprintf("repos/%s", ghrepo.FullName(repo)), nil, nil)
+}
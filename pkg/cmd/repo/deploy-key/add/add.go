@@ -0,0 +1,86 @@
+package add
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/cli/cli/utils"
+	"github.com/spf13/cobra"
+)
+
+type AddOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	KeyFile    string
+	Title      string
+	AllowWrite bool
+}
+
+func NewCmdAdd(f *cmdutil.Factory, runF func(*AddOptions) error) *cobra.Command {
+	opts := &AddOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "add <key-file>",
+		Short: "Add a deploy key to a repository",
+		Long: heredoc.Doc(`
+			Add a deploy key to a repository.
+
+			Deploy keys grant access to a single repository and are read-only unless
+			'--allow-write' is given. Pass "-" to read the public key from standard input.
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+			opts.KeyFile = args[0]
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return addRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Title, "title", "t", "", "Title of the new key")
+	cmd.Flags().BoolVarP(&opts.AllowWrite, "allow-write", "w", false, "Allow write access for the key")
+
+	return cmd
+}
+
+func addRun(opts *AddOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	keyBytes, err := cmdutil.ReadFile(opts.KeyFile, opts.IO.In)
+	if err != nil {
+		return err
+	}
+
+	err = uploadDeployKey(httpClient, baseRepo, opts.Title, strings.TrimSpace(string(keyBytes)), !opts.AllowWrite)
+	if err != nil {
+		return err
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		fmt.Fprintf(opts.IO.Out, "%s Deploy key added to %s\n", utils.GreenCheck(), ghrepo.FullName(baseRepo))
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package add
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/httpmock"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_addRun(t *testing.T) {
+	keyFile, err := ioutil.TempFile("", "deploy-key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(keyFile.Name())
+	fmt.Fprint(keyFile, "ssh-ed25519 AAAAC3 test@example.com\n")
+	keyFile.Close()
+
+	tests := []struct {
+		name     string
+		opts     *AddOptions
+		wantBody map[string]interface{}
+	}{
+		{
+			name: "add read-only key",
+			opts: &AddOptions{KeyFile: keyFile.Name(), Title: "CI key"},
+			wantBody: map[string]interface{}{
+				"title":     "CI key",
+				"key":       "ssh-ed25519 AAAAC3 test@example.com",
+				"read_only": true,
+			},
+		},
+		{
+			name: "add key with write access",
+			opts: &AddOptions{KeyFile: keyFile.Name(), AllowWrite: true},
+			wantBody: map[string]interface{}{
+				"title":     "",
+				"key":       "ssh-ed25519 AAAAC3 test@example.com",
+				"read_only": false,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			reg.Register(
+				httpmock.REST("POST", "repos/OWNER/REPO/keys"),
+				httpmock.StringResponse(`{}`))
+
+			io, _, stdout, _ := iostreams.Test()
+			io.SetStdoutTTY(true)
+			tt.opts.IO = io
+			tt.opts.HttpClient = func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			}
+			tt.opts.BaseRepo = func() (ghrepo.Interface, error) {
+				return ghrepo.New("OWNER", "REPO"), nil
+			}
+
+			err := addRun(tt.opts)
+			assert.NoError(t, err)
+
+			bodyBytes, _ := ioutil.ReadAll(reg.Requests[0].Body)
+			reqBody := map[string]interface{}{}
+			err = json.Unmarshal(bodyBytes, &reqBody)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantBody, reqBody)
+			assert.Equal(t, "✓ Deploy key added to OWNER/REPO\n", stdout.String())
+			reg.Verify(t)
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package add
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/internal/ghrepo"
+)
+
+func uploadDeployKey(httpClient *http.Client, repo ghrepo.Interface, title, key string, readOnly bool) error {
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	payload := map[string]interface{}{
+		"title":     title,
+		"key":       key,
+		"read_only": readOnly,
+	}
+	body := &bytes.Buffer{}
+	if err := json.NewEncoder(body).Encode(payload); err != nil {
+		return err
+	}
+
+	return apiClient.REST(repo.RepoHost(), "POST", fmt.Sprintf("repos/%s/keys", ghrepo.FullName(repo)), body, nil)
+}
@@ -0,0 +1,38 @@
+package delete
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/httpmock"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_deleteRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	reg.Register(
+		httpmock.REST("DELETE", "repos/OWNER/REPO/keys/1234"),
+		httpmock.StatusStringResponse(204, "{}"))
+
+	io, _, stdout, _ := iostreams.Test()
+	io.SetStdoutTTY(true)
+
+	opts := &DeleteOptions{
+		KeyID: "1234",
+		IO:    io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+	}
+
+	err := deleteRun(opts)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "✓ Deploy key deleted from OWNER/REPO\n", stdout.String())
+	reg.Verify(t)
+}
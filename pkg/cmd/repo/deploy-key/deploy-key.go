@@ -0,0 +1,24 @@
+package deploykey
+
+import (
+	cmdAdd "github.com/cli/cli/pkg/cmd/repo/deploy-key/add"
+	cmdDelete "github.com/cli/cli/pkg/cmd/repo/deploy-key/delete"
+	cmdList "github.com/cli/cli/pkg/cmd/repo/deploy-key/list"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdDeployKey(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deploy-key <command>",
+		Short: "Manage deploy keys in a repository",
+	}
+
+	cmdutil.EnableRepoOverride(cmd, f)
+
+	cmd.AddCommand(cmdAdd.NewCmdAdd(f, nil))
+	cmd.AddCommand(cmdDelete.NewCmdDelete(f, nil))
+	cmd.AddCommand(cmdList.NewCmdList(f, nil))
+
+	return cmd
+}
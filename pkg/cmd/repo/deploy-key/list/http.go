@@ -0,0 +1,29 @@
+package list
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/internal/ghrepo"
+)
+
+type deployKey struct {
+	ID        int       `json:"id"`
+	Key       string    `json:"key"`
+	Title     string    `json:"title"`
+	CreatedAt time.Time `json:"created_at"`
+	ReadOnly  bool      `json:"read_only"`
+}
+
+func repoKeys(httpClient *http.Client, repo ghrepo.Interface) ([]deployKey, error) {
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	var keys []deployKey
+	err := apiClient.REST(repo.RepoHost(), "GET", fmt.Sprintf("repos/%s/keys", ghrepo.FullName(repo)), nil, &keys)
+	if err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
@@ -0,0 +1,82 @@
+package list
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/cli/cli/utils"
+	"github.com/spf13/cobra"
+)
+
+type ListOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+}
+
+func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Command {
+	opts := &ListOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List deploy keys in a repository",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return listRun(opts)
+		},
+	}
+
+	return cmd
+}
+
+func listRun(opts *ListOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	deployKeys, err := repoKeys(httpClient, baseRepo)
+	if err != nil {
+		return err
+	}
+
+	if len(deployKeys) == 0 {
+		return fmt.Errorf("no deploy keys found in %s", ghrepo.FullName(baseRepo))
+	}
+
+	table := utils.NewTablePrinter(opts.IO)
+	for _, deployKey := range deployKeys {
+		table.AddField(fmt.Sprintf("%d", deployKey.ID), nil, nil)
+		table.AddField(deployKey.Title, nil, nil)
+		access := "read-only"
+		if !deployKey.ReadOnly {
+			access = "read-write"
+		}
+		table.AddField(access, nil, utils.Gray)
+		if table.IsTTY() {
+			table.AddField(utils.FuzzyAgo(time.Since(deployKey.CreatedAt)), nil, utils.Gray)
+		} else {
+			table.AddField(deployKey.CreatedAt.Format(time.RFC3339), nil, nil)
+		}
+		table.EndRow()
+	}
+	return table.Render()
+}
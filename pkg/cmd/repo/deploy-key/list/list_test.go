@@ -0,0 +1,78 @@
+package list
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/httpmock"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_listRun(t *testing.T) {
+	createdAt := time.Now().Add(-24 * time.Hour)
+	tests := []struct {
+		name      string
+		isTTY     bool
+		wantOut   string
+		wantErr   string
+		emptyList bool
+	}{
+		{
+			name:    "tty",
+			isTTY:   true,
+			wantOut: "123  CI key    read-only   about 1 day ago\n456  Prod key  read-write  about 1 day ago\n",
+		},
+		{
+			name:    "nontty",
+			wantOut: "123\tCI key\tread-only\t" + createdAt.Format(time.RFC3339) + "\n456\tProd key\tread-write\t" + createdAt.Format(time.RFC3339) + "\n",
+		},
+		{
+			name:      "no keys",
+			emptyList: true,
+			wantErr:   "no deploy keys found in OWNER/REPO",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			if tt.emptyList {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/keys"),
+					httpmock.StringResponse(`[]`))
+			} else {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/keys"),
+					httpmock.StringResponse(`[
+						{"id": 123, "title": "CI key", "read_only": true, "created_at": "`+createdAt.Format(time.RFC3339)+`"},
+						{"id": 456, "title": "Prod key", "read_only": false, "created_at": "`+createdAt.Format(time.RFC3339)+`"}
+					]`))
+			}
+
+			io, _, stdout, _ := iostreams.Test()
+			io.SetStdoutTTY(tt.isTTY)
+
+			opts := &ListOptions{
+				IO: io,
+				HttpClient: func() (*http.Client, error) {
+					return &http.Client{Transport: reg}, nil
+				},
+				BaseRepo: func() (ghrepo.Interface, error) {
+					return ghrepo.New("OWNER", "REPO"), nil
+				},
+			}
+
+			err := listRun(opts)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantOut, stdout.String())
+			reg.Verify(t)
+		})
+	}
+}
@@ -0,0 +1,397 @@
+package edit
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/cli/cli/pkg/prompt"
+	"github.com/cli/cli/utils"
+	"github.com/spf13/cobra"
+)
+
+// EditRepositoryInput is the payload for updating repository settings over REST
+type EditRepositoryInput struct {
+	Description         *string `json:"description,omitempty"`
+	Homepage            *string `json:"homepage,omitempty"`
+	DefaultBranch       *string `json:"default_branch,omitempty"`
+	Visibility          *string `json:"visibility,omitempty"`
+	EnableIssues        *bool   `json:"has_issues,omitempty"`
+	EnableProjects      *bool   `json:"has_projects,omitempty"`
+	EnableWiki          *bool   `json:"has_wiki,omitempty"`
+	EnableMergeCommit   *bool   `json:"allow_merge_commit,omitempty"`
+	EnableSquashMerge   *bool   `json:"allow_squash_merge,omitempty"`
+	EnableRebaseMerge   *bool   `json:"allow_rebase_merge,omitempty"`
+	DeleteBranchOnMerge *bool   `json:"delete_branch_on_merge,omitempty"`
+}
+
+type EditOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	Edits           EditRepositoryInput
+	AddTopics       []string
+	RemoveTopics    []string
+	InteractiveMode bool
+}
+
+func NewCmdEdit(f *cmdutil.Factory, runF func(*EditOptions) error) *cobra.Command {
+	opts := &EditOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		BaseRepo:   f.BaseRepo,
+	}
+
+	var (
+		description         string
+		homepage            string
+		defaultBranch       string
+		visibility          string
+		enableIssues        bool
+		enableProjects      bool
+		enableWiki          bool
+		enableMergeCommit   bool
+		enableSquashMerge   bool
+		enableRebaseMerge   bool
+		deleteBranchOnMerge bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "edit",
+		Short: "Edit repository settings",
+		Long: heredoc.Doc(`
+			Edit settings for the repository of the current directory.
+
+			With no flags, an interactive menu offers the editable settings.
+		`),
+		Example: heredoc.Doc(`
+			$ gh repo edit --description "a settings example" --visibility private
+			$ gh repo edit --add-topic cli --remove-topic deprecated
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cmd.Flags().NFlag() == 0 {
+				if !opts.IO.CanPrompt() {
+					return &cmdutil.FlagError{Err: fmt.Errorf("specify properties to edit when not running interactively")}
+				}
+				opts.InteractiveMode = true
+			}
+
+			if cmd.Flags().Changed("description") {
+				opts.Edits.Description = &description
+			}
+			if cmd.Flags().Changed("homepage") {
+				opts.Edits.Homepage = &homepage
+			}
+			if cmd.Flags().Changed("default-branch") {
+				opts.Edits.DefaultBranch = &defaultBranch
+			}
+			if cmd.Flags().Changed("visibility") {
+				opts.Edits.Visibility = &visibility
+			}
+			if cmd.Flags().Changed("enable-issues") {
+				opts.Edits.EnableIssues = &enableIssues
+			}
+			if cmd.Flags().Changed("enable-projects") {
+				opts.Edits.EnableProjects = &enableProjects
+			}
+			if cmd.Flags().Changed("enable-wiki") {
+				opts.Edits.EnableWiki = &enableWiki
+			}
+			if cmd.Flags().Changed("enable-merge-commit") {
+				opts.Edits.EnableMergeCommit = &enableMergeCommit
+			}
+			if cmd.Flags().Changed("enable-squash-merge") {
+				opts.Edits.EnableSquashMerge = &enableSquashMerge
+			}
+			if cmd.Flags().Changed("enable-rebase-merge") {
+				opts.Edits.EnableRebaseMerge = &enableRebaseMerge
+			}
+			if cmd.Flags().Changed("delete-branch-on-merge") {
+				opts.Edits.DeleteBranchOnMerge = &deleteBranchOnMerge
+			}
+
+			for i, topic := range opts.AddTopics {
+				opts.AddTopics[i] = strings.ToLower(strings.TrimSpace(topic))
+				if err := validateTopic(opts.AddTopics[i]); err != nil {
+					return &cmdutil.FlagError{Err: err}
+				}
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return editRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&description, "description", "d", "", "Description of the repository")
+	cmd.Flags().StringVar(&homepage, "homepage", "", "Repository home page `URL`")
+	cmd.Flags().StringVar(&defaultBranch, "default-branch", "", "Set the default branch `name` for the repository")
+	cmd.Flags().StringVar(&visibility, "visibility", "", "Change the visibility of the repository to {public,private,internal}")
+	cmd.Flags().StringSliceVar(&opts.AddTopics, "add-topic", nil, "Add repository topic")
+	cmd.Flags().StringSliceVar(&opts.RemoveTopics, "remove-topic", nil, "Remove repository topic")
+	cmd.Flags().BoolVar(&enableIssues, "enable-issues", true, "Enable issues in the repository")
+	cmd.Flags().BoolVar(&enableProjects, "enable-projects", true, "Enable projects in the repository")
+	cmd.Flags().BoolVar(&enableWiki, "enable-wiki", true, "Enable wiki in the repository")
+	cmd.Flags().BoolVar(&enableMergeCommit, "enable-merge-commit", true, "Enable merging pull requests via merge commit")
+	cmd.Flags().BoolVar(&enableSquashMerge, "enable-squash-merge", true, "Enable merging pull requests via squashed commit")
+	cmd.Flags().BoolVar(&enableRebaseMerge, "enable-rebase-merge", true, "Enable merging pull requests via rebase")
+	cmd.Flags().BoolVar(&deleteBranchOnMerge, "delete-branch-on-merge", false, "Delete head branch when pull requests are merged")
+
+	return cmd
+}
+
+func editRun(opts *EditOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	if opts.InteractiveMode {
+		settings, err := fetchRepoSettings(httpClient, repo)
+		if err != nil {
+			return err
+		}
+		err = interactiveRepoEdit(opts, settings)
+		if err != nil {
+			return err
+		}
+	}
+
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	body := &bytes.Buffer{}
+	if err := json.NewEncoder(body).Encode(opts.Edits); err != nil {
+		return err
+	}
+
+	if strings.TrimSpace(body.String()) != "{}" {
+		err = apiClient.REST(repo.RepoHost(), "PATCH", fmt.Sprintf("repos/%s", ghrepo.FullName(repo)), body, nil)
+		if err != nil {
+			return fmt.Errorf("failed to update repository: %w", err)
+		}
+	}
+
+	if len(opts.AddTopics) > 0 || len(opts.RemoveTopics) > 0 {
+		topics, err := getTopics(httpClient, repo)
+		if err != nil {
+			return err
+		}
+		for _, t := range opts.AddTopics {
+			if !topicIncluded(topics, t) {
+				topics = append(topics, t)
+			}
+		}
+		var newTopics []string
+		for _, t := range topics {
+			if !topicIncluded(opts.RemoveTopics, t) {
+				newTopics = append(newTopics, t)
+			}
+		}
+		err = setTopics(httpClient, repo, newTopics)
+		if err != nil {
+			return err
+		}
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		fmt.Fprintf(opts.IO.Out, "%s Edited repository %s\n", utils.GreenCheck(), ghrepo.FullName(repo))
+	}
+
+	return nil
+}
+
+var topicRE = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{0,49}$`)
+
+// validateTopic checks that a topic conforms to GitHub's naming rules
+func validateTopic(topic string) error {
+	if !topicRE.MatchString(topic) {
+		return fmt.Errorf("invalid topic %q: must start with a letter or number and contain up to 50 lowercase letters, numbers, or hyphens", topic)
+	}
+	return nil
+}
+
+func topicIncluded(topics []string, topic string) bool {
+	for _, t := range topics {
+		if strings.EqualFold(t, topic) {
+			return true
+		}
+	}
+	return false
+}
+
+func interactiveRepoEdit(opts *EditOptions, settings *repoSettings) error {
+	const (
+		optionDescription   = "Description"
+		optionHomepage      = "Home page URL"
+		optionTopics        = "Topics"
+		optionDefaultBranch = "Default branch"
+		optionVisibility    = "Visibility"
+		optionMerge         = "Merge options"
+		optionIssues        = "Issues"
+		optionProjects      = "Projects"
+		optionWiki          = "Wiki"
+		optionBranchCleanup = "Delete branch on merge"
+	)
+
+	var chosen []string
+	err := prompt.SurveyAskOne(&survey.MultiSelect{
+		Message: "What do you want to edit?",
+		Options: []string{
+			optionDescription,
+			optionHomepage,
+			optionTopics,
+			optionDefaultBranch,
+			optionVisibility,
+			optionMerge,
+			optionIssues,
+			optionProjects,
+			optionWiki,
+			optionBranchCleanup,
+		},
+	}, &chosen)
+	if err != nil {
+		return fmt.Errorf("could not prompt: %w", err)
+	}
+
+	for _, c := range chosen {
+		switch c {
+		case optionDescription:
+			answer := settings.Description
+			err = prompt.SurveyAskOne(&survey.Input{
+				Message: "Description of the repository",
+				Default: settings.Description,
+			}, &answer)
+			if err != nil {
+				return err
+			}
+			opts.Edits.Description = &answer
+		case optionHomepage:
+			answer := settings.Homepage
+			err = prompt.SurveyAskOne(&survey.Input{
+				Message: "Repository home page URL",
+				Default: settings.Homepage,
+			}, &answer)
+			if err != nil {
+				return err
+			}
+			opts.Edits.Homepage = &answer
+		case optionTopics:
+			var answer string
+			err = prompt.SurveyAskOne(&survey.Input{
+				Message: "Topics (comma separated)",
+				Default: strings.Join(settings.Topics, ", "),
+			}, &answer)
+			if err != nil {
+				return err
+			}
+			opts.RemoveTopics = settings.Topics
+			for _, t := range strings.Split(answer, ",") {
+				if t = strings.ToLower(strings.TrimSpace(t)); t != "" {
+					if err := validateTopic(t); err != nil {
+						return err
+					}
+					opts.AddTopics = append(opts.AddTopics, t)
+				}
+			}
+		case optionDefaultBranch:
+			answer := settings.DefaultBranch
+			err = prompt.SurveyAskOne(&survey.Input{
+				Message: "Default branch name",
+				Default: settings.DefaultBranch,
+			}, &answer)
+			if err != nil {
+				return err
+			}
+			opts.Edits.DefaultBranch = &answer
+		case optionVisibility:
+			answer := settings.visibility()
+			err = prompt.SurveyAskOne(&survey.Select{
+				Message: "Visibility",
+				Options: []string{"public", "private", "internal"},
+				Default: settings.visibility(),
+			}, &answer)
+			if err != nil {
+				return err
+			}
+			opts.Edits.Visibility = &answer
+		case optionMerge:
+			const (
+				mergeCommits = "Allow merge commits"
+				squashMerge  = "Allow squash merging"
+				rebaseMerge  = "Allow rebase merging"
+			)
+			var defaults []string
+			if settings.AllowMergeCommit {
+				defaults = append(defaults, mergeCommits)
+			}
+			if settings.AllowSquashMerge {
+				defaults = append(defaults, squashMerge)
+			}
+			if settings.AllowRebaseMerge {
+				defaults = append(defaults, rebaseMerge)
+			}
+			var selected []string
+			err = prompt.SurveyAskOne(&survey.MultiSelect{
+				Message: "Allowed merge strategies",
+				Options: []string{mergeCommits, squashMerge, rebaseMerge},
+				Default: defaults,
+			}, &selected)
+			if err != nil {
+				return err
+			}
+			allowMerge := topicIncluded(selected, mergeCommits)
+			allowSquash := topicIncluded(selected, squashMerge)
+			allowRebase := topicIncluded(selected, rebaseMerge)
+			opts.Edits.EnableMergeCommit = &allowMerge
+			opts.Edits.EnableSquashMerge = &allowSquash
+			opts.Edits.EnableRebaseMerge = &allowRebase
+		case optionIssues:
+			answer := settings.HasIssues
+			err = prompt.Confirm("Enable issues?", &answer)
+			if err != nil {
+				return err
+			}
+			opts.Edits.EnableIssues = &answer
+		case optionProjects:
+			answer := settings.HasProjects
+			err = prompt.Confirm("Enable projects?", &answer)
+			if err != nil {
+				return err
+			}
+			opts.Edits.EnableProjects = &answer
+		case optionWiki:
+			answer := settings.HasWiki
+			err = prompt.Confirm("Enable wiki?", &answer)
+			if err != nil {
+				return err
+			}
+			opts.Edits.EnableWiki = &answer
+		case optionBranchCleanup:
+			answer := settings.DeleteBranchOnMerge
+			err = prompt.Confirm("Automatically delete head branches after merging?", &answer)
+			if err != nil {
+				return err
+			}
+			opts.Edits.DeleteBranchOnMerge = &answer
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+package edit
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/httpmock"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func runCommand(httpClient *http.Client, cli string) (*EditOptions, error) {
+	io, _, _, _ := iostreams.Test()
+	fac := &cmdutil.Factory{
+		IOStreams: io,
+		HttpClient: func() (*http.Client, error) {
+			return httpClient, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+	}
+
+	var opts *EditOptions
+	cmd := NewCmdEdit(fac, func(o *EditOptions) error {
+		opts = o
+		return editRun(o)
+	})
+
+	argv, err := shlex.Split(cli)
+	if err != nil {
+		return nil, err
+	}
+	cmd.SetArgs(argv)
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	_, err = cmd.ExecuteC()
+	return opts, err
+}
+
+func Test_editRun_settings(t *testing.T) {
+	reg := &httpmock.Registry{}
+	reg.Register(
+		httpmock.REST("PATCH", "repos/OWNER/REPO"),
+		httpmock.StringResponse(`{}`))
+
+	httpClient := &http.Client{Transport: reg}
+
+	_, err := runCommand(httpClient, `--description "my cool repo" --visibility private --enable-wiki=false --delete-branch-on-merge`)
+	assert.NoError(t, err)
+
+	bodyBytes, _ := ioutil.ReadAll(reg.Requests[0].Body)
+	reqBody := map[string]interface{}{}
+	err = json.Unmarshal(bodyBytes, &reqBody)
+	assert.NoError(t, err)
+
+	assert.Equal(t, map[string]interface{}{
+		"description":            "my cool repo",
+		"visibility":             "private",
+		"has_wiki":               false,
+		"delete_branch_on_merge": true,
+	}, reqBody)
+	reg.Verify(t)
+}
+
+func Test_editRun_topics(t *testing.T) {
+	reg := &httpmock.Registry{}
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/topics"),
+		httpmock.StringResponse(`{"names":["go", "deprecated"]}`))
+	reg.Register(
+		httpmock.REST("PUT", "repos/OWNER/REPO/topics"),
+		httpmock.StringResponse(`{}`))
+
+	httpClient := &http.Client{Transport: reg}
+
+	_, err := runCommand(httpClient, "--add-topic CLI --remove-topic deprecated")
+	assert.NoError(t, err)
+
+	putRequest := reg.Requests[1]
+	bodyBytes, _ := ioutil.ReadAll(putRequest.Body)
+	assert.Equal(t, "application/vnd.github.mercy-preview+json", putRequest.Header.Get("Accept"))
+	assert.JSONEq(t, `{"names":["go", "cli"]}`, string(bodyBytes))
+	reg.Verify(t)
+}
+
+func Test_editRun_invalidTopic(t *testing.T) {
+	httpClient := &http.Client{}
+
+	_, err := runCommand(httpClient, `--add-topic "Bad Topic!"`)
+	assert.EqualError(t, err, `invalid topic "bad topic!": must start with a letter or number and contain up to 50 lowercase letters, numbers, or hyphens`)
+}
+
+func Test_editRun_nonInteractiveNoFlags(t *testing.T) {
+	_, err := runCommand(nil, "")
+	assert.EqualError(t, err, "specify properties to edit when not running interactively")
+}
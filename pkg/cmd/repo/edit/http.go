@@ -0,0 +1,115 @@
+package edit
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/internal/ghinstance"
+	"github.com/cli/cli/internal/ghrepo"
+)
+
+type repoSettings struct {
+	Description         string   `json:"description"`
+	Homepage            string   `json:"homepage"`
+	DefaultBranch       string   `json:"default_branch"`
+	Private             bool     `json:"private"`
+	Topics              []string `json:"topics"`
+	HasIssues           bool     `json:"has_issues"`
+	HasProjects         bool     `json:"has_projects"`
+	HasWiki             bool     `json:"has_wiki"`
+	AllowMergeCommit    bool     `json:"allow_merge_commit"`
+	AllowSquashMerge    bool     `json:"allow_squash_merge"`
+	AllowRebaseMerge    bool     `json:"allow_rebase_merge"`
+	DeleteBranchOnMerge bool     `json:"delete_branch_on_merge"`
+}
+
+func (s *repoSettings) visibility() string {
+	if s.Private {
+		return "private"
+	}
+	return "public"
+}
+
+func fetchRepoSettings(httpClient *http.Client, repo ghrepo.Interface) (*repoSettings, error) {
+	url := ghinstance.RESTPrefix(repo.RepoHost()) + fmt.Sprintf("repos/%s", ghrepo.FullName(repo))
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	// topics are still behind a preview media type
+	req.Header.Set("Accept", "application/vnd.github.mercy-preview+json")
+
+	res, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, api.HandleHTTPError(res)
+	}
+
+	settings := repoSettings{}
+	err = json.NewDecoder(res.Body).Decode(&settings)
+	if err != nil {
+		return nil, err
+	}
+	return &settings, nil
+}
+
+func getTopics(httpClient *http.Client, repo ghrepo.Interface) ([]string, error) {
+	url := ghinstance.RESTPrefix(repo.RepoHost()) + fmt.Sprintf("repos/%s/topics", ghrepo.FullName(repo))
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/vnd.github.mercy-preview+json")
+
+	res, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, api.HandleHTTPError(res)
+	}
+
+	var responseData struct {
+		Names []string `json:"names"`
+	}
+	err = json.NewDecoder(res.Body).Decode(&responseData)
+	return responseData.Names, err
+}
+
+func setTopics(httpClient *http.Client, repo ghrepo.Interface, topics []string) error {
+	payload := struct {
+		Names []string `json:"names"`
+	}{Names: topics}
+	if payload.Names == nil {
+		payload.Names = []string{}
+	}
+	body := &bytes.Buffer{}
+	if err := json.NewEncoder(body).Encode(payload); err != nil {
+		return err
+	}
+
+	url := ghinstance.RESTPrefix(repo.RepoHost()) + fmt.Sprintf("repos/%s/topics", ghrepo.FullName(repo))
+	req, err := http.NewRequest("PUT", url, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	req.Header.Set("Accept", "application/vnd.github.mercy-preview+json")
+
+	res, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode > 299 {
+		return api.HandleHTTPError(res)
+	}
+	return nil
+}
@@ -32,12 +32,34 @@ type ForkOptions struct {
 	Remote       bool
 	PromptClone  bool
 	PromptRemote bool
+	RemoteName   string
+	Org          string
+	ForkName     string
 }
 
 var Since = func(t time.Time) time.Duration {
 	return time.Since(t)
 }
 
+// waitForFork polls until the forked repository exists, since forking only
+// schedules the copy on the server side
+func waitForFork(apiClient *api.Client, repo ghrepo.Interface) error {
+	deadline := time.Now().Add(time.Minute)
+	for {
+		exists, err := api.RepoExists(apiClient, repo)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("%s is taking longer than expected to be created; try cloning it later", ghrepo.FullName(repo))
+		}
+		time.Sleep(2 * time.Second)
+	}
+}
+
 func NewCmdFork(f *cmdutil.Factory, runF func(*ForkOptions) error) *cobra.Command {
 	opts := &ForkOptions{
 		IO:         f.IOStreams,
@@ -68,6 +90,14 @@ With no argument, creates a fork of the current repository. Otherwise, forks the
 				opts.PromptRemote = true
 			}
 
+			if cmd.Flags().Changed("org") && opts.Org == "" {
+				return &cmdutil.FlagError{Err: fmt.Errorf("--org cannot be blank")}
+			}
+
+			if opts.RemoteName == "" {
+				return &cmdutil.FlagError{Err: fmt.Errorf("--remote-name cannot be blank")}
+			}
+
 			if runF != nil {
 				return runF(opts)
 			}
@@ -77,6 +107,9 @@ With no argument, creates a fork of the current repository. Otherwise, forks the
 
 	cmd.Flags().BoolVar(&opts.Clone, "clone", false, "Clone the fork {true|false}")
 	cmd.Flags().BoolVar(&opts.Remote, "remote", false, "Add remote for fork {true|false}")
+	cmd.Flags().StringVar(&opts.RemoteName, "remote-name", "origin", "Specify a name for a fork's new remote")
+	cmd.Flags().StringVar(&opts.Org, "org", "", "Create the fork in an organization")
+	cmd.Flags().StringVar(&opts.ForkName, "fork-name", "", "Rename the forked repository")
 
 	return cmd
 }
@@ -146,7 +179,7 @@ func forkRun(opts *ForkOptions) error {
 
 	apiClient := api.NewClientFromHTTP(httpClient)
 
-	forkedRepo, err := api.ForkRepo(apiClient, repoToFork)
+	forkedRepo, err := api.ForkRepo(apiClient, repoToFork, opts.Org)
 	if err != nil {
 		stopSpinner()
 		return fmt.Errorf("failed to fork: %w", err)
@@ -176,6 +209,16 @@ func forkRun(opts *ForkOptions) error {
 		}
 	}
 
+	if opts.ForkName != "" {
+		forkedRepo, err = api.RenameRepo(apiClient, forkedRepo, opts.ForkName)
+		if err != nil {
+			return fmt.Errorf("could not rename fork: %w", err)
+		}
+		if connectedToTerminal {
+			fmt.Fprintf(stderr, "%s Renamed fork to %s\n", utils.GreenCheck(), utils.Bold(ghrepo.FullName(forkedRepo)))
+		}
+	}
+
 	if (inParent && (!opts.Remote && !opts.PromptRemote)) || (!inParent && (!opts.Clone && !opts.PromptClone)) {
 		return nil
 	}
@@ -209,7 +252,7 @@ func forkRun(opts *ForkOptions) error {
 			}
 		}
 		if remoteDesired {
-			remoteName := "origin"
+			remoteName := opts.RemoteName
 
 			remotes, err := opts.Remotes()
 			if err != nil {
@@ -247,6 +290,15 @@ func forkRun(opts *ForkOptions) error {
 			}
 		}
 		if cloneDesired {
+			// forking is asynchronous; a brand-new repository may not be
+			// ready to clone right away
+			if createdAgo <= time.Minute {
+				err = waitForFork(apiClient, forkedRepo)
+				if err != nil {
+					return err
+				}
+			}
+
 			forkedRepoURL := ghrepo.FormatRemoteURL(forkedRepo, protocol)
 			cloneDir, err := git.RunClone(forkedRepoURL, []string{})
 			if err != nil {
@@ -1,6 +1,8 @@
 package fork
 
 import (
+	"bytes"
+	"io/ioutil"
 	"net/http"
 	"os/exec"
 	"regexp"
@@ -128,6 +130,7 @@ func TestRepoFork_outside_parent_nontty(t *testing.T) {
 	defer stubSince(2 * time.Second)()
 	reg := &httpmock.Registry{}
 	defer reg.StubWithFixturePath(200, "./forkResult.json")()
+	reg.StubResponse(200, bytes.NewBufferString("{}")) // fork readiness check
 	httpClient := &http.Client{Transport: reg}
 
 	cs, restore := test.InitCmdStubber()
@@ -308,6 +311,7 @@ func TestRepoFork_outside_yes(t *testing.T) {
 	defer stubSince(2 * time.Second)()
 	reg := &httpmock.Registry{}
 	defer reg.StubWithFixturePath(200, "./forkResult.json")()
+	reg.StubResponse(200, bytes.NewBufferString("{}")) // fork readiness check
 	httpClient := &http.Client{Transport: reg}
 
 	cs, restore := test.InitCmdStubber()
@@ -337,6 +341,7 @@ func TestRepoFork_outside_survey_yes(t *testing.T) {
 	defer stubSince(2 * time.Second)()
 	reg := &httpmock.Registry{}
 	defer reg.StubWithFixturePath(200, "./forkResult.json")()
+	reg.StubResponse(200, bytes.NewBufferString("{}")) // fork readiness check
 	httpClient := &http.Client{Transport: reg}
 
 	cs, restore := test.InitCmdStubber()
@@ -482,3 +487,91 @@ func stubSince(d time.Duration) func() {
 		Since = originalSince
 	}
 }
+
+func TestRepoFork_org(t *testing.T) {
+	defer stubSince(2 * time.Second)()
+	reg := &httpmock.Registry{}
+	defer reg.StubWithFixturePath(200, "./forkResult.json")()
+	httpClient := &http.Client{Transport: reg}
+
+	cs, restore := test.InitCmdStubber()
+	defer restore()
+
+	output, err := runCommand(httpClient, nil, false, "--org gamehendge OWNER/REPO")
+	if err != nil {
+		t.Fatalf("error running command `repo fork`: %v", err)
+	}
+
+	assert.Equal(t, 0, len(cs.Calls))
+
+	bodyBytes, _ := ioutil.ReadAll(reg.Requests[0].Body)
+	if !strings.Contains(string(bodyBytes), `"organization":"gamehendge"`) {
+		t.Errorf("expected fork request to target the organization, got %s", string(bodyBytes))
+	}
+
+	assert.Equal(t, "", output.String())
+	assert.Equal(t, "", output.Stderr())
+	reg.Verify(t)
+}
+
+func TestRepoFork_forkName(t *testing.T) {
+	defer stubSince(2 * time.Second)()
+	reg := &httpmock.Registry{}
+	defer reg.StubWithFixturePath(200, "./forkResult.json")()
+	reg.StubResponse(200, bytes.NewBufferString(`
+		{ "node_id": "NODEID",
+		  "name": "REPO2",
+		  "clone_url": "https://github.com/someone/REPO2.git",
+		  "created_at": "2011-01-26T19:01:12Z",
+		  "owner": {"login": "someone"}
+		}
+	`))
+	httpClient := &http.Client{Transport: reg}
+
+	cs, restore := test.InitCmdStubber()
+	defer restore()
+
+	output, err := runCommand(httpClient, nil, false, "--clone=false --fork-name REPO2 OWNER/REPO")
+	if err != nil {
+		t.Fatalf("error running command `repo fork`: %v", err)
+	}
+
+	assert.Equal(t, 0, len(cs.Calls))
+
+	renameRequest := reg.Requests[1]
+	assert.Equal(t, "PATCH", renameRequest.Method)
+	if !strings.HasSuffix(renameRequest.URL.Path, "/repos/someone/REPO") {
+		t.Errorf("unexpected request path %s", renameRequest.URL.Path)
+	}
+	bodyBytes, _ := ioutil.ReadAll(renameRequest.Body)
+	if !strings.Contains(string(bodyBytes), `"name":"REPO2"`) {
+		t.Errorf("expected rename request body, got %s", string(bodyBytes))
+	}
+
+	assert.Equal(t, "", output.String())
+	reg.Verify(t)
+}
+
+func TestRepoFork_remoteName(t *testing.T) {
+	defer stubSince(2 * time.Second)()
+	reg := &httpmock.Registry{}
+	defer reg.StubWithFixturePath(200, "./forkResult.json")()
+	httpClient := &http.Client{Transport: reg}
+
+	cs, restore := test.InitCmdStubber()
+	defer restore()
+
+	cs.Stub("") // git remote add
+
+	output, err := runCommand(httpClient, []*context.Remote{}, false, "--remote --remote-name fork")
+	if err != nil {
+		t.Fatalf("error running command `repo fork`: %v", err)
+	}
+
+	assert.Equal(t, 1, len(cs.Calls))
+	assert.Equal(t, "git remote add -f fork https://github.com/someone/REPO.git", strings.Join(cs.Calls[0].Args, " "))
+
+	assert.Equal(t, "", output.String())
+	assert.Equal(t, "", output.Stderr())
+	reg.Verify(t)
+}
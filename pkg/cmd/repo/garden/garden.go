@@ -0,0 +1,1012 @@
+package garden
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"math/rand"
+	"net/http"
+	"os"
+	"regexp"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/internal/config"
+	"github.com/cli/cli/internal/ghinstance"
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/cli/cli/utils"
+	"github.com/spf13/cobra"
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+type Geometry struct {
+	Width      int
+	Height     int
+	Repository ghrepo.Interface
+}
+
+type Player struct {
+	X    int
+	Y    int
+	Char string
+	Geo  *Geometry
+}
+
+type Commit struct {
+	Handle string `json:"handle"`
+	Sha    string `json:"sha"`
+	// Merge names what a merge commit merged; it is blank for regular commits
+	Merge string `json:"merge,omitempty"`
+	// Subsystem is the primary top-level directory the commit touched; it is
+	// only populated for the subsystem layout
+	Subsystem string `json:"subsystem,omitempty"`
+}
+
+type Cell struct {
+	Char       string `json:"char"`
+	StatusLine string `json:"statusLine"`
+	// Handle is the login of whoever planted a flower or built a bridge here;
+	// it is blank for scenery
+	Handle string `json:"handle,omitempty"`
+}
+
+type Direction int
+
+const (
+	DirUp Direction = iota
+	DirDown
+	DirLeft
+	DirRight
+)
+
+// how long a flashed cell stays highlighted before reverting
+const flashDuration = 150 * time.Millisecond
+
+var blockedQuips = []string{
+	"ouch. that's a tree.",
+	"this garden is only so big.",
+	"the trees are too thick to pass.",
+	"you bump your nose on bark.",
+}
+
+type GardenOptions struct {
+	HttpClient func() (*http.Client, error)
+	Config     func() (config.Config, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	RepoArg       string
+	Demo          bool
+	Dump          bool
+	FrameHashes   bool
+	ReducedMotion bool
+	Glyph         string
+	Trail         int
+	Layout        string
+	Seed          int64
+	SeedSet       bool
+}
+
+// plantFunc returns the planting strategy selected by --layout
+func (opts *GardenOptions) plantFunc() func([]*Commit, *Geometry, *rand.Rand) [][]*Cell {
+	if opts.Layout == "subsystem" {
+		return plantGardenSubsystems
+	}
+	return plantGarden
+}
+
+func NewCmdGarden(f *cmdutil.Factory, runF func(*GardenOptions) error) *cobra.Command {
+	opts := &GardenOptions{
+		HttpClient: f.HttpClient,
+		Config:     f.Config,
+		IO:         f.IOStreams,
+		BaseRepo:   f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "garden [<repository>]",
+		Short: "Explore a git repository as a garden",
+		Long:  "Use WASD or the arrow keys to walk around this representation of a repository's commit history.",
+		Example: heredoc.Doc(`
+			# wander the garden of the current repository
+			$ gh repo garden
+
+			# wander the garden of another repository
+			$ gh repo garden cli/cli
+
+			# dump the planted garden as JSON for other renderers
+			$ gh repo garden --dump > garden.json
+
+			# wander as a snail, leaving a trail behind
+			$ gh repo garden --glyph @ --trail 8
+
+			# plant a demo garden without any API access
+			$ gh repo garden --demo
+
+			# group flowers into labeled beds by the directory their commits touched
+			$ gh repo garden --layout subsystem
+
+			# replant the exact garden a teammate shared
+			$ gh repo garden cli/cli --seed 42
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.RepoArg = args[0]
+			}
+
+			opts.ReducedMotion = prefersReducedMotion(opts.Config)
+
+			if cmd.Flags().Changed("glyph") {
+				if utf8.RuneCountInString(opts.Glyph) != 1 {
+					return &cmdutil.FlagError{Err: fmt.Errorf("invalid glyph %q: must be exactly one character", opts.Glyph)}
+				}
+			} else {
+				opts.Glyph = configuredGlyph(opts.Config)
+			}
+			if cmd.Flags().Changed("trail") {
+				if opts.Trail < 0 {
+					return &cmdutil.FlagError{Err: errors.New("--trail must be zero or more cells")}
+				}
+			} else {
+				opts.Trail = configuredTrail(opts.Config)
+			}
+			if opts.Layout != "random" && opts.Layout != "subsystem" {
+				return &cmdutil.FlagError{Err: fmt.Errorf("invalid layout %q: must be \"random\" or \"subsystem\"", opts.Layout)}
+			}
+			opts.SeedSet = cmd.Flags().Changed("seed")
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return gardenRun(opts)
+		},
+		Hidden: true,
+	}
+
+	cmd.Flags().BoolVar(&opts.Demo, "demo", false, "Plant a deterministic demo garden with generated commits, requiring no auth or network")
+	cmd.Flags().BoolVar(&opts.Dump, "dump", false, "Output the planted garden as JSON instead of exploring it")
+	cmd.Flags().BoolVar(&opts.FrameHashes, "frame-hashes", false, "Walk the garden from scripted moves on stdin and print a checksum per rendered frame")
+	_ = cmd.Flags().MarkHidden("frame-hashes")
+	cmd.Flags().StringVar(&opts.Glyph, "glyph", "@", "Draw the player as `character`")
+	cmd.Flags().StringVar(&opts.Layout, "layout", "random", "Plant flowers at `random` or in labeled beds by `subsystem`")
+	cmd.Flags().Int64Var(&opts.Seed, "seed", 0, "Plant the garden from a specific `seed` so it can be shared and replanted")
+	cmd.Flags().IntVar(&opts.Trail, "trail", 0, "Leave a dimmed trail over the last `n` cells walked through")
+
+	return cmd
+}
+
+func gardenRun(opts *GardenOptions) error {
+	// dump and frame-hash modes never touch the terminal, so they work
+	// anywhere
+	interactive := !opts.Dump && !opts.FrameHashes
+
+	if runtime.GOOS == "windows" && interactive {
+		return errors.New("sorry :( this command only works on linux and macos")
+	}
+
+	if interactive && (!opts.IO.IsStdoutTTY() || !opts.IO.IsStdinTTY()) {
+		return errors.New("must be connected to a terminal")
+	}
+
+	// the demo garden needs neither auth nor network, so skip client and
+	// repository resolution entirely
+	var httpClient *http.Client
+	var toView ghrepo.Interface
+	if opts.Demo {
+		toView = ghrepo.New("octocat", "garden-demo")
+	} else {
+		var err error
+		httpClient, err = opts.HttpClient()
+		if err != nil {
+			return err
+		}
+
+		if opts.RepoArg == "" {
+			toView, err = opts.BaseRepo()
+			if err != nil {
+				return err
+			}
+		} else {
+			toView, err = ghrepo.FromFullName(opts.RepoArg)
+			if err != nil {
+				return fmt.Errorf("argument error: %w", err)
+			}
+		}
+	}
+
+	fetchCommits := func(maxCommits int) ([]*Commit, error) {
+		if opts.Demo {
+			commits := demoCommits(maxCommits)
+			if opts.Layout == "subsystem" {
+				demoSubsystems(commits)
+			}
+			return commits, nil
+		}
+		commits, err := getCommits(httpClient, toView, maxCommits)
+		if err != nil {
+			return nil, err
+		}
+		if opts.Layout == "subsystem" {
+			if err := annotateSubsystems(httpClient, toView, commits); err != nil {
+				return nil, err
+			}
+		}
+		return commits, nil
+	}
+
+	out := opts.IO.Out
+
+	if opts.Dump {
+		return dumpGarden(opts, fetchCommits, toView)
+	}
+
+	if opts.FrameHashes {
+		return frameHashRun(opts, fetchCommits, toView)
+	}
+
+	termWidth, termHeight, err := utils.TerminalSize(out)
+	if err != nil {
+		return err
+	}
+
+	geo := &Geometry{
+		// leave room for the status line below the garden
+		Width:      termWidth,
+		Height:     termHeight - 2,
+		Repository: toView,
+	}
+
+	// planting needs room between the trees; a plot any smaller would make the
+	// planting functions panic picking spots
+	if geo.Width < 4 || geo.Height < 4 {
+		return errors.New("the terminal is too small to plant a garden; it needs at least a 4x4 plot")
+	}
+
+	maxCommits := (geo.Width * geo.Height) / 2
+
+	opts.IO.StartProgressIndicator()
+	commits, err := fetchCommits(maxCommits)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	player := &Player{
+		X:    geo.Width / 2,
+		Y:    geo.Height / 2,
+		Char: utils.Bold(opts.Glyph),
+		Geo:  geo,
+	}
+	tracks := &trail{max: opts.Trail}
+
+	// an explicit --seed wins, so a teammate's shared garden replants exactly;
+	// the demo garden otherwise keeps a fixed seed so screenshots and terminal
+	// compatibility checks are reproducible
+	seed := time.Now().UnixNano()
+	if opts.Demo {
+		seed = 1
+	}
+	if opts.SeedSet {
+		seed = opts.Seed
+	}
+	rng := rand.New(rand.NewSource(seed))
+	garden := opts.plantFunc()(commits, geo, rng)
+
+	clearScreen(out)
+	fmt.Fprint(out, "\x1b[?25l") // hide cursor
+	defer fmt.Fprint(out, "\x1b[?25h")
+
+	drawGarden(out, garden, player)
+	drawStatus(out, geo, utils.Bold(fmt.Sprintf("the garden of %s. walk with wasd or arrows; quit with q.", ghrepo.FullName(toView))))
+
+	oldTermState, err := terminal.MakeRaw(int(os.Stdin.Fd()))
+	if err != nil {
+		return fmt.Errorf("failed to set up terminal: %w", err)
+	}
+	defer func() {
+		_ = terminal.Restore(int(os.Stdin.Fd()), oldTermState)
+	}()
+
+	visited := map[trailCell]bool{{player.X, player.Y}: true}
+	authorsMet := map[string]bool{}
+	startedAt := time.Now()
+
+	quipIx := 0
+	b := make([]byte, 3)
+	for {
+		n, err := opts.IO.In.Read(b)
+		if err != nil {
+			break
+		}
+		if n == 0 {
+			continue
+		}
+
+		quitting := false
+		var dir Direction
+		moving := false
+		switch {
+		case b[0] == 'q' || b[0] == 3: // ctrl-c
+			quitting = true
+		case b[0] == 'w', n == 3 && b[1] == '[' && b[2] == 'A':
+			dir, moving = DirUp, true
+		case b[0] == 's', n == 3 && b[1] == '[' && b[2] == 'B':
+			dir, moving = DirDown, true
+		case b[0] == 'd', n == 3 && b[1] == '[' && b[2] == 'C':
+			dir, moving = DirRight, true
+		case b[0] == 'a', n == 3 && b[1] == '[' && b[2] == 'D':
+			dir, moving = DirLeft, true
+		}
+
+		if quitting {
+			break
+		}
+		if !moving {
+			continue
+		}
+
+		newX, newY := player.X, player.Y
+		switch dir {
+		case DirUp:
+			newY--
+		case DirDown:
+			newY++
+		case DirLeft:
+			newX--
+		case DirRight:
+			newX++
+		}
+
+		if isBlocked(geo, newX, newY) {
+			drawStatus(out, geo, utils.Red(blockedQuips[quipIx%len(blockedQuips)]))
+			quipIx++
+			if !opts.ReducedMotion {
+				flashCell(out, garden, newX, newY)
+			}
+			continue
+		}
+
+		if tracks.max > 0 {
+			drawTrailCell(out, garden, player.X, player.Y)
+			for _, c := range tracks.visit(player.X, player.Y) {
+				drawCell(out, garden, c.x, c.y)
+			}
+		} else {
+			drawCell(out, garden, player.X, player.Y)
+		}
+		player.X, player.Y = newX, newY
+		visited[trailCell{player.X, player.Y}] = true
+		if handle := garden[player.Y][player.X].Handle; handle != "" {
+			authorsMet[handle] = true
+		}
+		drawPlayer(out, player)
+		drawStatus(out, geo, garden[player.Y][player.X].StatusLine)
+	}
+
+	clearScreen(out)
+	fmt.Fprintln(out, utils.Bold("You turn and walk back down the garden path. Thanks for visiting!"))
+	fmt.Fprintln(out)
+	fmt.Fprintf(out, "You visited %s, met %s, and wandered for %s.\n",
+		utils.Pluralize(len(visited), "cell"),
+		utils.Pluralize(len(authorsMet), "gardener"),
+		time.Since(startedAt).Round(time.Second))
+	fmt.Fprintf(out, "Replant this garden for a teammate with: %s\n", utils.Bold(shareCommand(opts, toView, seed)))
+
+	return nil
+}
+
+// shareCommand builds a command line that replants this exact garden
+func shareCommand(opts *GardenOptions, repo ghrepo.Interface, seed int64) string {
+	parts := []string{"gh", "repo", "garden"}
+	if opts.Demo {
+		parts = append(parts, "--demo")
+	} else {
+		parts = append(parts, ghrepo.FullName(repo))
+	}
+	if opts.Layout != "random" {
+		parts = append(parts, "--layout", opts.Layout)
+	}
+	parts = append(parts, "--seed", strconv.FormatInt(seed, 10))
+	return strings.Join(parts, " ")
+}
+
+// Thumbnail renders a miniature garden of a repository as plain rows of text,
+// suitable for embedding in other views such as a status dashboard. The
+// planting is seeded from the repository name so the same repository always
+// grows the same tiny garden.
+func Thumbnail(client *http.Client, repo ghrepo.Interface, width, height int) ([]string, error) {
+	if width < 4 || height < 4 {
+		return nil, errors.New("a garden needs at least a 4x4 plot")
+	}
+
+	maxCommits := (width * height) / 2
+	commits, err := getCommits(client, repo, maxCommits)
+	if err != nil {
+		return nil, err
+	}
+
+	geo := &Geometry{
+		Width:      width,
+		Height:     height,
+		Repository: repo,
+	}
+
+	rng := rand.New(rand.NewSource(repoSeed(repo)))
+
+	garden := plantGarden(commits, geo, rng)
+
+	rows := make([]string, 0, len(garden))
+	for _, gardenRow := range garden {
+		row := ""
+		for _, gardenCell := range gardenRow {
+			row += gardenCell.Char
+		}
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+// repoSeed derives a planting seed from a repository's name so the same
+// repository always grows the same garden
+func repoSeed(repo ghrepo.Interface) int64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(ghrepo.FullName(repo)))
+	return int64(h.Sum64())
+}
+
+// gardenDump is the JSON shape of a planted garden for external renderers
+type gardenDump struct {
+	Repository string    `json:"repository"`
+	Width      int       `json:"width"`
+	Height     int       `json:"height"`
+	Seed       int64     `json:"seed"`
+	Commits    []*Commit `json:"commits"`
+	Cells      [][]*Cell `json:"cells"`
+}
+
+var ansiPattern = regexp.MustCompile("\x1b\\[[0-9;]*m")
+
+// dumpGarden plants a deterministic garden on a fixed plot and writes it out
+// as JSON with color codes stripped, so alternative renderers can build on
+// the same planting algorithm
+func dumpGarden(opts *GardenOptions, fetchCommits func(maxCommits int) ([]*Commit, error), repo ghrepo.Interface) error {
+	const width, height = 80, 24
+
+	maxCommits := (width * height) / 2
+	commits, err := fetchCommits(maxCommits)
+	if err != nil {
+		return err
+	}
+
+	geo := &Geometry{
+		Width:      width,
+		Height:     height,
+		Repository: repo,
+	}
+
+	// seed from the repository name so the same repository always dumps the
+	// same garden, unless --seed asks for a specific planting
+	seed := repoSeed(repo)
+	if opts.SeedSet {
+		seed = opts.Seed
+	}
+	rng := rand.New(rand.NewSource(seed))
+
+	garden := opts.plantFunc()(commits, geo, rng)
+	for _, gardenRow := range garden {
+		for _, gardenCell := range gardenRow {
+			gardenCell.Char = ansiPattern.ReplaceAllString(gardenCell.Char, "")
+		}
+	}
+
+	dump := gardenDump{
+		Repository: ghrepo.FullName(repo),
+		Width:      geo.Width,
+		Height:     geo.Height,
+		Seed:       seed,
+		Commits:    commits,
+		Cells:      garden,
+	}
+
+	encoder := json.NewEncoder(opts.IO.Out)
+	return encoder.Encode(&dump)
+}
+
+// frameHashRun plants the same deterministic garden as --dump, walks it from
+// scripted moves read on stdin (wasd, q to stop), and prints a checksum per
+// rendered frame. CI can assert on the hashes to catch rendering changes
+// without storing golden ANSI output.
+func frameHashRun(opts *GardenOptions, fetchCommits func(maxCommits int) ([]*Commit, error), repo ghrepo.Interface) error {
+	const width, height = 80, 24
+
+	maxCommits := (width * height) / 2
+	commits, err := fetchCommits(maxCommits)
+	if err != nil {
+		return err
+	}
+
+	geo := &Geometry{
+		Width:      width,
+		Height:     height,
+		Repository: repo,
+	}
+
+	// seed from the repository name so the same repository always produces the
+	// same frames, unless --seed asks for a specific planting
+	seed := repoSeed(repo)
+	if opts.SeedSet {
+		seed = opts.Seed
+	}
+	rng := rand.New(rand.NewSource(seed))
+
+	garden := opts.plantFunc()(commits, geo, rng)
+
+	// hash the colorless frame so the checksums survive palette changes and
+	// stay meaningful across terminals
+	for _, gardenRow := range garden {
+		for _, gardenCell := range gardenRow {
+			gardenCell.Char = ansiPattern.ReplaceAllString(gardenCell.Char, "")
+		}
+	}
+
+	player := &Player{
+		X:    geo.Width / 2,
+		Y:    geo.Height / 2,
+		Char: opts.Glyph,
+		Geo:  geo,
+	}
+
+	out := opts.IO.Out
+	frameIx := 0
+	printFrameHash := func(status string) {
+		fh := fnv.New64a()
+		_, _ = fh.Write([]byte(renderFrame(garden, player, status)))
+		fmt.Fprintf(out, "%d\t%016x\n", frameIx, fh.Sum64())
+		frameIx++
+	}
+
+	printFrameHash(fmt.Sprintf("the garden of %s", ghrepo.FullName(repo)))
+
+	quipIx := 0
+	b := make([]byte, 1)
+	for {
+		n, err := opts.IO.In.Read(b)
+		if err != nil {
+			break
+		}
+		if n == 0 {
+			continue
+		}
+
+		newX, newY := player.X, player.Y
+		switch b[0] {
+		case 'q':
+			return nil
+		case 'w':
+			newY--
+		case 's':
+			newY++
+		case 'a':
+			newX--
+		case 'd':
+			newX++
+		default:
+			continue
+		}
+
+		if isBlocked(geo, newX, newY) {
+			printFrameHash(blockedQuips[quipIx%len(blockedQuips)])
+			quipIx++
+			continue
+		}
+
+		player.X, player.Y = newX, newY
+		printFrameHash(garden[player.Y][player.X].StatusLine)
+	}
+
+	return nil
+}
+
+// renderFrame composes the visible screen as plain rows of text with the
+// player overlaid and the status line at the bottom
+func renderFrame(garden [][]*Cell, player *Player, status string) string {
+	var frame strings.Builder
+	for y, gardenRow := range garden {
+		for x, gardenCell := range gardenRow {
+			if player.X == x && player.Y == y {
+				frame.WriteString(player.Char)
+				continue
+			}
+			frame.WriteString(gardenCell.Char)
+		}
+		frame.WriteString("\n")
+	}
+	frame.WriteString("\n")
+	frame.WriteString(status)
+	frame.WriteString("\n")
+	return frame.String()
+}
+
+// demoCommits generates a deterministic set of fake commits so a garden can
+// be planted without auth or network access
+func demoCommits(maxCommits int) []*Commit {
+	handles := []string{"octocat", "hubot", "monalisa", "mona", "spamzilla"}
+	rng := rand.New(rand.NewSource(1))
+
+	commits := make([]*Commit, 0, maxCommits)
+	for i := 0; i < maxCommits; i++ {
+		commit := &Commit{
+			Handle: handles[rng.Intn(len(handles))],
+			Sha:    fmt.Sprintf("%020x%020x", rng.Uint64(), rng.Uint64()),
+		}
+		// sprinkle in the occasional merge so the demo stream gets bridges
+		if i%16 == 15 {
+			commit.Merge = fmt.Sprintf("patch-%d", i)
+		}
+		commits = append(commits, commit)
+	}
+	return commits
+}
+
+// demoSubsystems assigns deterministic subsystems to demo commits so the
+// subsystem layout can be tried without any API access
+func demoSubsystems(commits []*Commit) {
+	subsystems := []string{"api", "cmd", "docs", "internal"}
+	for _, commit := range commits {
+		if commit.Merge != "" {
+			continue
+		}
+		// demo shas are zero-padded, so index past the padding
+		commit.Subsystem = subsystems[int(commit.Sha[10])%len(subsystems)]
+	}
+}
+
+// prefersReducedMotion reports whether the user asked for animation to be
+// turned off, either with the GH_REDUCED_MOTION environment variable or the
+// "reduced_motion" config setting. Movement and input still work as usual.
+func prefersReducedMotion(configFunc func() (config.Config, error)) bool {
+	if os.Getenv("GH_REDUCED_MOTION") != "" {
+		return true
+	}
+	if configFunc == nil {
+		return false
+	}
+	cfg, err := configFunc()
+	if err != nil {
+		return false
+	}
+	setting, _ := cfg.Get(ghinstance.OverridableDefault(), "reduced_motion")
+	return setting != "" && setting != "false"
+}
+
+// configuredGlyph returns the player character from the "garden_glyph" config
+// setting, falling back to "@" when the setting is blank or not exactly one
+// character wide
+func configuredGlyph(configFunc func() (config.Config, error)) string {
+	if configFunc != nil {
+		if cfg, err := configFunc(); err == nil {
+			setting, _ := cfg.Get(ghinstance.OverridableDefault(), "garden_glyph")
+			if utf8.RuneCountInString(setting) == 1 {
+				return setting
+			}
+		}
+	}
+	return "@"
+}
+
+// configuredTrail returns the trail length from the "garden_trail" config
+// setting, falling back to no trail when the setting is blank or not a
+// positive number
+func configuredTrail(configFunc func() (config.Config, error)) int {
+	if configFunc == nil {
+		return 0
+	}
+	cfg, err := configFunc()
+	if err != nil {
+		return 0
+	}
+	setting, _ := cfg.Get(ghinstance.OverridableDefault(), "garden_trail")
+	length, err := strconv.Atoi(setting)
+	if err != nil || length < 0 {
+		return 0
+	}
+	return length
+}
+
+// trail remembers the cells the player most recently walked through, newest
+// first, so they can be drawn dimmed and restored once they fall off the end
+type trail struct {
+	cells []trailCell
+	max   int
+}
+
+type trailCell struct {
+	x int
+	y int
+}
+
+// visit marks a cell as the most recently left one and returns any cells that
+// fell off the end of the trail and need to be restored to their original
+// look. Revisited cells move back to the front instead of appearing twice.
+func (t *trail) visit(x, y int) []trailCell {
+	for i, c := range t.cells {
+		if c.x == x && c.y == y {
+			t.cells = append(t.cells[:i], t.cells[i+1:]...)
+			break
+		}
+	}
+	t.cells = append([]trailCell{{x, y}}, t.cells...)
+	if len(t.cells) <= t.max {
+		return nil
+	}
+	dropped := t.cells[t.max:]
+	t.cells = t.cells[:t.max]
+	return dropped
+}
+
+var flowers = []string{"❀", "✿", "❁", "✽"}
+
+// plantField prepares an empty plot: trees around the edge, grass inside
+func plantField(geo *Geometry, rng *rand.Rand) [][]*Cell {
+	garden := make([][]*Cell, geo.Height)
+	for y := 0; y < geo.Height; y++ {
+		garden[y] = make([]*Cell, geo.Width)
+		for x := 0; x < geo.Width; x++ {
+			if x == 0 || x == geo.Width-1 || y == 0 || y == geo.Height-1 {
+				garden[y][x] = &Cell{
+					Char:       utils.Green("^"),
+					StatusLine: "You're at the edge of the garden, under the trees.",
+				}
+				continue
+			}
+			grass := ","
+			if rng.Float64() < 0.1 {
+				grass = "."
+			}
+			garden[y][x] = &Cell{
+				Char:       utils.Green(grass),
+				StatusLine: "You're standing on a patch of grass in a field of grass.",
+			}
+		}
+	}
+	return garden
+}
+
+func plantGarden(commits []*Commit, geo *Geometry, rng *rand.Rand) [][]*Cell {
+	garden := plantField(geo, rng)
+
+	// a stream meanders from the top of the garden to the bottom
+	streamCol := make([]int, geo.Height)
+	streamX := geo.Width/4 + rng.Intn(geo.Width/2)
+	for y := 1; y < geo.Height-1; y++ {
+		streamX += rng.Intn(3) - 1
+		if streamX < 1 {
+			streamX = 1
+		} else if streamX > geo.Width-2 {
+			streamX = geo.Width - 2
+		}
+		streamCol[y] = streamX
+		garden[y][streamX] = &Cell{
+			Char:       utils.Cyan("~"),
+			StatusLine: "You're standing in the stream. Your shoes are getting wet.",
+		}
+	}
+
+	for _, commit := range commits {
+		if commit.Merge != "" {
+			continue
+		}
+		x := 1 + rng.Intn(geo.Width-2)
+		y := 1 + rng.Intn(geo.Height-2)
+		flower := flowers[int(commit.Sha[0])%len(flowers)]
+		garden[y][x] = &Cell{
+			Char:       handleColorFunc(commit.Handle)(flower),
+			StatusLine: fmt.Sprintf("You're standing at a flower called %s planted by %s.", commit.Sha[0:6], commit.Handle),
+			Handle:     commit.Handle,
+		}
+	}
+
+	// merge commits become bridges over the stream, at rows matching their
+	// place in history, so the stream can be crossed with dry shoes
+	for i, commit := range commits {
+		if commit.Merge == "" {
+			continue
+		}
+		y := 1 + (i*(geo.Height-2))/len(commits)
+		garden[y][streamCol[y]] = &Cell{
+			Char:       utils.Yellow("="),
+			StatusLine: fmt.Sprintf("You're crossing a bridge built by %s when they merged %s.", commit.Handle, commit.Merge),
+			Handle:     commit.Handle,
+		}
+	}
+
+	return garden
+}
+
+// plantGardenSubsystems lays the garden out as labeled beds, one per
+// subsystem (the primary directory a commit touched), so where a flower grows
+// says something about where its commit lives. Merge commits have no
+// subsystem of their own and are left out, as is the stream they bridge.
+func plantGardenSubsystems(commits []*Commit, geo *Geometry, rng *rand.Rand) [][]*Cell {
+	garden := plantField(geo, rng)
+
+	// group flowers by subsystem, keeping beds in order of first appearance
+	const catchAll = "elsewhere"
+	order := []string{}
+	beds := map[string][]*Commit{}
+	for _, commit := range commits {
+		if commit.Merge != "" {
+			continue
+		}
+		name := commit.Subsystem
+		if name == "" {
+			name = catchAll
+		}
+		if _, ok := beds[name]; !ok {
+			order = append(order, name)
+		}
+		beds[name] = append(beds[name], commit)
+	}
+	if len(order) == 0 {
+		return garden
+	}
+
+	// a bed narrower than its sign isn't much of a bed, so the smaller
+	// subsystems share a catch-all bed when the plot fills up
+	maxBeds := (geo.Width - 2) / 10
+	if maxBeds < 1 {
+		maxBeds = 1
+	}
+	if len(order) > maxBeds {
+		sort.SliceStable(order, func(i, j int) bool {
+			return len(beds[order[i]]) > len(beds[order[j]])
+		})
+		for _, name := range order[maxBeds-1:] {
+			if name == catchAll {
+				continue
+			}
+			beds[catchAll] = append(beds[catchAll], beds[name]...)
+			delete(beds, name)
+		}
+		order = order[:maxBeds-1]
+		hasCatchAll := false
+		for _, name := range order {
+			hasCatchAll = hasCatchAll || name == catchAll
+		}
+		if !hasCatchAll {
+			order = append(order, catchAll)
+		}
+	}
+
+	bedWidth := (geo.Width - 2) / len(order)
+	for i, name := range order {
+		x0 := 1 + i*bedWidth
+		x1 := x0 + bedWidth - 1
+		if i == len(order)-1 {
+			x1 = geo.Width - 2
+		}
+
+		// a fence separates neighboring beds
+		if i > 0 {
+			for y := 1; y < geo.Height-1; y++ {
+				garden[y][x0-1] = &Cell{
+					Char:       utils.Yellow("|"),
+					StatusLine: "You're straddling the fence between two garden beds.",
+				}
+			}
+		}
+
+		// each bed gets a sign along its top, truncated to fit
+		sign := []rune(name)
+		if room := x1 - x0 - 1; len(sign) > room {
+			if room < 0 {
+				room = 0
+			}
+			sign = sign[:room]
+		}
+		for j, r := range sign {
+			garden[1][x0+1+j] = &Cell{
+				Char:       utils.Bold(string(r)),
+				StatusLine: fmt.Sprintf("A sign marks the %s bed.", name),
+			}
+		}
+
+		for _, commit := range beds[name] {
+			x := x0 + rng.Intn(x1-x0+1)
+			y := 2 + rng.Intn(geo.Height-3)
+			flower := flowers[int(commit.Sha[0])%len(flowers)]
+			garden[y][x] = &Cell{
+				Char:       handleColorFunc(commit.Handle)(flower),
+				StatusLine: fmt.Sprintf("You're standing at a flower called %s planted by %s in the %s bed.", commit.Sha[0:6], commit.Handle, name),
+				Handle:     commit.Handle,
+			}
+		}
+	}
+
+	return garden
+}
+
+func isBlocked(geo *Geometry, x, y int) bool {
+	return x <= 0 || x >= geo.Width-1 || y <= 0 || y >= geo.Height-1
+}
+
+func drawGarden(out io.Writer, garden [][]*Cell, player *Player) {
+	moveTo(out, 0, 0)
+	for y, gardenRow := range garden {
+		for x, gardenCell := range gardenRow {
+			char := gardenCell.Char
+			if player.X == x && player.Y == y {
+				char = player.Char
+			}
+			fmt.Fprint(out, char)
+		}
+		fmt.Fprintln(out)
+	}
+}
+
+func drawCell(out io.Writer, garden [][]*Cell, x, y int) {
+	moveTo(out, x, y)
+	fmt.Fprint(out, garden[y][x].Char)
+}
+
+// drawTrailCell redraws a cell the player recently walked through in a dimmed
+// color; the cell's own colors are stripped so the dimming reads uniformly
+func drawTrailCell(out io.Writer, garden [][]*Cell, x, y int) {
+	moveTo(out, x, y)
+	fmt.Fprintf(out, "\x1b[2m%s\x1b[0m", ansiPattern.ReplaceAllString(garden[y][x].Char, ""))
+}
+
+func drawPlayer(out io.Writer, p *Player) {
+	moveTo(out, p.X, p.Y)
+	fmt.Fprint(out, p.Char)
+}
+
+// flashCell briefly highlights a cell in inverse video, reverting it after
+// flashDuration so the bump reads as feedback rather than a redraw glitch.
+func flashCell(out io.Writer, garden [][]*Cell, x, y int) {
+	moveTo(out, x, y)
+	fmt.Fprintf(out, "\x1b[7m%s\x1b[0m", garden[y][x].Char)
+	time.Sleep(flashDuration)
+	drawCell(out, garden, x, y)
+}
+
+func drawStatus(out io.Writer, geo *Geometry, text string) {
+	moveTo(out, 0, geo.Height)
+	fmt.Fprint(out, "\x1b[K") // clear the old status line
+	fmt.Fprint(out, text)
+}
+
+func moveTo(out io.Writer, x, y int) {
+	fmt.Fprintf(out, "\x1b[%d;%dH", y+1, x+1)
+}
+
+func clearScreen(out io.Writer) {
+	fmt.Fprint(out, "\x1b[2J")
+	moveTo(out, 0, 0)
+}
+
+func handleColorFunc(handle string) func(string) string {
+	rainbow := []func(string) string{
+		utils.Magenta,
+		utils.Red,
+		utils.Yellow,
+		utils.Green,
+		utils.Cyan,
+		utils.Blue,
+	}
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(handle))
+	return rainbow[int(h.Sum32())%len(rainbow)]
+}
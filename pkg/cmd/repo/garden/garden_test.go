@@ -0,0 +1,273 @@
+package garden
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/cli/cli/internal/config"
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_trail_visit(t *testing.T) {
+	tr := &trail{max: 2}
+
+	assert.Nil(t, tr.visit(1, 1))
+	assert.Nil(t, tr.visit(2, 1))
+	assert.Equal(t, []trailCell{{1, 1}}, tr.visit(3, 1))
+	assert.Equal(t, []trailCell{{3, 1}, {2, 1}}, tr.cells)
+
+	// revisiting a remembered cell moves it to the front without evicting
+	assert.Nil(t, tr.visit(2, 1))
+	assert.Equal(t, []trailCell{{2, 1}, {3, 1}}, tr.cells)
+
+	assert.Equal(t, []trailCell{{3, 1}}, tr.visit(4, 1))
+	assert.Equal(t, []trailCell{{4, 1}, {2, 1}}, tr.cells)
+}
+
+func Test_plantGarden_deterministic(t *testing.T) {
+	commits := demoCommits(100)
+	geo := &Geometry{
+		Width:      40,
+		Height:     20,
+		Repository: ghrepo.New("octocat", "garden-demo"),
+	}
+
+	first := plantGarden(commits, geo, rand.New(rand.NewSource(42)))
+	second := plantGarden(commits, geo, rand.New(rand.NewSource(42)))
+	if !reflect.DeepEqual(first, second) {
+		t.Error("planting twice from the same seed grew different gardens")
+	}
+
+	other := plantGarden(commits, geo, rand.New(rand.NewSource(7)))
+	if reflect.DeepEqual(first, other) {
+		t.Error("planting from a different seed grew the same garden")
+	}
+
+	// the plot keeps its dimensions and its border of trees
+	assert.Equal(t, geo.Height, len(first))
+	for _, row := range first {
+		assert.Equal(t, geo.Width, len(row))
+	}
+	assert.Equal(t, "^", ansiPattern.ReplaceAllString(first[0][0].Char, ""))
+
+	flowerCount := 0
+	bridgeCount := 0
+	for _, row := range first {
+		for _, cell := range row {
+			switch ansiPattern.ReplaceAllString(cell.Char, "") {
+			case "❀", "✿", "❁", "✽":
+				flowerCount++
+			case "=":
+				bridgeCount++
+			}
+		}
+	}
+	if flowerCount == 0 {
+		t.Error("no flowers were planted")
+	}
+	if bridgeCount == 0 {
+		t.Error("no bridges were built for the merge commits")
+	}
+}
+
+func Test_plantGardenSubsystems(t *testing.T) {
+	commits := demoCommits(64)
+	demoSubsystems(commits)
+	geo := &Geometry{
+		Width:      40,
+		Height:     20,
+		Repository: ghrepo.New("octocat", "garden-demo"),
+	}
+
+	first := plantGardenSubsystems(commits, geo, rand.New(rand.NewSource(42)))
+	second := plantGardenSubsystems(commits, geo, rand.New(rand.NewSource(42)))
+	if !reflect.DeepEqual(first, second) {
+		t.Error("planting twice from the same seed grew different gardens")
+	}
+
+	signRow := ""
+	for _, cell := range first[1] {
+		signRow += ansiPattern.ReplaceAllString(cell.Char, "")
+	}
+	// four demo subsystems on a 40-column plot overflow the three beds that
+	// fit, so the smallest ones share the catch-all bed
+	assert.Contains(t, signRow, "elsewhere")
+
+	fenceCount := 0
+	for _, row := range first {
+		for _, cell := range row {
+			if ansiPattern.ReplaceAllString(cell.Char, "") == "|" {
+				fenceCount++
+			}
+		}
+	}
+	if fenceCount == 0 {
+		t.Error("no fences separate the beds")
+	}
+}
+
+func Test_plantGardenSubsystems_minimumPlot(t *testing.T) {
+	commits := demoCommits(8)
+	demoSubsystems(commits)
+	geo := &Geometry{
+		Width:      4,
+		Height:     4,
+		Repository: ghrepo.New("octocat", "garden-demo"),
+	}
+
+	// the smallest plot gardenRun allows must not panic picking spots
+	garden := plantGardenSubsystems(commits, geo, rand.New(rand.NewSource(42)))
+	assert.Equal(t, geo.Height, len(garden))
+}
+
+func Test_frameHashRun(t *testing.T) {
+	walk := func(moves string) string {
+		io, stdin, stdout, _ := iostreams.Test()
+		stdin.WriteString(moves)
+
+		opts := &GardenOptions{
+			IO:      io,
+			Glyph:   "@",
+			Layout:  "random",
+			Seed:    42,
+			SeedSet: true,
+		}
+		fetchCommits := func(maxCommits int) ([]*Commit, error) {
+			return demoCommits(maxCommits), nil
+		}
+
+		err := frameHashRun(opts, fetchCommits, ghrepo.New("octocat", "garden-demo"))
+		assert.NoError(t, err)
+		return stdout.String()
+	}
+
+	output := walk("ddwwaq")
+
+	lines := strings.Split(strings.TrimSuffix(output, "\n"), "\n")
+	// one frame before walking, then one per move; "q" prints nothing
+	assert.Equal(t, 6, len(lines))
+	lineFormat := regexp.MustCompile(`^\d+\t[0-9a-f]{16}$`)
+	for i, line := range lines {
+		if !lineFormat.MatchString(line) {
+			t.Errorf("line %d does not look like a frame hash: %q", i, line)
+		}
+		assert.True(t, strings.HasPrefix(line, fmt.Sprintf("%d\t", i)))
+	}
+
+	// the same seed and moves must hash to the same frames every time
+	assert.Equal(t, output, walk("ddwwaq"))
+
+	// a different walk diverges after the shared opening frame
+	other := strings.Split(strings.TrimSuffix(walk("ssq"), "\n"), "\n")
+	assert.Equal(t, lines[0], other[0])
+	assert.NotEqual(t, lines[1], other[1])
+}
+
+func Test_shareCommand(t *testing.T) {
+	repo := ghrepo.New("cli", "cli")
+
+	cmd := shareCommand(&GardenOptions{Layout: "random"}, repo, 42)
+	assert.Equal(t, "gh repo garden cli/cli --seed 42", cmd)
+
+	cmd = shareCommand(&GardenOptions{Demo: true, Layout: "subsystem"}, repo, 7)
+	assert.Equal(t, "gh repo garden --demo --layout subsystem --seed 7", cmd)
+}
+
+func Test_configuredGlyph(t *testing.T) {
+	blank := func() (config.Config, error) {
+		return config.NewBlankConfig(), nil
+	}
+	assert.Equal(t, "@", configuredGlyph(blank))
+	assert.Equal(t, "@", configuredGlyph(nil))
+
+	set := func() (config.Config, error) {
+		return config.NewFromString("garden_glyph: ❀\n"), nil
+	}
+	assert.Equal(t, "❀", configuredGlyph(set))
+
+	tooWide := func() (config.Config, error) {
+		return config.NewFromString("garden_glyph: uhoh\n"), nil
+	}
+	assert.Equal(t, "@", configuredGlyph(tooWide))
+}
+
+func Test_configuredTrail(t *testing.T) {
+	blank := func() (config.Config, error) {
+		return config.NewBlankConfig(), nil
+	}
+	assert.Equal(t, 0, configuredTrail(blank))
+	assert.Equal(t, 0, configuredTrail(nil))
+
+	set := func() (config.Config, error) {
+		return config.NewFromString("garden_trail: \"6\"\n"), nil
+	}
+	assert.Equal(t, 6, configuredTrail(set))
+
+	invalid := func() (config.Config, error) {
+		return config.NewFromString("garden_trail: often\n"), nil
+	}
+	assert.Equal(t, 0, configuredTrail(invalid))
+}
+
+func Test_mergeLabel(t *testing.T) {
+	tests := []struct {
+		message string
+		want    string
+	}{
+		{
+			message: "Merge pull request #123 from cli/feature\n\nAdd a feature",
+			want:    "pull request #123",
+		},
+		{
+			message: "Merge branch 'trunk' into release",
+			want:    "branch 'trunk'",
+		},
+		{
+			message: "Merge remote changes",
+			want:    "two branches",
+		},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, mergeLabel(tt.message))
+	}
+}
+
+func Test_primarySubsystem(t *testing.T) {
+	tests := []struct {
+		name  string
+		paths []string
+		want  string
+	}{
+		{
+			name:  "majority directory wins",
+			paths: []string{"api/client.go", "api/queries.go", "cmd/main.go"},
+			want:  "api",
+		},
+		{
+			name:  "root files",
+			paths: []string{"README.md", "go.mod"},
+			want:  "./",
+		},
+		{
+			name:  "ties break alphabetically",
+			paths: []string{"cmd/main.go", "api/client.go"},
+			want:  "api",
+		},
+		{
+			name:  "no files",
+			paths: []string{},
+			want:  "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, primarySubsystem(tt.paths))
+		})
+	}
+}
@@ -0,0 +1,152 @@
+package garden
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/internal/ghrepo"
+)
+
+var mergePullPattern = regexp.MustCompile(`^Merge pull request (#\d+) from \S+`)
+var mergeBranchPattern = regexp.MustCompile(`^Merge branch '([^']+)'`)
+
+// mergeLabel names what a merge commit merged based on its subject line
+func mergeLabel(message string) string {
+	subject := strings.SplitN(message, "\n", 2)[0]
+	if m := mergePullPattern.FindStringSubmatch(subject); m != nil {
+		return fmt.Sprintf("pull request %s", m[1])
+	}
+	if m := mergeBranchPattern.FindStringSubmatch(subject); m != nil {
+		return fmt.Sprintf("branch '%s'", m[1])
+	}
+	return "two branches"
+}
+
+// fetching the file list of every commit gets expensive, so subsystem lookups
+// are capped; commits beyond the cap keep an empty subsystem and end up in the
+// catch-all bed
+const maxSubsystemLookups = 200
+
+// annotateSubsystems fills in the primary directory each commit touched by
+// fetching the commit's file list
+func annotateSubsystems(client *http.Client, repo ghrepo.Interface, commits []*Commit) error {
+	apiClient := api.NewClientFromHTTP(client)
+
+	lookups := 0
+	for _, commit := range commits {
+		if commit.Merge != "" {
+			continue
+		}
+		if lookups >= maxSubsystemLookups {
+			break
+		}
+		lookups++
+
+		var result struct {
+			Files []struct {
+				Filename string
+			}
+		}
+		path := fmt.Sprintf("repos/%s/commits/%s", ghrepo.FullName(repo), commit.Sha)
+		err := apiClient.REST(repo.RepoHost(), "GET", path, nil, &result)
+		if err != nil {
+			return err
+		}
+
+		paths := make([]string, 0, len(result.Files))
+		for _, f := range result.Files {
+			paths = append(paths, f.Filename)
+		}
+		commit.Subsystem = primarySubsystem(paths)
+	}
+
+	return nil
+}
+
+// primarySubsystem returns the top-level directory most of the paths live in;
+// files at the repository root count toward "./"
+func primarySubsystem(paths []string) string {
+	counts := map[string]int{}
+	for _, p := range paths {
+		dir := "./"
+		if ix := strings.IndexRune(p, '/'); ix >= 0 {
+			dir = p[:ix]
+		}
+		counts[dir]++
+	}
+
+	best := ""
+	for dir, n := range counts {
+		if best == "" || n > counts[best] || (n == counts[best] && dir < best) {
+			best = dir
+		}
+	}
+	return best
+}
+
+func getCommits(client *http.Client, repo ghrepo.Interface, maxCommits int) ([]*Commit, error) {
+	type Item struct {
+		Author struct {
+			Login string
+		}
+		Sha    string
+		Commit struct {
+			Author struct {
+				Email string
+			}
+			Message string
+		}
+		Parents []struct {
+			Sha string
+		}
+	}
+
+	type Result []Item
+
+	commits := []*Commit{}
+
+	pathF := func(page int) string {
+		return fmt.Sprintf("repos/%s/commits?per_page=100&page=%d", ghrepo.FullName(repo), page)
+	}
+
+	apiClient := api.NewClientFromHTTP(client)
+	page := 1
+	for len(commits) < maxCommits {
+		result := Result{}
+		err := apiClient.REST(repo.RepoHost(), "GET", pathF(page), nil, &result)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range result {
+			handle := r.Author.Login
+			if handle == "" {
+				handle = r.Commit.Author.Email
+			}
+			commit := &Commit{
+				Handle: handle,
+				Sha:    r.Sha,
+			}
+			if len(r.Parents) > 1 {
+				commit.Merge = mergeLabel(r.Commit.Message)
+			}
+			commits = append(commits, commit)
+		}
+		if len(result) < 100 {
+			break
+		}
+		page++
+	}
+	if len(commits) > maxCommits {
+		commits = commits[:maxCommits]
+	}
+
+	// reverse to get older commits first
+	for i, j := 0, len(commits)-1; i < j; i, j = i+1, j-1 {
+		commits[i], commits[j] = commits[j], commits[i]
+	}
+
+	return commits, nil
+}
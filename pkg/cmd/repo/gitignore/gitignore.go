@@ -0,0 +1,21 @@
+package gitignore
+
+import (
+	gitIgnoreListCmd "github.com/cli/cli/pkg/cmd/repo/gitignore/list"
+	gitIgnoreViewCmd "github.com/cli/cli/pkg/cmd/repo/gitignore/view"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdGitIgnore(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gitignore <command>",
+		Short: "Explore repository gitignore templates",
+		Long:  "List and preview the gitignore templates accepted by `gh repo create --gitignore`.",
+	}
+
+	cmd.AddCommand(gitIgnoreListCmd.NewCmdList(f, nil))
+	cmd.AddCommand(gitIgnoreViewCmd.NewCmdView(f, nil))
+
+	return cmd
+}
@@ -0,0 +1,32 @@
+package list
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/pkg/httpmock"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_listRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	reg.Register(
+		httpmock.REST("GET", "gitignore/templates"),
+		httpmock.StringResponse(`["Go", "Node", "Python"]`))
+
+	io, _, stdout, _ := iostreams.Test()
+
+	opts := &ListOptions{
+		IO: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+	}
+
+	err := listRun(opts)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "Go\nNode\nPython\n", stdout.String())
+	reg.Verify(t)
+}
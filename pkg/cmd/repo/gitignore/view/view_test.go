@@ -0,0 +1,53 @@
+package view
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/pkg/httpmock"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_viewRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	reg.Register(
+		httpmock.REST("GET", "gitignore/templates/Go"),
+		httpmock.StringResponse(`{"name": "Go", "source": "# Binaries\n*.exe\n"}`))
+
+	io, _, stdout, _ := iostreams.Test()
+
+	opts := &ViewOptions{
+		Name: "Go",
+		IO:   io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+	}
+
+	err := viewRun(opts)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "# Binaries\n*.exe\n", stdout.String())
+	reg.Verify(t)
+}
+
+func Test_viewRun_notFound(t *testing.T) {
+	reg := &httpmock.Registry{}
+	reg.Register(
+		httpmock.REST("GET", "gitignore/templates/Whatever"),
+		httpmock.StatusStringResponse(404, `{"message": "Not Found"}`))
+
+	io, _, _, _ := iostreams.Test()
+
+	opts := &ViewOptions{
+		Name: "Whatever",
+		IO:   io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+	}
+
+	err := viewRun(opts)
+	assert.EqualError(t, err, "\"Whatever\" is not a valid gitignore template name; run `gh repo gitignore list` for options")
+}
@@ -0,0 +1,21 @@
+package license
+
+import (
+	licenseListCmd "github.com/cli/cli/pkg/cmd/repo/license/list"
+	licenseViewCmd "github.com/cli/cli/pkg/cmd/repo/license/view"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdLicense(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "license <command>",
+		Short: "Explore repository license templates",
+		Long:  "List and preview the license templates accepted by `gh repo create --license`.",
+	}
+
+	cmd.AddCommand(licenseListCmd.NewCmdList(f, nil))
+	cmd.AddCommand(licenseViewCmd.NewCmdView(f, nil))
+
+	return cmd
+}
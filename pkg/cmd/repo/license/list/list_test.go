@@ -0,0 +1,35 @@
+package list
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/pkg/httpmock"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_listRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	reg.Register(
+		httpmock.REST("GET", "licenses"),
+		httpmock.StringResponse(`[
+			{"key": "mit", "name": "MIT License", "spdx_id": "MIT"},
+			{"key": "gpl-3.0", "name": "GNU General Public License v3.0", "spdx_id": "GPL-3.0"}
+		]`))
+
+	io, _, stdout, _ := iostreams.Test()
+
+	opts := &ListOptions{
+		IO: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+	}
+
+	err := listRun(opts)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "mit\tMIT\tMIT License\ngpl-3.0\tGPL-3.0\tGNU General Public License v3.0\n", stdout.String())
+	reg.Verify(t)
+}
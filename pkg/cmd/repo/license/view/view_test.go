@@ -0,0 +1,53 @@
+package view
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/pkg/httpmock"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_viewRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	reg.Register(
+		httpmock.REST("GET", "licenses/mit"),
+		httpmock.StringResponse(`{"name": "MIT License", "body": "MIT License\n\nPermission is hereby granted..."}`))
+
+	io, _, stdout, _ := iostreams.Test()
+
+	opts := &ViewOptions{
+		Key: "mit",
+		IO:  io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+	}
+
+	err := viewRun(opts)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "MIT License\n\nPermission is hereby granted...\n", stdout.String())
+	reg.Verify(t)
+}
+
+func Test_viewRun_notFound(t *testing.T) {
+	reg := &httpmock.Registry{}
+	reg.Register(
+		httpmock.REST("GET", "licenses/whatever"),
+		httpmock.StatusStringResponse(404, `{"message": "Not Found"}`))
+
+	io, _, _, _ := iostreams.Test()
+
+	opts := &ViewOptions{
+		Key: "whatever",
+		IO:  io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+	}
+
+	err := viewRun(opts)
+	assert.EqualError(t, err, "\"whatever\" is not a valid license template name; run `gh repo license list` for options")
+}
@@ -0,0 +1,133 @@
+package rename
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/context"
+	"github.com/cli/cli/git"
+	"github.com/cli/cli/internal/config"
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/cli/cli/pkg/prompt"
+	"github.com/cli/cli/utils"
+	"github.com/spf13/cobra"
+)
+
+type RenameOptions struct {
+	HttpClient func() (*http.Client, error)
+	Config     func() (config.Config, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+	Remotes    func() (context.Remotes, error)
+
+	NewRepoName string
+}
+
+func NewCmdRename(f *cmdutil.Factory, runF func(*RenameOptions) error) *cobra.Command {
+	opts := &RenameOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		Config:     f.Config,
+		BaseRepo:   f.BaseRepo,
+		Remotes:    f.Remotes,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "rename [<new-name>]",
+		Short: "Rename a repository",
+		Long: heredoc.Doc(`
+			Rename the repository for the current directory.
+
+			When run inside a local clone, the git remote pointing at the repository is
+			updated to use the new name.
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.NewRepoName = args[0]
+			}
+
+			if opts.NewRepoName == "" && !opts.IO.CanPrompt() {
+				return &cmdutil.FlagError{Err: fmt.Errorf("new name argument required when not running interactively")}
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return renameRun(opts)
+		},
+	}
+
+	return cmd
+}
+
+func renameRun(opts *RenameOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	newRepoName := opts.NewRepoName
+	if newRepoName == "" {
+		err = prompt.SurveyAskOne(
+			&survey.Input{Message: fmt.Sprintf("Rename %s to:", ghrepo.FullName(repo))},
+			&newRepoName,
+			survey.WithValidator(survey.Required))
+		if err != nil {
+			return fmt.Errorf("could not prompt: %w", err)
+		}
+	}
+
+	newRepo, err := api.RenameRepo(apiClient, repo, newRepoName)
+	if err != nil {
+		return fmt.Errorf("could not rename repository: %w", err)
+	}
+
+	connectedToTerminal := opts.IO.IsStdoutTTY()
+
+	if connectedToTerminal {
+		fmt.Fprintf(opts.IO.Out, "%s Renamed repository %s\n", utils.GreenCheck(), ghrepo.FullName(newRepo))
+	}
+
+	remotes, err := opts.Remotes()
+	if err != nil {
+		// not in a local git repository; nothing to update
+		return nil
+	}
+	remote, err := remotes.FindByRepo(repo.RepoOwner(), repo.RepoName())
+	if err != nil {
+		return nil
+	}
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+	protocol, err := cfg.Get(repo.RepoHost(), "git_protocol")
+	if err != nil {
+		return err
+	}
+
+	remoteURL := ghrepo.FormatRemoteURL(newRepo, protocol)
+	err = git.UpdateRemoteURL(remote.Name, remoteURL)
+	if err != nil {
+		return fmt.Errorf("failed to update remote %q: %w", remote.Name, err)
+	}
+
+	if connectedToTerminal {
+		fmt.Fprintf(opts.IO.Out, "%s Updated the %q remote\n", utils.GreenCheck(), remote.Name)
+	}
+
+	return nil
+}
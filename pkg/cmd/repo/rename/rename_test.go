@@ -0,0 +1,107 @@
+package rename
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/cli/cli/context"
+	"github.com/cli/cli/git"
+	"github.com/cli/cli/internal/config"
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/httpmock"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/cli/cli/pkg/prompt"
+	"github.com/cli/cli/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_renameRun(t *testing.T) {
+	tests := []struct {
+		name       string
+		opts       *RenameOptions
+		askStubs   func(*prompt.AskStubber)
+		isTTY      bool
+		wantRemote string
+		wantStdout string
+	}{
+		{
+			name:       "rename with argument",
+			opts:       &RenameOptions{NewRepoName: "NEW_REPO"},
+			isTTY:      true,
+			wantRemote: "git remote set-url origin https://github.com/OWNER/NEW_REPO.git",
+			wantStdout: "✓ Renamed repository OWNER/NEW_REPO\n✓ Updated the \"origin\" remote\n",
+		},
+		{
+			name:  "rename prompting for name",
+			opts:  &RenameOptions{},
+			isTTY: true,
+			askStubs: func(as *prompt.AskStubber) {
+				as.StubOne("NEW_REPO")
+			},
+			wantRemote: "git remote set-url origin https://github.com/OWNER/NEW_REPO.git",
+			wantStdout: "✓ Renamed repository OWNER/NEW_REPO\n✓ Updated the \"origin\" remote\n",
+		},
+		{
+			name:       "nontty",
+			opts:       &RenameOptions{NewRepoName: "NEW_REPO"},
+			wantRemote: "git remote set-url origin https://github.com/OWNER/NEW_REPO.git",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			reg.Register(
+				httpmock.REST("PATCH", "repos/OWNER/REPO"),
+				httpmock.StringResponse(`
+				{ "node_id": "THE-ID",
+				  "name": "NEW_REPO",
+				  "clone_url": "https://github.com/OWNER/NEW_REPO.git",
+				  "created_at": "2011-01-26T19:01:12Z",
+				  "owner": {"login": "OWNER"}
+				}`))
+
+			tt.opts.HttpClient = func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			}
+			tt.opts.Config = func() (config.Config, error) {
+				return config.NewBlankConfig(), nil
+			}
+			tt.opts.BaseRepo = func() (ghrepo.Interface, error) {
+				return ghrepo.New("OWNER", "REPO"), nil
+			}
+			tt.opts.Remotes = func() (context.Remotes, error) {
+				return context.Remotes{
+					{
+						Remote: &git.Remote{Name: "origin"},
+						Repo:   ghrepo.New("OWNER", "REPO"),
+					},
+				}, nil
+			}
+
+			io, _, stdout, _ := iostreams.Test()
+			io.SetStdinTTY(tt.isTTY)
+			io.SetStdoutTTY(tt.isTTY)
+			tt.opts.IO = io
+
+			as, teardown := prompt.InitAskStubber()
+			defer teardown()
+			if tt.askStubs != nil {
+				tt.askStubs(as)
+			}
+
+			cs, restore := test.InitCmdStubber()
+			defer restore()
+			cs.Stub("") // git remote set-url
+
+			err := renameRun(tt.opts)
+			assert.NoError(t, err)
+
+			assert.Equal(t, 1, cs.Count)
+			assert.Equal(t, tt.wantRemote, strings.Join(cs.Calls[0].Args, " "))
+			assert.Equal(t, tt.wantStdout, stdout.String())
+			reg.Verify(t)
+		})
+	}
+}
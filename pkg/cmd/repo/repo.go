@@ -2,10 +2,24 @@ package repo
 
 import (
 	"github.com/MakeNowJust/heredoc"
+	repoArchiveCmd "github.com/cli/cli/pkg/cmd/repo/archive"
+	autolinkCmd "github.com/cli/cli/pkg/cmd/repo/autolink"
 	repoCloneCmd "github.com/cli/cli/pkg/cmd/repo/clone"
 	repoCreateCmd "github.com/cli/cli/pkg/cmd/repo/create"
 	creditsCmd "github.com/cli/cli/pkg/cmd/repo/credits"
+	repoDeleteCmd "github.com/cli/cli/pkg/cmd/repo/delete"
+	deployKeyCmd "github.com/cli/cli/pkg/cmd/repo/deploy-key"
+	repoEditCmd "github.com/cli/cli/pkg/cmd/repo/edit"
 	repoForkCmd "github.com/cli/cli/pkg/cmd/repo/fork"
+	gardenCmd "github.com/cli/cli/pkg/cmd/repo/garden"
+	gitIgnoreCmd "github.com/cli/cli/pkg/cmd/repo/gitignore"
+	licenseCmd "github.com/cli/cli/pkg/cmd/repo/license"
+	repoRenameCmd "github.com/cli/cli/pkg/cmd/repo/rename"
+	repoSetDefaultCmd "github.com/cli/cli/pkg/cmd/repo/setdefault"
+	repoStatsCmd "github.com/cli/cli/pkg/cmd/repo/stats"
+	repoSyncCmd "github.com/cli/cli/pkg/cmd/repo/sync"
+	repoTransferCmd "github.com/cli/cli/pkg/cmd/repo/transfer"
+	repoUnarchiveCmd "github.com/cli/cli/pkg/cmd/repo/unarchive"
 	repoViewCmd "github.com/cli/cli/pkg/cmd/repo/view"
 	"github.com/cli/cli/pkg/cmdutil"
 	"github.com/spf13/cobra"
@@ -35,7 +49,21 @@ func NewCmdRepo(f *cmdutil.Factory) *cobra.Command {
 	cmd.AddCommand(repoForkCmd.NewCmdFork(f, nil))
 	cmd.AddCommand(repoCloneCmd.NewCmdClone(f, nil))
 	cmd.AddCommand(repoCreateCmd.NewCmdCreate(f, nil))
+	cmd.AddCommand(repoDeleteCmd.NewCmdDelete(f, nil))
+	cmd.AddCommand(repoEditCmd.NewCmdEdit(f, nil))
+	cmd.AddCommand(deployKeyCmd.NewCmdDeployKey(f))
+	cmd.AddCommand(autolinkCmd.NewCmdAutolink(f))
+	cmd.AddCommand(licenseCmd.NewCmdLicense(f))
+	cmd.AddCommand(gitIgnoreCmd.NewCmdGitIgnore(f))
+	cmd.AddCommand(repoRenameCmd.NewCmdRename(f, nil))
+	cmd.AddCommand(repoSyncCmd.NewCmdSync(f, nil))
+	cmd.AddCommand(repoSetDefaultCmd.NewCmdSetDefault(f, nil))
+	cmd.AddCommand(repoStatsCmd.NewCmdStats(f, nil))
+	cmd.AddCommand(repoTransferCmd.NewCmdTransfer(f, nil))
+	cmd.AddCommand(repoArchiveCmd.NewCmdArchive(f, nil))
+	cmd.AddCommand(repoUnarchiveCmd.NewCmdUnarchive(f, nil))
 	cmd.AddCommand(creditsCmd.NewCmdRepoCredits(f, nil))
+	cmd.AddCommand(gardenCmd.NewCmdGarden(f, nil))
 
 	return cmd
 }
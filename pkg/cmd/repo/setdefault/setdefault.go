@@ -0,0 +1,152 @@
+package setdefault
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/context"
+	"github.com/cli/cli/git"
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/cli/cli/pkg/prompt"
+	"github.com/cli/cli/utils"
+	"github.com/spf13/cobra"
+)
+
+type SetDefaultOptions struct {
+	IO      *iostreams.IOStreams
+	Remotes func() (context.Remotes, error)
+
+	RepoArg   string
+	ViewMode  bool
+	UnsetMode bool
+}
+
+func NewCmdSetDefault(f *cmdutil.Factory, runF func(*SetDefaultOptions) error) *cobra.Command {
+	opts := &SetDefaultOptions{
+		IO:      f.IOStreams,
+		Remotes: f.Remotes,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "set-default [<repository>]",
+		Short: "Configure the default repository used for API operations",
+		Long: heredoc.Doc(`
+			When a local repository has multiple git remotes pointing to GitHub, gh
+			cannot always tell which repository its commands should target. This
+			command records which remote is the default for the current directory
+			so that you are not asked again.
+		`),
+		Example: heredoc.Doc(`
+			$ gh repo set-default
+			$ gh repo set-default OWNER/REPO
+			$ gh repo set-default --view
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.RepoArg = args[0]
+			}
+
+			if opts.ViewMode && opts.UnsetMode {
+				return &cmdutil.FlagError{Err: errors.New("specify only one of `--view` or `--unset`")}
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return setDefaultRun(opts)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&opts.ViewMode, "view", "v", false, "View the current default repository")
+	cmd.Flags().BoolVarP(&opts.UnsetMode, "unset", "u", false, "Unset the current default repository")
+
+	return cmd
+}
+
+func setDefaultRun(opts *SetDefaultOptions) error {
+	remotes, err := opts.Remotes()
+	if err != nil {
+		return err
+	}
+
+	var currentDefault *context.Remote
+	for _, r := range remotes {
+		if r.Resolved == "base" {
+			currentDefault = r
+			break
+		}
+	}
+
+	if opts.ViewMode {
+		if currentDefault == nil {
+			fmt.Fprintln(opts.IO.Out, "no default repository has been set; use `gh repo set-default` to select one")
+		} else {
+			fmt.Fprintln(opts.IO.Out, ghrepo.FullName(currentDefault))
+		}
+		return nil
+	}
+
+	if opts.UnsetMode {
+		if currentDefault == nil {
+			fmt.Fprintln(opts.IO.Out, "no default repository has been set")
+			return nil
+		}
+		if err := git.UnsetRemoteResolution(currentDefault.Name); err != nil {
+			return err
+		}
+		if opts.IO.IsStdoutTTY() {
+			fmt.Fprintf(opts.IO.Out, "%s Unset %s as the default repository\n", utils.GreenCheck(), ghrepo.FullName(currentDefault))
+		}
+		return nil
+	}
+
+	var selectedRemote *context.Remote
+	if opts.RepoArg != "" {
+		repo, err := ghrepo.FromFullName(opts.RepoArg)
+		if err != nil {
+			return err
+		}
+		selectedRemote, err = remotes.FindByRepo(repo.RepoOwner(), repo.RepoName())
+		if err != nil {
+			return fmt.Errorf("%s does not correspond to any git remotes", ghrepo.FullName(repo))
+		}
+	} else {
+		if !opts.IO.CanPrompt() {
+			return &cmdutil.FlagError{Err: errors.New("repository argument required when not running interactively")}
+		}
+
+		options := make([]string, len(remotes))
+		for i, r := range remotes {
+			options[i] = ghrepo.FullName(r)
+		}
+		var selected int
+		err := prompt.SurveyAskOne(&survey.Select{
+			Message: "Which repository should be the default?",
+			Options: options,
+		}, &selected)
+		if err != nil {
+			return fmt.Errorf("could not prompt: %w", err)
+		}
+		selectedRemote = remotes[selected]
+	}
+
+	if currentDefault != nil {
+		if err := git.UnsetRemoteResolution(currentDefault.Name); err != nil {
+			return err
+		}
+	}
+	if err := git.SetRemoteResolution(selectedRemote.Name, "base"); err != nil {
+		return err
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		fmt.Fprintf(opts.IO.Out, "%s Set %s as the default repository for the current directory\n", utils.GreenCheck(), ghrepo.FullName(selectedRemote))
+	}
+
+	return nil
+}
@@ -0,0 +1,137 @@
+package setdefault
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cli/cli/context"
+	"github.com/cli/cli/git"
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/cli/cli/pkg/prompt"
+	"github.com/cli/cli/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func stubRemotes(resolved string) context.Remotes {
+	return context.Remotes{
+		{
+			Remote: &git.Remote{Name: "upstream", Resolved: resolved},
+			Repo:   ghrepo.New("PARENT", "REPO"),
+		},
+		{
+			Remote: &git.Remote{Name: "origin"},
+			Repo:   ghrepo.New("OWNER", "REPO"),
+		},
+	}
+}
+
+func Test_setDefaultRun(t *testing.T) {
+	tests := []struct {
+		name       string
+		opts       SetDefaultOptions
+		resolved   string
+		isTTY      bool
+		askStubs   func(*prompt.AskStubber)
+		wantStdout string
+		wantErr    string
+		wantGit    []string
+	}{
+		{
+			name:       "set by argument",
+			opts:       SetDefaultOptions{RepoArg: "OWNER/REPO"},
+			isTTY:      true,
+			wantStdout: "✓ Set OWNER/REPO as the default repository for the current directory\n",
+			wantGit:    []string{"git config --add remote.origin.gh-resolved base"},
+		},
+		{
+			name:       "set by argument replaces previous default",
+			opts:       SetDefaultOptions{RepoArg: "OWNER/REPO"},
+			resolved:   "base",
+			isTTY:      true,
+			wantStdout: "✓ Set OWNER/REPO as the default repository for the current directory\n",
+			wantGit: []string{
+				"git config --unset-all remote.upstream.gh-resolved",
+				"git config --add remote.origin.gh-resolved base",
+			},
+		},
+		{
+			name:  "set interactively",
+			opts:  SetDefaultOptions{},
+			isTTY: true,
+			askStubs: func(as *prompt.AskStubber) {
+				as.StubOne(0)
+			},
+			wantStdout: "✓ Set PARENT/REPO as the default repository for the current directory\n",
+			wantGit:    []string{"git config --add remote.upstream.gh-resolved base"},
+		},
+		{
+			name:    "argument does not match a remote",
+			opts:    SetDefaultOptions{RepoArg: "monalisa/hello"},
+			isTTY:   true,
+			wantErr: "monalisa/hello does not correspond to any git remotes",
+		},
+		{
+			name:    "non-interactive without argument",
+			opts:    SetDefaultOptions{},
+			wantErr: "repository argument required when not running interactively",
+		},
+		{
+			name:       "view with default set",
+			opts:       SetDefaultOptions{ViewMode: true},
+			resolved:   "base",
+			wantStdout: "PARENT/REPO\n",
+		},
+		{
+			name:       "view with no default",
+			opts:       SetDefaultOptions{ViewMode: true},
+			wantStdout: "no default repository has been set; use `gh repo set-default` to select one\n",
+		},
+		{
+			name:       "unset",
+			opts:       SetDefaultOptions{UnsetMode: true},
+			resolved:   "base",
+			isTTY:      true,
+			wantStdout: "✓ Unset PARENT/REPO as the default repository\n",
+			wantGit:    []string{"git config --unset-all remote.upstream.gh-resolved"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, stdout, _ := iostreams.Test()
+			io.SetStdoutTTY(tt.isTTY)
+			io.SetStdinTTY(tt.isTTY)
+
+			tt.opts.IO = io
+			tt.opts.Remotes = func() (context.Remotes, error) {
+				return stubRemotes(tt.resolved), nil
+			}
+
+			cs, restoreCmds := test.InitCmdStubber()
+			defer restoreCmds()
+			for range tt.wantGit {
+				cs.Stub("")
+			}
+
+			as, restoreAsk := prompt.InitAskStubber()
+			defer restoreAsk()
+			if tt.askStubs != nil {
+				tt.askStubs(as)
+			}
+
+			err := setDefaultRun(&tt.opts)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.wantStdout, stdout.String())
+			assert.Equal(t, len(tt.wantGit), cs.Count)
+			for i, w := range tt.wantGit {
+				assert.Equal(t, w, strings.Join(cs.Calls[i].Args, " "))
+			}
+		})
+	}
+}
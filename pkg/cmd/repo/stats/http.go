@@ -0,0 +1,62 @@
+package stats
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/internal/ghrepo"
+)
+
+type trafficSummary struct {
+	Count   int `json:"count"`
+	Uniques int `json:"uniques"`
+}
+
+type referrer struct {
+	Referrer string `json:"referrer"`
+	Count    int    `json:"count"`
+	Uniques  int    `json:"uniques"`
+}
+
+type contributor struct {
+	Login         string `json:"login"`
+	Contributions int    `json:"contributions"`
+}
+
+type repoStats struct {
+	Clones       trafficSummary `json:"clones"`
+	Views        trafficSummary `json:"views"`
+	Referrers    []referrer     `json:"referrers"`
+	Contributors []contributor  `json:"contributors"`
+}
+
+// fetchStats aggregates traffic and contributor data for a repository
+func fetchStats(httpClient *http.Client, repo ghrepo.Interface) (*repoStats, error) {
+	apiClient := api.NewClientFromHTTP(httpClient)
+	prefix := fmt.Sprintf("repos/%s", ghrepo.FullName(repo))
+
+	var stats repoStats
+
+	err := apiClient.REST(repo.RepoHost(), "GET", prefix+"/traffic/clones", nil, &stats.Clones)
+	if err != nil {
+		return nil, err
+	}
+
+	err = apiClient.REST(repo.RepoHost(), "GET", prefix+"/traffic/views", nil, &stats.Views)
+	if err != nil {
+		return nil, err
+	}
+
+	err = apiClient.REST(repo.RepoHost(), "GET", prefix+"/traffic/popular/referrers", nil, &stats.Referrers)
+	if err != nil {
+		return nil, err
+	}
+
+	err = apiClient.REST(repo.RepoHost(), "GET", prefix+"/contributors?per_page=10", nil, &stats.Contributors)
+	if err != nil {
+		return nil, err
+	}
+
+	return &stats, nil
+}
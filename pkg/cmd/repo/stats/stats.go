@@ -0,0 +1,129 @@
+package stats
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/cli/cli/utils"
+	"github.com/spf13/cobra"
+)
+
+type StatsOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	JSON bool
+}
+
+func NewCmdStats(f *cmdutil.Factory, runF func(*StatsOptions) error) *cobra.Command {
+	opts := &StatsOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "stats",
+		Short: "Show traffic and activity statistics for a repository",
+		Long: heredoc.Doc(`
+			Display clone and view counts over the last 14 days, top referrers, and top
+			contributors for a repository.
+
+			Traffic data requires push access to the repository.
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return statsRun(opts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.JSON, "json", false, "Output statistics as JSON")
+
+	return cmd
+}
+
+func statsRun(opts *StatsOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	stats, err := fetchStats(httpClient, baseRepo)
+	if err != nil {
+		return err
+	}
+
+	stdout := opts.IO.Out
+
+	if opts.JSON {
+		encoded, err := json.MarshalIndent(stats, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(stdout, string(encoded))
+		return nil
+	}
+
+	if !opts.IO.IsStdoutTTY() {
+		fmt.Fprintf(stdout, "clones\t%d\t%d\n", stats.Clones.Count, stats.Clones.Uniques)
+		fmt.Fprintf(stdout, "views\t%d\t%d\n", stats.Views.Count, stats.Views.Uniques)
+		for _, r := range stats.Referrers {
+			fmt.Fprintf(stdout, "referrer\t%s\t%d\t%d\n", r.Referrer, r.Count, r.Uniques)
+		}
+		for _, c := range stats.Contributors {
+			fmt.Fprintf(stdout, "contributor\t%s\t%d\n", c.Login, c.Contributions)
+		}
+		return nil
+	}
+
+	fmt.Fprintf(stdout, "%s\n\n", utils.Bold(ghrepo.FullName(baseRepo)))
+	fmt.Fprintf(stdout, "%s\n", utils.Bold("Traffic over the last 14 days"))
+	fmt.Fprintf(stdout, "Clones: %d (%d unique)\n", stats.Clones.Count, stats.Clones.Uniques)
+	fmt.Fprintf(stdout, "Views:  %d (%d unique)\n", stats.Views.Count, stats.Views.Uniques)
+
+	if len(stats.Referrers) > 0 {
+		fmt.Fprintf(stdout, "\n%s\n", utils.Bold("Top referrers"))
+		table := utils.NewTablePrinter(opts.IO)
+		for _, r := range stats.Referrers {
+			table.AddField(r.Referrer, nil, nil)
+			table.AddField(strconv.Itoa(r.Count), nil, nil)
+			table.AddField(fmt.Sprintf("%d unique", r.Uniques), nil, utils.Gray)
+			table.EndRow()
+		}
+		if err := table.Render(); err != nil {
+			return err
+		}
+	}
+
+	if len(stats.Contributors) > 0 {
+		fmt.Fprintf(stdout, "\n%s\n", utils.Bold("Top contributors"))
+		table := utils.NewTablePrinter(opts.IO)
+		for _, c := range stats.Contributors {
+			table.AddField(c.Login, nil, nil)
+			table.AddField(utils.Pluralize(c.Contributions, "commit"), nil, nil)
+			table.EndRow()
+		}
+		if err := table.Render(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,116 @@
+package stats
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/httpmock"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_statsRun(t *testing.T) {
+	tests := []struct {
+		name    string
+		isTTY   bool
+		json    bool
+		wantOut string
+	}{
+		{
+			name:  "tty",
+			isTTY: true,
+			wantOut: heredoc.Doc(`
+				OWNER/REPO
+
+				Traffic over the last 14 days
+				Clones: 120 (40 unique)
+				Views:  999 (340 unique)
+
+				Top referrers
+				github.com  100  50 unique
+
+				Top contributors
+				monalisa  120 commits
+			`),
+		},
+		{
+			name: "nontty",
+			wantOut: heredoc.Doc(`
+				clones	120	40
+				views	999	340
+				referrer	github.com	100	50
+				contributor	monalisa	120
+			`),
+		},
+		{
+			name: "json",
+			json: true,
+			wantOut: heredoc.Doc(`
+				{
+				  "clones": {
+				    "count": 120,
+				    "uniques": 40
+				  },
+				  "views": {
+				    "count": 999,
+				    "uniques": 340
+				  },
+				  "referrers": [
+				    {
+				      "referrer": "github.com",
+				      "count": 100,
+				      "uniques": 50
+				    }
+				  ],
+				  "contributors": [
+				    {
+				      "login": "monalisa",
+				      "contributions": 120
+				    }
+				  ]
+				}
+			`),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			reg.Register(
+				httpmock.REST("GET", "repos/OWNER/REPO/traffic/clones"),
+				httpmock.StringResponse(`{"count": 120, "uniques": 40}`))
+			reg.Register(
+				httpmock.REST("GET", "repos/OWNER/REPO/traffic/views"),
+				httpmock.StringResponse(`{"count": 999, "uniques": 340}`))
+			reg.Register(
+				httpmock.REST("GET", "repos/OWNER/REPO/traffic/popular/referrers"),
+				httpmock.StringResponse(`[{"referrer": "github.com", "count": 100, "uniques": 50}]`))
+			reg.Register(
+				httpmock.REST("GET", "repos/OWNER/REPO/contributors"),
+				httpmock.StringResponse(`[{"login": "monalisa", "contributions": 120}]`))
+
+			io, _, stdout, _ := iostreams.Test()
+			io.SetStdoutTTY(tt.isTTY)
+
+			opts := &StatsOptions{
+				JSON: tt.json,
+				IO:   io,
+				HttpClient: func() (*http.Client, error) {
+					return &http.Client{Transport: reg}, nil
+				},
+				BaseRepo: func() (ghrepo.Interface, error) {
+					return ghrepo.New("OWNER", "REPO"), nil
+				},
+			}
+
+			err := statsRun(opts)
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.wantOut, stdout.String())
+			assert.Equal(t, "per_page=10", reg.Requests[3].URL.RawQuery)
+			reg.Verify(t)
+		})
+	}
+}
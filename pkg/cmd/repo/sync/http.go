@@ -0,0 +1,58 @@
+package sync
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/internal/ghrepo"
+)
+
+var errDivergingChanges = errors.New("diverging changes")
+
+// mergeUpstream fast-forwards a fork's branch from its parent repository
+func mergeUpstream(client *http.Client, repo ghrepo.Interface, branch string) error {
+	apiClient := api.NewClientFromHTTP(client)
+
+	body := &bytes.Buffer{}
+	if err := json.NewEncoder(body).Encode(map[string]string{"branch": branch}); err != nil {
+		return err
+	}
+
+	err := apiClient.REST(repo.RepoHost(), "POST", fmt.Sprintf("repos/%s/merge-upstream", ghrepo.FullName(repo)), body, nil)
+	var httpErr api.HTTPError
+	if errors.As(err, &httpErr) && httpErr.StatusCode == 409 {
+		return errDivergingChanges
+	}
+	return err
+}
+
+func latestCommitSHA(client *http.Client, repo ghrepo.Interface, branch string) (string, error) {
+	apiClient := api.NewClientFromHTTP(client)
+
+	var response struct {
+		Object struct {
+			SHA string `json:"sha"`
+		}
+	}
+	path := fmt.Sprintf("repos/%s/git/refs/heads/%s", ghrepo.FullName(repo), branch)
+	err := apiClient.REST(repo.RepoHost(), "GET", path, nil, &response)
+	return response.Object.SHA, err
+}
+
+// forceUpdateRef points a branch of the repository at a specific commit
+func forceUpdateRef(client *http.Client, repo ghrepo.Interface, branch, sha string) error {
+	apiClient := api.NewClientFromHTTP(client)
+
+	body := &bytes.Buffer{}
+	params := map[string]interface{}{"sha": sha, "force": true}
+	if err := json.NewEncoder(body).Encode(params); err != nil {
+		return err
+	}
+
+	path := fmt.Sprintf("repos/%s/git/refs/heads/%s", ghrepo.FullName(repo), branch)
+	return apiClient.REST(repo.RepoHost(), "PATCH", path, body, nil)
+}
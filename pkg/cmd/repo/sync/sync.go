@@ -0,0 +1,203 @@
+package sync
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/context"
+	"github.com/cli/cli/git"
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/internal/run"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/cli/cli/utils"
+	"github.com/spf13/cobra"
+)
+
+type SyncOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+	Remotes    func() (context.Remotes, error)
+
+	DestArg string
+	Branch  string
+	Force   bool
+}
+
+func NewCmdSync(f *cmdutil.Factory, runF func(*SyncOptions) error) *cobra.Command {
+	opts := &SyncOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		BaseRepo:   f.BaseRepo,
+		Remotes:    f.Remotes,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "sync [<destination-repository>]",
+		Short: "Sync a repository",
+		Long: heredoc.Doc(`
+			Sync destination repository from source repository. Syncing uses the main branch
+			of the source repository to update the matching branch on the destination
+			repository so they are equal.
+
+			Without an argument, the local repository is updated from its remote.
+			With a "OWNER/REPO" argument, the fork on GitHub is updated from its parent.
+
+			A fast forward update is performed unless there are diverging changes, in which
+			case '--force' overwrites the destination branch.
+		`),
+		Example: heredoc.Doc(`
+			# Sync local repository from remote
+			$ gh repo sync
+
+			# Sync remote fork from its parent
+			$ gh repo sync owner/cli-fork
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.DestArg = args[0]
+			}
+			if runF != nil {
+				return runF(opts)
+			}
+			return syncRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Branch, "branch", "b", "", "Branch to sync (default: main branch)")
+	cmd.Flags().BoolVar(&opts.Force, "force", false, "Hard reset the branch of the destination repository to match the source repository")
+
+	return cmd
+}
+
+func syncRun(opts *SyncOptions) error {
+	if opts.DestArg == "" {
+		return syncLocalRepo(opts)
+	}
+	return syncRemoteRepo(opts)
+}
+
+func syncLocalRepo(opts *SyncOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	remotes, err := opts.Remotes()
+	if err != nil {
+		return err
+	}
+	remote, err := remotes.FindByRepo(baseRepo.RepoOwner(), baseRepo.RepoName())
+	if err != nil {
+		return fmt.Errorf("can't determine remote for %s", ghrepo.FullName(baseRepo))
+	}
+
+	branch := opts.Branch
+	if branch == "" {
+		branch, err = api.RepoDefaultBranch(apiClient, baseRepo)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = git.Fetch(remote.Name, fmt.Sprintf("refs/heads/%s", branch))
+	if err != nil {
+		return err
+	}
+
+	fastForward := git.IsAncestor(branch, "FETCH_HEAD")
+	if !fastForward && !opts.Force {
+		return fmt.Errorf("can't sync because there are diverging changes; use `--force` to overwrite the destination branch")
+	}
+
+	currentBranch, err := git.CurrentBranch()
+	if err != nil && !errors.Is(err, git.ErrNotOnAnyBranch) {
+		return err
+	}
+	if currentBranch == branch {
+		var syncCmd []string
+		if fastForward {
+			syncCmd = []string{"merge", "--ff-only", "FETCH_HEAD"}
+		} else {
+			syncCmd = []string{"reset", "--hard", "FETCH_HEAD"}
+		}
+		if err := run.PrepareCmd(git.GitCommand(syncCmd...)).Run(); err != nil {
+			return err
+		}
+	} else {
+		updateCmd := git.GitCommand("update-ref", fmt.Sprintf("refs/heads/%s", branch), "FETCH_HEAD")
+		if err := run.PrepareCmd(updateCmd).Run(); err != nil {
+			return err
+		}
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		fmt.Fprintf(opts.IO.Out, "%s Synced the \"%s\" branch from %s to local repository\n",
+			utils.GreenCheck(), branch, ghrepo.FullName(baseRepo))
+	}
+
+	return nil
+}
+
+func syncRemoteRepo(opts *SyncOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	destRepo, err := ghrepo.FromFullName(opts.DestArg)
+	if err != nil {
+		return fmt.Errorf("argument error: %w", err)
+	}
+
+	srcRepo, err := api.RepoParent(apiClient, destRepo)
+	if err != nil {
+		return err
+	}
+	if srcRepo == nil {
+		return fmt.Errorf("can't determine source repository for %s because repository is not fork", ghrepo.FullName(destRepo))
+	}
+
+	branch := opts.Branch
+	if branch == "" {
+		branch = srcRepo.DefaultBranchRef.Name
+	}
+
+	if opts.Force {
+		commit, err := latestCommitSHA(httpClient, srcRepo, branch)
+		if err != nil {
+			return err
+		}
+		err = forceUpdateRef(httpClient, destRepo, branch, commit)
+		if err != nil {
+			return err
+		}
+	} else {
+		err = mergeUpstream(httpClient, destRepo, branch)
+		if errors.Is(err, errDivergingChanges) {
+			return fmt.Errorf("can't sync because there are diverging changes; use `--force` to overwrite the destination branch")
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		fmt.Fprintf(opts.IO.Out, "%s Synced the \"%s:%s\" branch from \"%s:%s\"\n",
+			utils.GreenCheck(), destRepo.RepoOwner(), branch, srcRepo.RepoOwner(), branch)
+	}
+
+	return nil
+}
@@ -0,0 +1,210 @@
+package sync
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/cli/cli/context"
+	"github.com/cli/cli/git"
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/httpmock"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/cli/cli/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_syncLocalRepo(t *testing.T) {
+	opts := &SyncOptions{
+		Branch: "trunk",
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: &httpmock.Registry{}}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+		Remotes: func() (context.Remotes, error) {
+			return context.Remotes{
+				{
+					Remote: &git.Remote{Name: "origin"},
+					Repo:   ghrepo.New("OWNER", "REPO"),
+				},
+			}, nil
+		},
+	}
+
+	io, _, stdout, _ := iostreams.Test()
+	io.SetStdoutTTY(true)
+	opts.IO = io
+
+	cs, restore := test.InitCmdStubber()
+	defer restore()
+
+	cs.Stub("")        // git fetch origin refs/heads/trunk
+	cs.Stub("")        // git merge-base --is-ancestor trunk FETCH_HEAD
+	cs.Stub("trunk\n") // git symbolic-ref --quiet --short HEAD
+	cs.Stub("")        // git merge --ff-only FETCH_HEAD
+
+	err := syncRun(opts)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 4, cs.Count)
+	assert.Equal(t, "git fetch origin refs/heads/trunk", strings.Join(cs.Calls[0].Args, " "))
+	assert.Equal(t, "git merge-base --is-ancestor trunk FETCH_HEAD", strings.Join(cs.Calls[1].Args, " "))
+	assert.Equal(t, "git merge --ff-only FETCH_HEAD", strings.Join(cs.Calls[3].Args, " "))
+	assert.Equal(t, "✓ Synced the \"trunk\" branch from OWNER/REPO to local repository\n", stdout.String())
+}
+
+func Test_syncLocalRepo_divergingChanges(t *testing.T) {
+	opts := &SyncOptions{
+		Branch: "trunk",
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: &httpmock.Registry{}}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+		Remotes: func() (context.Remotes, error) {
+			return context.Remotes{
+				{
+					Remote: &git.Remote{Name: "origin"},
+					Repo:   ghrepo.New("OWNER", "REPO"),
+				},
+			}, nil
+		},
+	}
+
+	io, _, _, _ := iostreams.Test()
+	opts.IO = io
+
+	cs, restore := test.InitCmdStubber()
+	defer restore()
+
+	cs.Stub("")                   // git fetch origin refs/heads/trunk
+	cs.StubError("exit status 1") // git merge-base --is-ancestor trunk FETCH_HEAD
+
+	err := syncRun(opts)
+	assert.EqualError(t, err, "can't sync because there are diverging changes; use `--force` to overwrite the destination branch")
+}
+
+func Test_syncRemoteRepo(t *testing.T) {
+	reg := &httpmock.Registry{}
+	reg.Register(
+		httpmock.GraphQL(`query RepositoryFindParent\b`),
+		httpmock.StringResponse(`
+		{ "data": { "repository": {
+			"parent": {
+				"owner": {"login": "hubot"},
+				"name": "REPO",
+				"defaultBranchRef": {"name": "trunk"}
+			}
+		} } }`))
+	reg.Register(
+		httpmock.REST("POST", "repos/OWNER/REPO-fork/merge-upstream"),
+		httpmock.StringResponse(`{"merge_type":"fast-forward"}`))
+
+	opts := &SyncOptions{
+		DestArg: "OWNER/REPO-fork",
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+	}
+
+	io, _, stdout, _ := iostreams.Test()
+	io.SetStdoutTTY(true)
+	opts.IO = io
+
+	err := syncRun(opts)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "✓ Synced the \"OWNER:trunk\" branch from \"hubot:trunk\"\n", stdout.String())
+	reg.Verify(t)
+}
+
+func Test_syncRemoteRepo_divergingChanges(t *testing.T) {
+	reg := &httpmock.Registry{}
+	reg.Register(
+		httpmock.GraphQL(`query RepositoryFindParent\b`),
+		httpmock.StringResponse(`
+		{ "data": { "repository": {
+			"parent": {
+				"owner": {"login": "hubot"},
+				"name": "REPO",
+				"defaultBranchRef": {"name": "trunk"}
+			}
+		} } }`))
+	reg.Register(
+		httpmock.REST("POST", "repos/OWNER/REPO-fork/merge-upstream"),
+		httpmock.StatusStringResponse(409, `{"message":"There are merge conflicts"}`))
+
+	opts := &SyncOptions{
+		DestArg: "OWNER/REPO-fork",
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+	}
+
+	io, _, _, _ := iostreams.Test()
+	opts.IO = io
+
+	err := syncRun(opts)
+	assert.EqualError(t, err, "can't sync because there are diverging changes; use `--force` to overwrite the destination branch")
+}
+
+func Test_syncRemoteRepo_force(t *testing.T) {
+	reg := &httpmock.Registry{}
+	reg.Register(
+		httpmock.GraphQL(`query RepositoryFindParent\b`),
+		httpmock.StringResponse(`
+		{ "data": { "repository": {
+			"parent": {
+				"owner": {"login": "hubot"},
+				"name": "REPO",
+				"defaultBranchRef": {"name": "trunk"}
+			}
+		} } }`))
+	reg.Register(
+		httpmock.REST("GET", "repos/hubot/REPO/git/refs/heads/trunk"),
+		httpmock.StringResponse(`{"object":{"sha":"deadbeef"}}`))
+	reg.Register(
+		httpmock.REST("PATCH", "repos/OWNER/REPO-fork/git/refs/heads/trunk"),
+		httpmock.StringResponse(`{}`))
+
+	opts := &SyncOptions{
+		DestArg: "OWNER/REPO-fork",
+		Force:   true,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+	}
+
+	io, _, _, _ := iostreams.Test()
+	opts.IO = io
+
+	err := syncRun(opts)
+	assert.NoError(t, err)
+	reg.Verify(t)
+}
+
+func Test_syncRemoteRepo_notFork(t *testing.T) {
+	reg := &httpmock.Registry{}
+	reg.Register(
+		httpmock.GraphQL(`query RepositoryFindParent\b`),
+		httpmock.StringResponse(`
+		{ "data": { "repository": {
+			"parent": null
+		} } }`))
+
+	opts := &SyncOptions{
+		DestArg: "OWNER/REPO",
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+	}
+
+	io, _, _, _ := iostreams.Test()
+	opts.IO = io
+
+	err := syncRun(opts)
+	assert.EqualError(t, err, "can't determine source repository for OWNER/REPO because repository is not fork")
+}
@@ -0,0 +1,122 @@
+package transfer
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/cli/cli/pkg/prompt"
+	"github.com/cli/cli/utils"
+	"github.com/spf13/cobra"
+)
+
+type TransferOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	NewOwner  string
+	TeamIDs   []int64
+	Confirmed bool
+}
+
+func NewCmdTransfer(f *cmdutil.Factory, runF func(*TransferOptions) error) *cobra.Command {
+	opts := &TransferOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "transfer <new-owner>",
+		Short: "Transfer a repository to another user or organization",
+		Long: heredoc.Doc(`
+			Initiate the transfer of a repository to a new owner.
+
+			Transfers to a user remain pending until the new owner accepts the
+			repository. When transferring to an organization, team access can be
+			carried over with the --team flag.
+		`),
+		Example: heredoc.Doc(`
+			$ gh repo transfer monalisa
+			$ gh repo transfer github --team 12345 --team 67890
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+
+			opts.NewOwner = args[0]
+
+			if !opts.IO.CanPrompt() && !opts.Confirmed {
+				return &cmdutil.FlagError{Err: fmt.Errorf("could not prompt: confirmation with prompt or --yes flag required")}
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return transferRun(opts)
+		},
+	}
+
+	cmd.Flags().Int64SliceVar(&opts.TeamIDs, "team", nil, "ID of a team in the target organization to grant access to the repository")
+	cmd.Flags().BoolVarP(&opts.Confirmed, "yes", "y", false, "Skip the confirmation prompt")
+
+	return cmd
+}
+
+func transferRun(opts *TransferOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+	fullName := ghrepo.FullName(baseRepo)
+
+	if !opts.Confirmed {
+		err = prompt.Confirm(fmt.Sprintf("Transfer %s to %s?", fullName, opts.NewOwner), &opts.Confirmed)
+		if err != nil {
+			return fmt.Errorf("could not prompt: %w", err)
+		}
+		if !opts.Confirmed {
+			return nil
+		}
+	}
+
+	err = transferRepo(httpClient, baseRepo, opts.NewOwner, opts.TeamIDs)
+	if err != nil {
+		return err
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		fmt.Fprintf(opts.IO.Out, "%s Initiated transfer of %s to %s\n", utils.GreenCheck(), fullName, opts.NewOwner)
+		fmt.Fprintln(opts.IO.Out, utils.Gray(fmt.Sprintf("The transfer completes once %s accepts the repository", opts.NewOwner)))
+	}
+
+	return nil
+}
+
+func transferRepo(httpClient *http.Client, repo ghrepo.Interface, newOwner string, teamIDs []int64) error {
+	input := map[string]interface{}{"new_owner": newOwner}
+	if len(teamIDs) > 0 {
+		input["team_ids"] = teamIDs
+	}
+
+	body := &bytes.Buffer{}
+	if err := json.NewEncoder(body).Encode(input); err != nil {
+		return err
+	}
+
+	apiClient := api.NewClientFromHTTP(httpClient)
+	path := fmt.Sprintf("repos/%s/transfer", ghrepo.FullName(repo))
+	return apiClient.REST(repo.RepoHost(), "POST", path, body, nil)
+}
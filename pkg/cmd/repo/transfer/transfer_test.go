@@ -0,0 +1,100 @@
+package transfer
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/httpmock"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/cli/cli/pkg/prompt"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_transferRun(t *testing.T) {
+	tests := []struct {
+		name       string
+		opts       TransferOptions
+		confirm    *bool
+		wantBody   map[string]interface{}
+		wantStdout string
+	}{
+		{
+			name: "confirmed with flag",
+			opts: TransferOptions{NewOwner: "monalisa", Confirmed: true},
+			wantBody: map[string]interface{}{
+				"new_owner": "monalisa",
+			},
+			wantStdout: "✓ Initiated transfer of OWNER/REPO to monalisa\nThe transfer completes once monalisa accepts the repository\n",
+		},
+		{
+			name:    "confirmed with prompt",
+			opts:    TransferOptions{NewOwner: "monalisa"},
+			confirm: boolP(true),
+			wantBody: map[string]interface{}{
+				"new_owner": "monalisa",
+			},
+			wantStdout: "✓ Initiated transfer of OWNER/REPO to monalisa\nThe transfer completes once monalisa accepts the repository\n",
+		},
+		{
+			name:    "declined prompt",
+			opts:    TransferOptions{NewOwner: "monalisa"},
+			confirm: boolP(false),
+		},
+		{
+			name: "with team IDs",
+			opts: TransferOptions{NewOwner: "github", TeamIDs: []int64{12, 34}, Confirmed: true},
+			wantBody: map[string]interface{}{
+				"new_owner": "github",
+				"team_ids":  []interface{}{float64(12), float64(34)},
+			},
+			wantStdout: "✓ Initiated transfer of OWNER/REPO to github\nThe transfer completes once github accepts the repository\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			if tt.wantBody != nil {
+				reg.Register(
+					httpmock.REST("POST", "repos/OWNER/REPO/transfer"),
+					httpmock.StatusStringResponse(202, "{}"))
+			}
+
+			io, _, stdout, _ := iostreams.Test()
+			io.SetStdoutTTY(true)
+			io.SetStdinTTY(true)
+
+			tt.opts.IO = io
+			tt.opts.HttpClient = func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			}
+			tt.opts.BaseRepo = func() (ghrepo.Interface, error) {
+				return ghrepo.New("OWNER", "REPO"), nil
+			}
+
+			if tt.confirm != nil {
+				defer prompt.StubConfirm(*tt.confirm)()
+			}
+
+			err := transferRun(&tt.opts)
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.wantStdout, stdout.String())
+			if tt.wantBody != nil {
+				bodyBytes, _ := ioutil.ReadAll(reg.Requests[0].Body)
+				reqBody := map[string]interface{}{}
+				err = json.Unmarshal(bodyBytes, &reqBody)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantBody, reqBody)
+			}
+			reg.Verify(t)
+		})
+	}
+}
+
+func boolP(b bool) *bool {
+	return &b
+}
@@ -0,0 +1,129 @@
+package unarchive
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/internal/ghinstance"
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/cli/cli/pkg/prompt"
+	"github.com/cli/cli/utils"
+	"github.com/spf13/cobra"
+)
+
+type UnarchiveOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	RepoArg   string
+	Confirmed bool
+}
+
+func NewCmdUnarchive(f *cmdutil.Factory, runF func(*UnarchiveOptions) error) *cobra.Command {
+	opts := &UnarchiveOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		BaseRepo:   f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "unarchive [<repository>]",
+		Short: "Unarchive a repository",
+		Long: heredoc.Doc(`
+			Unarchive a GitHub repository.
+
+			Unarchiving makes a read-only archived repository accept writes again:
+			issues, pull requests, and wiki pages can once more be updated.
+
+			With no argument, unarchives the repository for the current directory.
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.RepoArg = args[0]
+			}
+
+			if !opts.IO.CanPrompt() && !opts.Confirmed {
+				return &cmdutil.FlagError{Err: fmt.Errorf("could not prompt: confirmation with prompt or --yes flag required")}
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return unarchiveRun(opts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.Confirmed, "yes", false, "Skip the confirmation prompt")
+
+	return cmd
+}
+
+func unarchiveRun(opts *UnarchiveOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	var toUnarchive ghrepo.Interface
+	if opts.RepoArg == "" {
+		toUnarchive, err = opts.BaseRepo()
+		if err != nil {
+			return err
+		}
+	} else {
+		repoSelector := opts.RepoArg
+		if !strings.Contains(repoSelector, "/") {
+			currentUser, err := api.CurrentLoginName(apiClient, ghinstance.Default())
+			if err != nil {
+				return err
+			}
+			repoSelector = currentUser + "/" + repoSelector
+		}
+		toUnarchive, err = ghrepo.FromFullName(repoSelector)
+		if err != nil {
+			return fmt.Errorf("argument error: %w", err)
+		}
+	}
+
+	fullName := ghrepo.FullName(toUnarchive)
+
+	repo, err := api.GitHubRepo(apiClient, toUnarchive)
+	if err != nil {
+		return err
+	}
+
+	if !repo.IsArchived {
+		fmt.Fprintf(opts.IO.ErrOut, "%s Repository %s is not archived\n", utils.Yellow("!"), fullName)
+		return nil
+	}
+
+	if !opts.Confirmed {
+		var confirmed bool
+		err := prompt.Confirm(fmt.Sprintf("Unarchive %s? (the repository will no longer be read-only)", fullName), &confirmed)
+		if err != nil {
+			return fmt.Errorf("could not prompt: %w", err)
+		}
+		if !confirmed {
+			return nil
+		}
+	}
+
+	err = api.RepoUnarchive(apiClient, repo)
+	if err != nil {
+		return fmt.Errorf("could not unarchive repository: %w", err)
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		fmt.Fprintf(opts.IO.Out, "%s Unarchived repository %s\n", utils.GreenCheck(), fullName)
+	}
+
+	return nil
+}
@@ -0,0 +1,92 @@
+package unarchive
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/httpmock"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/cli/cli/pkg/prompt"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_unarchiveRun(t *testing.T) {
+	tests := []struct {
+		name       string
+		opts       *UnarchiveOptions
+		httpStubs  func(*httpmock.Registry)
+		confirm    bool
+		isTTY      bool
+		wantStdout string
+		wantStderr string
+	}{
+		{
+			name:    "confirming unarchive interactively",
+			opts:    &UnarchiveOptions{RepoArg: "OWNER/REPO"},
+			isTTY:   true,
+			confirm: true,
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query RepositoryInfo\b`),
+					httpmock.StringResponse(`
+					{ "data": { "repository": {
+						"id": "THE-ID",
+						"isArchived": true
+					} } }`))
+				reg.Register(
+					httpmock.GraphQL(`mutation RepositoryUnarchive\b`),
+					httpmock.StringResponse(`
+					{ "data": { "unarchiveRepository": { "repository": {
+						"id": "THE-ID"
+					} } } }`))
+			},
+			wantStdout: "✓ Unarchived repository OWNER/REPO\n",
+		},
+		{
+			name: "repository not archived",
+			opts: &UnarchiveOptions{RepoArg: "OWNER/REPO", Confirmed: true},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query RepositoryInfo\b`),
+					httpmock.StringResponse(`
+					{ "data": { "repository": {
+						"id": "THE-ID",
+						"isArchived": false
+					} } }`))
+			},
+			wantStderr: "! Repository OWNER/REPO is not archived\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			if tt.httpStubs != nil {
+				tt.httpStubs(reg)
+			}
+			tt.opts.HttpClient = func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			}
+			tt.opts.BaseRepo = func() (ghrepo.Interface, error) {
+				return ghrepo.New("OWNER", "REPO"), nil
+			}
+
+			io, _, stdout, stderr := iostreams.Test()
+			io.SetStdinTTY(tt.isTTY)
+			io.SetStdoutTTY(tt.isTTY)
+			tt.opts.IO = io
+
+			if tt.confirm {
+				defer prompt.StubConfirm(true)()
+			}
+
+			err := unarchiveRun(tt.opts)
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.wantStdout, stdout.String())
+			assert.Equal(t, tt.wantStderr, stderr.String())
+			reg.Verify(t)
+		})
+	}
+}
@@ -2,9 +2,11 @@ package view
 
 import (
 	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
 
 	"github.com/cli/cli/api"
 	"github.com/cli/cli/internal/ghrepo"
@@ -17,14 +19,14 @@ type RepoReadme struct {
 	Content  string
 }
 
-func RepositoryReadme(client *http.Client, repo ghrepo.Interface) (*RepoReadme, error) {
+func RepositoryReadme(client *http.Client, repo ghrepo.Interface, branch string) (*RepoReadme, error) {
 	apiClient := api.NewClientFromHTTP(client)
 	var response struct {
 		Name    string
 		Content string
 	}
 
-	err := apiClient.REST(repo.RepoHost(), "GET", fmt.Sprintf("repos/%s/readme", ghrepo.FullName(repo)), nil, &response)
+	err := apiClient.REST(repo.RepoHost(), "GET", getReadmePath(repo, branch), nil, &response)
 	if err != nil {
 		var httpError api.HTTPError
 		if errors.As(err, &httpError) && httpError.StatusCode == 404 {
@@ -43,3 +45,59 @@ func RepositoryReadme(client *http.Client, repo ghrepo.Interface) (*RepoReadme,
 		Content:  string(decoded),
 	}, nil
 }
+
+// RepoContent is a file or directory entry at a path within a repository
+type RepoContent struct {
+	Name    string `json:"name"`
+	Type    string `json:"type"`
+	Content string `json:"content"`
+}
+
+// RepositoryContents fetches the contents at a path within a repository. For a
+// file, the decoded file is returned; for a directory, its entries are.
+func RepositoryContents(client *http.Client, repo ghrepo.Interface, path, branch string) (*RepoContent, []RepoContent, error) {
+	apiClient := api.NewClientFromHTTP(client)
+
+	requestPath := fmt.Sprintf("repos/%s/contents/%s", ghrepo.FullName(repo), path)
+	if branch != "" {
+		requestPath = fmt.Sprintf("%s?ref=%s", requestPath, url.QueryEscape(branch))
+	}
+
+	var response json.RawMessage
+	err := apiClient.REST(repo.RepoHost(), "GET", requestPath, nil, &response)
+	if err != nil {
+		var httpError api.HTTPError
+		if errors.As(err, &httpError) && httpError.StatusCode == 404 {
+			return nil, nil, fmt.Errorf("the %q path does not exist in %s", path, ghrepo.FullName(repo))
+		}
+		return nil, nil, err
+	}
+
+	if len(response) > 0 && response[0] == '[' {
+		var entries []RepoContent
+		if err := json.Unmarshal(response, &entries); err != nil {
+			return nil, nil, err
+		}
+		return nil, entries, nil
+	}
+
+	var file RepoContent
+	if err := json.Unmarshal(response, &file); err != nil {
+		return nil, nil, err
+	}
+	decoded, err := base64.StdEncoding.DecodeString(file.Content)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to decode file contents: %w", err)
+	}
+	file.Content = string(decoded)
+
+	return &file, nil, nil
+}
+
+func getReadmePath(repo ghrepo.Interface, branch string) string {
+	path := fmt.Sprintf("repos/%s/readme", ghrepo.FullName(repo))
+	if branch != "" {
+		path = fmt.Sprintf("%s?ref=%s", path, url.QueryEscape(branch))
+	}
+	return path
+}
@@ -22,7 +22,9 @@ type ViewOptions struct {
 	BaseRepo   func() (ghrepo.Interface, error)
 
 	RepoArg string
+	Path    string
 	Web     bool
+	Branch  string
 }
 
 func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Command {
@@ -33,18 +35,24 @@ func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Comman
 	}
 
 	cmd := &cobra.Command{
-		Use:   "view [<repository>]",
+		Use:   "view [<repository>] [<path>]",
 		Short: "View a repository",
 		Long: `Display the description and the README of a GitHub repository.
 
 With no argument, the repository for the current directory is displayed.
 
+When a second argument is given, it names a path within the repository: a
+directory is listed and a file's contents are displayed, without cloning.
+
 With '--web', open the repository in a web browser instead.`,
-		Args: cobra.MaximumNArgs(1),
+		Args: cobra.MaximumNArgs(2),
 		RunE: func(c *cobra.Command, args []string) error {
 			if len(args) > 0 {
 				opts.RepoArg = args[0]
 			}
+			if len(args) > 1 {
+				opts.Path = args[1]
+			}
 			if runF != nil {
 				return runF(&opts)
 			}
@@ -53,6 +61,7 @@ With '--web', open the repository in a web browser instead.`,
 	}
 
 	cmd.Flags().BoolVarP(&opts.Web, "web", "w", false, "Open a repository in the browser")
+	cmd.Flags().StringVarP(&opts.Branch, "branch", "b", "", "View a specific branch of the repository")
 
 	return cmd
 }
@@ -87,12 +96,33 @@ func viewRun(opts *ViewOptions) error {
 		}
 	}
 
+	if opts.Path != "" {
+		if opts.Web {
+			branch := opts.Branch
+			if branch == "" {
+				branch, err = api.RepoDefaultBranch(apiClient, toView)
+				if err != nil {
+					return err
+				}
+			}
+			openURL := ghrepo.GenerateRepoURL(toView, "tree/%s/%s", branch, opts.Path)
+			if opts.IO.IsStdoutTTY() {
+				fmt.Fprintf(opts.IO.ErrOut, "Opening %s in your browser.\n", utils.DisplayURL(openURL))
+			}
+			return utils.OpenInBrowser(openURL)
+		}
+		return viewPath(opts, httpClient, toView)
+	}
+
 	repo, err := api.GitHubRepo(apiClient, toView)
 	if err != nil {
 		return err
 	}
 
 	openURL := ghrepo.GenerateRepoURL(toView, "")
+	if opts.Branch != "" {
+		openURL = ghrepo.GenerateRepoURL(toView, "tree/%s", opts.Branch)
+	}
 	if opts.Web {
 		if opts.IO.IsStdoutTTY() {
 			fmt.Fprintf(opts.IO.ErrOut, "Opening %s in your browser.\n", utils.DisplayURL(openURL))
@@ -102,16 +132,23 @@ func viewRun(opts *ViewOptions) error {
 
 	fullName := ghrepo.FullName(toView)
 
-	readme, err := RepositoryReadme(httpClient, toView)
+	readme, err := RepositoryReadme(httpClient, toView, opts.Branch)
 	if err != nil && err != NotFoundError {
 		return err
 	}
 
+	topics := make([]string, 0, len(repo.RepositoryTopics.Nodes))
+	for _, node := range repo.RepositoryTopics.Nodes {
+		topics = append(topics, node.Topic.Name)
+	}
+
 	stdout := opts.IO.Out
 
 	if !opts.IO.IsStdoutTTY() {
 		fmt.Fprintf(stdout, "name:\t%s\n", fullName)
 		fmt.Fprintf(stdout, "description:\t%s\n", repo.Description)
+		fmt.Fprintf(stdout, "stars:\t%d\n", repo.Stargazers.TotalCount)
+		fmt.Fprintf(stdout, "topics:\t%s\n", strings.Join(topics, ", "))
 		if readme != nil {
 			fmt.Fprintln(stdout, "--")
 			fmt.Fprintf(stdout, readme.Content)
@@ -124,9 +161,10 @@ func viewRun(opts *ViewOptions) error {
 	repoTmpl := heredoc.Doc(`
 		{{.FullName}}
 		{{.Description}}
-		
+		{{.Info}}
+
 		{{.Readme}}
-		
+
 		{{.View}}
 	`)
 
@@ -153,14 +191,21 @@ func viewRun(opts *ViewOptions) error {
 		description = utils.Gray("No description provided")
 	}
 
+	infoParts := []string{utils.Pluralize(repo.Stargazers.TotalCount, "star")}
+	if len(topics) > 0 {
+		infoParts = append(infoParts, "Topics: "+strings.Join(topics, ", "))
+	}
+
 	repoData := struct {
 		FullName    string
 		Description string
+		Info        string
 		Readme      string
 		View        string
 	}{
 		FullName:    utils.Bold(fullName),
 		Description: description,
+		Info:        utils.Gray(strings.Join(infoParts, " • ")),
 		Readme:      readmeContent,
 		View:        utils.Gray(fmt.Sprintf("View this repository on GitHub: %s", openURL)),
 	}
@@ -173,6 +218,39 @@ func viewRun(opts *ViewOptions) error {
 	return nil
 }
 
+// viewPath lists a directory or displays a file at a path within the repository
+func viewPath(opts *ViewOptions, httpClient *http.Client, repo ghrepo.Interface) error {
+	file, entries, err := RepositoryContents(httpClient, repo, opts.Path, opts.Branch)
+	if err != nil {
+		return err
+	}
+
+	stdout := opts.IO.Out
+
+	if file == nil {
+		for _, entry := range entries {
+			name := entry.Name
+			if entry.Type == "dir" {
+				name += "/"
+			}
+			fmt.Fprintln(stdout, name)
+		}
+		return nil
+	}
+
+	if opts.IO.IsStdoutTTY() && isMarkdownFile(file.Name) {
+		rendered, err := utils.RenderMarkdown(file.Content)
+		if err != nil {
+			return fmt.Errorf("error rendering markdown: %w", err)
+		}
+		fmt.Fprint(stdout, rendered)
+		return nil
+	}
+
+	fmt.Fprint(stdout, file.Content)
+	return nil
+}
+
 func isMarkdownFile(filename string) bool {
 	// kind of gross, but i'm assuming that 90% of the time the suffix will just be .md. it didn't
 	// seem worth executing a regex for this given that assumption.
@@ -47,6 +47,22 @@ func TestNewCmdView(t *testing.T) {
 				Web:     true,
 			},
 		},
+		{
+			name: "sets branch",
+			cli:  "-b feat/awesome",
+			wants: ViewOptions{
+				RepoArg: "",
+				Branch:  "feat/awesome",
+			},
+		},
+		{
+			name: "sets path",
+			cli:  "some/repo docs/readme.md",
+			wants: ViewOptions{
+				RepoArg: "some/repo",
+				Path:    "docs/readme.md",
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -80,7 +96,9 @@ func TestNewCmdView(t *testing.T) {
 			assert.NoError(t, err)
 
 			assert.Equal(t, tt.wants.Web, gotOpts.Web)
+			assert.Equal(t, tt.wants.Branch, gotOpts.Branch)
 			assert.Equal(t, tt.wants.RepoArg, gotOpts.RepoArg)
+			assert.Equal(t, tt.wants.Path, gotOpts.Path)
 		})
 	}
 }
@@ -158,6 +176,8 @@ func Test_ViewRun(t *testing.T) {
 			wantOut: heredoc.Doc(`
 				name:	OWNER/REPO
 				description:	social distancing
+				stars:	3
+				topics:	mystery, zombies
 				--
 				# truly cool readme check it out
 				`),
@@ -172,6 +192,7 @@ func Test_ViewRun(t *testing.T) {
 			wantOut: heredoc.Doc(`
 				jill/valentine
 				social distancing
+				3 stars • Topics: mystery, zombies
 
 
 				  # truly cool readme check it out                                            
@@ -191,6 +212,7 @@ func Test_ViewRun(t *testing.T) {
 			wantOut: heredoc.Doc(`
 				jill/valentine
 				social distancing
+				3 stars • Topics: mystery, zombies
 
 
 				  # truly cool readme check it out                                            
@@ -206,6 +228,7 @@ func Test_ViewRun(t *testing.T) {
 			wantOut: heredoc.Doc(`
 				OWNER/REPO
 				social distancing
+				3 stars • Topics: mystery, zombies
 
 
 				  # truly cool readme check it out                                            
@@ -236,7 +259,12 @@ func Test_ViewRun(t *testing.T) {
 			httpmock.StringResponse(`
 		{ "data": {
 			"repository": {
-			"description": "social distancing"
+			"description": "social distancing",
+			"stargazers": { "totalCount": 3 },
+			"repositoryTopics": { "nodes": [
+				{ "topic": { "name": "mystery" } },
+				{ "topic": { "name": "zombies" } }
+			] }
 		} } }`))
 		reg.Register(
 			httpmock.REST("GET", fmt.Sprintf("repos/%s/readme", tt.repoName)),
@@ -275,6 +303,7 @@ func Test_ViewRun_NonMarkdownReadme(t *testing.T) {
 			wantOut: heredoc.Doc(`
 			OWNER/REPO
 			social distancing
+			0 stars
 
 			# truly cool readme check it out
 
@@ -287,6 +316,8 @@ func Test_ViewRun_NonMarkdownReadme(t *testing.T) {
 			wantOut: heredoc.Doc(`
 			name:	OWNER/REPO
 			description:	social distancing
+			stars:	0
+			topics:	
 			--
 			# truly cool readme check it out
 			`),
@@ -345,6 +376,7 @@ func Test_ViewRun_NoReadme(t *testing.T) {
 			wantOut: heredoc.Doc(`
 			OWNER/REPO
 			social distancing
+			0 stars
 
 			This repository does not have a README
 
@@ -357,6 +389,8 @@ func Test_ViewRun_NoReadme(t *testing.T) {
 			wantOut: heredoc.Doc(`
 			name:	OWNER/REPO
 			description:	social distancing
+			stars:	0
+			topics:	
 			`),
 		},
 	}
@@ -411,6 +445,7 @@ func Test_ViewRun_NoDescription(t *testing.T) {
 			wantOut: heredoc.Doc(`
 			OWNER/REPO
 			No description provided
+			0 stars
 
 			# truly cool readme check it out
 
@@ -423,6 +458,8 @@ func Test_ViewRun_NoDescription(t *testing.T) {
 			wantOut: heredoc.Doc(`
 			name:	OWNER/REPO
 			description:	
+			stars:	0
+			topics:	
 			--
 			# truly cool readme check it out
 			`),
@@ -509,6 +546,8 @@ func Test_ViewRun_WithoutUsername(t *testing.T) {
 	assert.Equal(t, heredoc.Doc(`
 			name:	OWNER/REPO
 			description:	social distancing
+			stars:	0
+			topics:	
 			--
 			# truly cool readme check it out
 			`), stdout.String())
@@ -531,6 +570,8 @@ func Test_ViewRun_HandlesSpecialCharacters(t *testing.T) {
 			wantOut: heredoc.Doc(`
 				name:	OWNER/REPO
 				description:	Some basic special characters " & / < > '
+				stars:	0
+				topics:	
 				--
 				# < is always > than & ' and "
 				`),
@@ -541,6 +582,7 @@ func Test_ViewRun_HandlesSpecialCharacters(t *testing.T) {
 			wantOut: heredoc.Doc(`
 				OWNER/REPO
 				Some basic special characters " & / < > '
+				0 stars
 
 
 				  # < is always > than & ' and "                                              
@@ -598,3 +640,104 @@ func Test_ViewRun_HandlesSpecialCharacters(t *testing.T) {
 		})
 	}
 }
+
+func Test_ViewRun_WithBranch(t *testing.T) {
+	reg := &httpmock.Registry{}
+	reg.Register(
+		httpmock.GraphQL(`query RepositoryInfo\b`),
+		httpmock.StringResponse(`
+	{ "data": {
+		"repository": {
+		"description": "social distancing"
+	} } }`))
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/readme"),
+		httpmock.StringResponse(`
+	{ "name": "readme.md",
+	"content": "IyB0cnVseSBjb29sIHJlYWRtZSBjaGVjayBpdCBvdXQ="}`))
+
+	io, _, stdout, _ := iostreams.Test()
+	io.SetStdoutTTY(true)
+
+	opts := &ViewOptions{
+		Branch: "feat/awesome",
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+		IO: io,
+	}
+
+	if err := viewRun(opts); err != nil {
+		t.Errorf("viewRun() error = %v", err)
+	}
+
+	assert.Equal(t, "ref=feat%2Fawesome", reg.Requests[1].URL.RawQuery)
+	assert.Contains(t, stdout.String(), "View this repository on GitHub: https://github.com/OWNER/REPO/tree/feat/awesome")
+	reg.Verify(t)
+}
+
+func Test_ViewRun_Path(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		branch   string
+		response string
+		wantOut  string
+	}{
+		{
+			name: "file",
+			path: "LICENSE",
+			response: `
+		{ "name": "LICENSE",
+		"type": "file",
+		"content": "TUlUIExpY2Vuc2U="}`,
+			wantOut: "MIT License",
+		},
+		{
+			name:   "directory",
+			path:   "docs",
+			branch: "feat/awesome",
+			response: `[
+			{ "name": "assets", "type": "dir" },
+			{ "name": "readme.md", "type": "file" }
+		]`,
+			wantOut: "assets/\nreadme.md\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			reg.Register(
+				httpmock.REST("GET", fmt.Sprintf("repos/OWNER/REPO/contents/%s", tt.path)),
+				httpmock.StringResponse(tt.response))
+
+			io, _, stdout, _ := iostreams.Test()
+
+			opts := &ViewOptions{
+				Path:   tt.path,
+				Branch: tt.branch,
+				HttpClient: func() (*http.Client, error) {
+					return &http.Client{Transport: reg}, nil
+				},
+				BaseRepo: func() (ghrepo.Interface, error) {
+					return ghrepo.New("OWNER", "REPO"), nil
+				},
+				IO: io,
+			}
+
+			if err := viewRun(opts); err != nil {
+				t.Errorf("viewRun() error = %v", err)
+			}
+
+			if tt.branch != "" {
+				assert.Equal(t, "ref=feat%2Fawesome", reg.Requests[0].URL.RawQuery)
+			}
+			assert.Equal(t, tt.wantOut, stdout.String())
+			reg.Verify(t)
+		})
+	}
+}
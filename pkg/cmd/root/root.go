@@ -15,12 +15,14 @@ import (
 	authCmd "github.com/cli/cli/pkg/cmd/auth"
 	configCmd "github.com/cli/cli/pkg/cmd/config"
 	"github.com/cli/cli/pkg/cmd/factory"
+	fieldsCmd "github.com/cli/cli/pkg/cmd/fields"
 	gistCmd "github.com/cli/cli/pkg/cmd/gist"
 	issueCmd "github.com/cli/cli/pkg/cmd/issue"
 	prCmd "github.com/cli/cli/pkg/cmd/pr"
 	releaseCmd "github.com/cli/cli/pkg/cmd/release"
 	repoCmd "github.com/cli/cli/pkg/cmd/repo"
 	creditsCmd "github.com/cli/cli/pkg/cmd/repo/credits"
+	runCmd "github.com/cli/cli/pkg/cmd/run"
 	"github.com/cli/cli/pkg/cmdutil"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -92,6 +94,21 @@ func NewCmdRoot(f *cmdutil.Factory, version, buildDate string) *cobra.Command {
 	cmd.SetErr(f.IOStreams.ErrOut)
 
 	cmd.PersistentFlags().Bool("help", false, "Show help for command")
+
+	var dryRun bool
+	cmd.PersistentFlags().BoolVar(&dryRun, "dry-run", false, "Print mutating API requests instead of sending them")
+	innerHTTPClient := f.HttpClient
+	f.HttpClient = func() (*http.Client, error) {
+		httpClient, err := innerHTTPClient()
+		if err != nil {
+			return nil, err
+		}
+		if dryRun {
+			httpClient.Transport = api.DryRun(f.IOStreams.ErrOut)(httpClient.Transport)
+		}
+		return httpClient, nil
+	}
+
 	cmd.SetHelpFunc(rootHelpFunc)
 	cmd.SetUsageFunc(rootUsageFunc)
 
@@ -110,6 +127,7 @@ func NewCmdRoot(f *cmdutil.Factory, version, buildDate string) *cobra.Command {
 	cmd.AddCommand(authCmd.NewCmdAuth(f))
 	cmd.AddCommand(configCmd.NewCmdConfig(f))
 	cmd.AddCommand(creditsCmd.NewCmdCredits(f, nil))
+	cmd.AddCommand(fieldsCmd.NewCmdFields(f, nil))
 	cmd.AddCommand(gistCmd.NewCmdGist(f))
 	cmd.AddCommand(NewCmdCompletion(f.IOStreams))
 
@@ -133,6 +151,7 @@ func NewCmdRoot(f *cmdutil.Factory, version, buildDate string) *cobra.Command {
 	cmd.AddCommand(issueCmd.NewCmdIssue(&repoResolvingCmdFactory))
 	cmd.AddCommand(releaseCmd.NewCmdRelease(&repoResolvingCmdFactory))
 	cmd.AddCommand(repoCmd.NewCmdRepo(&repoResolvingCmdFactory))
+	cmd.AddCommand(runCmd.NewCmdRun(&repoResolvingCmdFactory))
 
 	return cmd
 }
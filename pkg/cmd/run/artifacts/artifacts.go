@@ -0,0 +1,184 @@
+package artifacts
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/cli/cli/utils"
+	"github.com/spf13/cobra"
+)
+
+type ArtifactsOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	RunID    uint64
+	Exporter *cmdutil.Exporter
+}
+
+var artifactFields = []cmdutil.JSONField{
+	{Name: "id", Type: "number", Example: `4091`},
+	{Name: "name", Type: "string", Example: `"test-results"`},
+	{Name: "sizeInBytes", Type: "number", Example: `4096`},
+	{Name: "expired", Type: "boolean", Example: `false`},
+	{Name: "createdAt", Type: "string", Example: `"2020-01-10T14:59:22Z"`},
+	{Name: "expiresAt", Type: "string", Example: `"2020-04-10T14:59:22Z"`},
+}
+
+func NewCmdArtifacts(f *cmdutil.Factory, runF func(*ArtifactsOptions) error) *cobra.Command {
+	opts := &ArtifactsOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "artifacts [<run-id>]",
+		Short: "List artifacts of workflow runs",
+		Long: heredoc.Doc(`
+			List the artifacts uploaded by workflow runs, along with their sizes
+			and expiry dates.
+
+			Without an argument, the artifacts of the repository's recent runs are
+			listed; with a run ID, only that run's artifacts are shown.
+		`),
+		Example: heredoc.Doc(`
+			$ gh run artifacts
+			$ gh run artifacts 1234567
+			$ gh run artifacts --json name,sizeInBytes,expiresAt
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+
+			if len(args) > 0 {
+				runID, err := strconv.ParseUint(args[0], 10, 64)
+				if err != nil {
+					return &cmdutil.FlagError{Err: fmt.Errorf("invalid run ID: %q", args[0])}
+				}
+				opts.RunID = runID
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return artifactsRun(opts)
+		},
+	}
+
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, artifactFields)
+
+	return cmd
+}
+
+func artifactsRun(opts *ArtifactsOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	var artifacts []artifact
+	if opts.RunID > 0 {
+		artifacts, err = listRunArtifacts(apiClient, baseRepo, opts.RunID)
+	} else {
+		artifacts, err = listRepoArtifacts(apiClient, baseRepo)
+	}
+	if err != nil {
+		return err
+	}
+
+	if opts.Exporter != nil {
+		return exportArtifacts(opts.IO.Out, opts.Exporter.Fields(), artifacts)
+	}
+
+	if len(artifacts) == 0 {
+		return errors.New("no artifacts found")
+	}
+
+	table := utils.NewTablePrinter(opts.IO)
+	for _, a := range artifacts {
+		table.AddField(a.Name, nil, nil)
+		if table.IsTTY() {
+			table.AddField(humanizeSize(a.Size), nil, nil)
+			table.AddField(expiryLabel(&a), nil, expiryColor(&a))
+		} else {
+			table.AddField(strconv.FormatUint(a.Size, 10), nil, nil)
+			table.AddField(a.ExpiresAt.Format(time.RFC3339), nil, nil)
+		}
+		table.EndRow()
+	}
+	return table.Render()
+}
+
+func expiryLabel(a *artifact) string {
+	if a.Expired {
+		return "expired"
+	}
+	return fmt.Sprintf("expires %s", a.ExpiresAt.Format("2006-01-02"))
+}
+
+func expiryColor(a *artifact) func(string) string {
+	if a.Expired {
+		return utils.Gray
+	}
+	return nil
+}
+
+func humanizeSize(size uint64) string {
+	switch {
+	case size < 1024:
+		return fmt.Sprintf("%d B", size)
+	case size < 1024*1024:
+		return fmt.Sprintf("%.1f KiB", float64(size)/1024)
+	case size < 1024*1024*1024:
+		return fmt.Sprintf("%.1f MiB", float64(size)/(1024*1024))
+	default:
+		return fmt.Sprintf("%.1f GiB", float64(size)/(1024*1024*1024))
+	}
+}
+
+func exportArtifacts(w io.Writer, fields []string, artifacts []artifact) error {
+	data := make([]map[string]interface{}, 0, len(artifacts))
+	for i := range artifacts {
+		data = append(data, exportArtifact(&artifacts[i], fields))
+	}
+	return json.NewEncoder(w).Encode(data)
+}
+
+func exportArtifact(a *artifact, fields []string) map[string]interface{} {
+	data := make(map[string]interface{}, len(fields))
+	for _, f := range fields {
+		switch f {
+		case "id":
+			data[f] = a.ID
+		case "name":
+			data[f] = a.Name
+		case "sizeInBytes":
+			data[f] = a.Size
+		case "expired":
+			data[f] = a.Expired
+		case "createdAt":
+			data[f] = a.CreatedAt
+		case "expiresAt":
+			data[f] = a.ExpiresAt
+		}
+	}
+	return data
+}
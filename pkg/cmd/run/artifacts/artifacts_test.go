@@ -0,0 +1,164 @@
+package artifacts
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/httpmock"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/cli/cli/test"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func runCommand(rt http.RoundTripper, isTTY bool, cli string) (*test.CmdOut, error) {
+	io, _, stdout, stderr := iostreams.Test()
+	io.SetStdoutTTY(isTTY)
+	io.SetStdinTTY(isTTY)
+	io.SetStderrTTY(isTTY)
+
+	factory := &cmdutil.Factory{
+		IOStreams: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: rt}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+	}
+
+	cmd := NewCmdArtifacts(factory, nil)
+
+	argv, err := shlex.Split(cli)
+	if err != nil {
+		return nil, err
+	}
+	cmd.SetArgs(argv)
+
+	cmd.SetIn(&bytes.Buffer{})
+	cmd.SetOut(ioutil.Discard)
+	cmd.SetErr(ioutil.Discard)
+
+	_, err = cmd.ExecuteC()
+	return &test.CmdOut{
+		OutBuf: stdout,
+		ErrBuf: stderr,
+	}, err
+}
+
+func TestRunArtifacts_repo(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/actions/artifacts"),
+		httpmock.StringResponse(`{
+			"artifacts": [
+				{
+					"id": 11,
+					"name": "test-results",
+					"size_in_bytes": 4096,
+					"expired": false,
+					"created_at": "2020-01-02T15:04:05Z",
+					"expires_at": "2020-04-02T15:04:05Z"
+				},
+				{
+					"id": 13,
+					"name": "coverage",
+					"size_in_bytes": 123,
+					"expired": true,
+					"created_at": "2019-10-02T15:04:05Z",
+					"expires_at": "2020-01-02T15:04:05Z"
+				}
+			]
+		}`))
+
+	output, err := runCommand(reg, false, "")
+	if err != nil {
+		t.Fatalf("error running command `run artifacts`: %v", err)
+	}
+
+	test.ExpectLines(t, output.String(),
+		`test-results\t4096\t2020-04-02T15:04:05Z`,
+		`coverage\t123\t2020-01-02T15:04:05Z`)
+}
+
+func TestRunArtifacts_run(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/1234/artifacts"),
+		httpmock.StringResponse(`{
+			"artifacts": [
+				{
+					"id": 11,
+					"name": "test-results",
+					"size_in_bytes": 4096,
+					"expired": false,
+					"created_at": "2020-01-02T15:04:05Z",
+					"expires_at": "2020-04-02T15:04:05Z"
+				}
+			]
+		}`))
+
+	output, err := runCommand(reg, false, "1234")
+	if err != nil {
+		t.Fatalf("error running command `run artifacts`: %v", err)
+	}
+
+	test.ExpectLines(t, output.String(),
+		`test-results\t4096\t2020-04-02T15:04:05Z`)
+}
+
+func TestRunArtifacts_json(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/actions/artifacts"),
+		httpmock.StringResponse(`{
+			"artifacts": [
+				{
+					"id": 11,
+					"name": "test-results",
+					"size_in_bytes": 4096,
+					"expired": false,
+					"created_at": "2020-01-02T15:04:05Z",
+					"expires_at": "2020-04-02T15:04:05Z"
+				}
+			]
+		}`))
+
+	output, err := runCommand(reg, false, "--json name,sizeInBytes,expiresAt")
+	if err != nil {
+		t.Fatalf("error running command `run artifacts`: %v", err)
+	}
+
+	assert.Equal(t, `[{"expiresAt":"2020-04-02T15:04:05Z","name":"test-results","sizeInBytes":4096}]
+`, output.String())
+}
+
+func TestRunArtifacts_jsonUnknownField(t *testing.T) {
+	reg := &httpmock.Registry{}
+
+	_, err := runCommand(reg, false, "--json color")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), `unknown JSON field "color"`)
+}
+
+func TestRunArtifacts_none(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/actions/artifacts"),
+		httpmock.StringResponse(`{"artifacts": []}`))
+
+	_, err := runCommand(reg, false, "")
+	assert.EqualError(t, err, "no artifacts found")
+}
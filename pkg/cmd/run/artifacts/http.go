@@ -0,0 +1,45 @@
+package artifacts
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/internal/ghrepo"
+)
+
+type artifact struct {
+	ID        uint64
+	Name      string
+	Size      uint64 `json:"size_in_bytes"`
+	Expired   bool
+	CreatedAt time.Time `json:"created_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// listRunArtifacts fetches the artifacts uploaded by a single workflow run
+func listRunArtifacts(client *api.Client, repo ghrepo.Interface, runID uint64) ([]artifact, error) {
+	var result struct {
+		Artifacts []artifact
+	}
+	path := fmt.Sprintf("repos/%s/actions/runs/%d/artifacts", ghrepo.FullName(repo), runID)
+	err := client.REST(repo.RepoHost(), "GET", path, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.Artifacts, nil
+}
+
+// listRepoArtifacts fetches the artifacts of the repository's recent workflow
+// runs
+func listRepoArtifacts(client *api.Client, repo ghrepo.Interface) ([]artifact, error) {
+	var result struct {
+		Artifacts []artifact
+	}
+	path := fmt.Sprintf("repos/%s/actions/artifacts?per_page=100", ghrepo.FullName(repo))
+	err := client.REST(repo.RepoHost(), "GET", path, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.Artifacts, nil
+}
@@ -0,0 +1,28 @@
+package run
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	cmdArtifacts "github.com/cli/cli/pkg/cmd/run/artifacts"
+	cmdView "github.com/cli/cli/pkg/cmd/run/view"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdRun(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "run <command>",
+		Short: "View workflow runs",
+		Long:  "Work with GitHub Actions workflow runs",
+		Example: heredoc.Doc(`
+			$ gh run view 1234567
+			$ gh run view 1234567 --tests
+		`),
+	}
+
+	cmdutil.EnableRepoOverride(cmd, f)
+
+	cmd.AddCommand(cmdArtifacts.NewCmdArtifacts(f, nil))
+	cmd.AddCommand(cmdView.NewCmdView(f, nil))
+
+	return cmd
+}
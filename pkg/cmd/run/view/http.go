@@ -0,0 +1,183 @@
+package view
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/internal/ghinstance"
+	"github.com/cli/cli/internal/ghrepo"
+)
+
+type workflowRun struct {
+	ID         uint64
+	Name       string
+	HeadBranch string `json:"head_branch"`
+	Event      string
+	Status     string
+	Conclusion string
+	URL        string `json:"html_url"`
+}
+
+type runArtifact struct {
+	ID   uint64
+	Name string
+	Size uint64 `json:"size_in_bytes"`
+}
+
+type testFailure struct {
+	ClassName string
+	Name      string
+	Message   string
+}
+
+type testReport struct {
+	Tests    int
+	Failures []testFailure
+}
+
+func getWorkflowRun(client *api.Client, repo ghrepo.Interface, runID uint64) (*workflowRun, error) {
+	var result workflowRun
+	path := fmt.Sprintf("repos/%s/actions/runs/%d", ghrepo.FullName(repo), runID)
+	err := client.REST(repo.RepoHost(), "GET", path, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// getTestReports downloads the artifacts of a run and parses any JUnit XML
+// files found inside them
+func getTestReports(httpClient *http.Client, client *api.Client, repo ghrepo.Interface, runID uint64) ([]testReport, error) {
+	var artifactList struct {
+		Artifacts []runArtifact
+	}
+	path := fmt.Sprintf("repos/%s/actions/runs/%d/artifacts", ghrepo.FullName(repo), runID)
+	err := client.REST(repo.RepoHost(), "GET", path, nil, &artifactList)
+	if err != nil {
+		return nil, err
+	}
+
+	var reports []testReport
+	for _, artifact := range artifactList.Artifacts {
+		zipData, err := downloadArtifact(httpClient, repo, artifact.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to download artifact %q: %w", artifact.Name, err)
+		}
+
+		zipReader, err := zip.NewReader(bytes.NewReader(zipData), int64(len(zipData)))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read artifact %q: %w", artifact.Name, err)
+		}
+
+		for _, file := range zipReader.File {
+			if !strings.HasSuffix(file.Name, ".xml") {
+				continue
+			}
+			f, err := file.Open()
+			if err != nil {
+				return nil, err
+			}
+			contents, err := ioutil.ReadAll(f)
+			f.Close()
+			if err != nil {
+				return nil, err
+			}
+			report, ok := parseJUnitReport(contents)
+			if ok {
+				reports = append(reports, report)
+			}
+		}
+	}
+
+	return reports, nil
+}
+
+func downloadArtifact(httpClient *http.Client, repo ghrepo.Interface, artifactID uint64) ([]byte, error) {
+	url := fmt.Sprintf("%srepos/%s/actions/artifacts/%d/zip",
+		ghinstance.RESTPrefix(repo.RepoHost()), ghrepo.FullName(repo), artifactID)
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode > 299 {
+		return nil, api.HandleHTTPError(resp)
+	}
+
+	return ioutil.ReadAll(resp.Body)
+}
+
+type junitTestCase struct {
+	ClassName string `xml:"classname,attr"`
+	Name      string `xml:"name,attr"`
+	Failure   *struct {
+		Message string `xml:"message,attr"`
+		Body    string `xml:",chardata"`
+	} `xml:"failure"`
+	Error *struct {
+		Message string `xml:"message,attr"`
+		Body    string `xml:",chardata"`
+	} `xml:"error"`
+}
+
+type junitTestSuite struct {
+	TestCases []junitTestCase  `xml:"testcase"`
+	Suites    []junitTestSuite `xml:"testsuite"`
+}
+
+// parseJUnitReport interprets contents as a JUnit XML report. The second
+// return value is false when the file is some other kind of XML.
+func parseJUnitReport(contents []byte) (testReport, bool) {
+	report := testReport{}
+
+	var root struct {
+		XMLName xml.Name
+		junitTestSuite
+	}
+	if err := xml.Unmarshal(contents, &root); err != nil {
+		return report, false
+	}
+	if root.XMLName.Local != "testsuites" && root.XMLName.Local != "testsuite" {
+		return report, false
+	}
+
+	collectJUnitCases(root.junitTestSuite, &report)
+	return report, true
+}
+
+func collectJUnitCases(suite junitTestSuite, report *testReport) {
+	for _, testCase := range suite.TestCases {
+		report.Tests++
+		failure := testCase.Failure
+		if failure == nil {
+			failure = testCase.Error
+		}
+		if failure == nil {
+			continue
+		}
+		message := failure.Message
+		if message == "" {
+			message = strings.TrimSpace(failure.Body)
+		}
+		report.Failures = append(report.Failures, testFailure{
+			ClassName: testCase.ClassName,
+			Name:      testCase.Name,
+			Message:   message,
+		})
+	}
+	for _, nested := range suite.Suites {
+		collectJUnitCases(nested, report)
+	}
+}
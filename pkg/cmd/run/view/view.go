@@ -0,0 +1,151 @@
+package view
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/cli/cli/utils"
+	"github.com/spf13/cobra"
+)
+
+type ViewOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	RunID uint64
+	Tests bool
+}
+
+func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Command {
+	opts := &ViewOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "view <run-id>",
+		Short: "View a workflow run",
+		Long: heredoc.Doc(`
+			Display the status and conclusion of a GitHub Actions workflow run.
+
+			With '--tests', download any JUnit XML test report artifacts the run
+			uploaded and summarize the test failures inline.
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+
+			runID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return &cmdutil.FlagError{Err: fmt.Errorf("invalid run ID: %q", args[0])}
+			}
+			opts.RunID = runID
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return viewRun(opts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.Tests, "tests", false, "Show test failures parsed from JUnit report artifacts")
+
+	return cmd
+}
+
+func viewRun(opts *ViewOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	run, err := getWorkflowRun(apiClient, baseRepo, opts.RunID)
+	if err != nil {
+		return err
+	}
+
+	out := opts.IO.Out
+
+	if opts.IO.IsStdoutTTY() {
+		fmt.Fprintf(out, "%s %s · %s\n", utils.Bold(run.Name), utils.Gray(fmt.Sprintf("#%d", run.ID)), run.HeadBranch)
+		fmt.Fprintf(out, "Triggered via %s, status: %s\n", run.Event, runStatus(run))
+		fmt.Fprintf(out, "\n%s\n", utils.Gray(fmt.Sprintf("View this run on GitHub: %s", run.URL)))
+	} else {
+		fmt.Fprintf(out, "name:\t%s\n", run.Name)
+		fmt.Fprintf(out, "branch:\t%s\n", run.HeadBranch)
+		fmt.Fprintf(out, "event:\t%s\n", run.Event)
+		fmt.Fprintf(out, "status:\t%s\n", runStatus(run))
+		fmt.Fprintf(out, "url:\t%s\n", run.URL)
+	}
+
+	if !opts.Tests {
+		return nil
+	}
+
+	opts.IO.StartProgressIndicator()
+	reports, err := getTestReports(httpClient, apiClient, baseRepo, opts.RunID)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	if len(reports) == 0 {
+		return errors.New("no JUnit test report artifacts found for this run")
+	}
+
+	return printTestReports(opts.IO, reports)
+}
+
+func runStatus(run *workflowRun) string {
+	if run.Conclusion != "" {
+		return run.Conclusion
+	}
+	return run.Status
+}
+
+func printTestReports(io *iostreams.IOStreams, reports []testReport) error {
+	out := io.Out
+
+	totalTests := 0
+	failures := []testFailure{}
+	for _, report := range reports {
+		totalTests += report.Tests
+		failures = append(failures, report.Failures...)
+	}
+
+	fmt.Fprintln(out)
+	if len(failures) == 0 {
+		fmt.Fprintf(out, "%s All %s passed\n", utils.Green("✓"), utils.Pluralize(totalTests, "test"))
+		return nil
+	}
+
+	fmt.Fprintf(out, "%s %s out of %d failed\n", utils.Red("X"), utils.Pluralize(len(failures), "test"), totalTests)
+	for _, failure := range failures {
+		fmt.Fprintln(out)
+		name := failure.Name
+		if failure.ClassName != "" {
+			name = fmt.Sprintf("%s.%s", failure.ClassName, failure.Name)
+		}
+		fmt.Fprintf(out, "%s %s\n", utils.Red("X"), utils.Bold(name))
+		if failure.Message != "" {
+			fmt.Fprintf(out, "  %s\n", failure.Message)
+		}
+	}
+
+	return cmdutil.SilentError
+}
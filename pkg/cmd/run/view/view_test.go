@@ -0,0 +1,159 @@
+package view
+
+import (
+	"archive/zip"
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/httpmock"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/cli/cli/test"
+	"github.com/google/shlex"
+)
+
+func runCommand(rt http.RoundTripper, isTTY bool, cli string) (*test.CmdOut, error) {
+	io, _, stdout, stderr := iostreams.Test()
+	io.SetStdoutTTY(isTTY)
+	io.SetStdinTTY(isTTY)
+	io.SetStderrTTY(isTTY)
+
+	factory := &cmdutil.Factory{
+		IOStreams: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: rt}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+	}
+
+	cmd := NewCmdView(factory, nil)
+
+	argv, err := shlex.Split(cli)
+	if err != nil {
+		return nil, err
+	}
+	cmd.SetArgs(argv)
+
+	cmd.SetIn(&bytes.Buffer{})
+	cmd.SetOut(ioutil.Discard)
+	cmd.SetErr(ioutil.Discard)
+
+	_, err = cmd.ExecuteC()
+	return &test.CmdOut{
+		OutBuf: stdout,
+		ErrBuf: stderr,
+	}, err
+}
+
+func TestRunView(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/1234"),
+		httpmock.StringResponse(`{
+			"id": 1234,
+			"name": "CI",
+			"head_branch": "trunk",
+			"event": "push",
+			"status": "completed",
+			"conclusion": "success",
+			"html_url": "https://github.com/OWNER/REPO/actions/runs/1234"
+		}`))
+
+	output, err := runCommand(reg, false, "1234")
+	if err != nil {
+		t.Fatalf("error running command `run view`: %v", err)
+	}
+
+	test.ExpectLines(t, output.String(),
+		`name:\tCI`,
+		`branch:\ttrunk`,
+		`event:\tpush`,
+		`status:\tsuccess`,
+		`url:\thttps://github.com/OWNER/REPO/actions/runs/1234`)
+}
+
+func TestRunView_tests(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/1234"),
+		httpmock.StringResponse(`{
+			"id": 1234,
+			"name": "CI",
+			"head_branch": "trunk",
+			"event": "push",
+			"status": "completed",
+			"conclusion": "failure",
+			"html_url": "https://github.com/OWNER/REPO/actions/runs/1234"
+		}`))
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/1234/artifacts"),
+		httpmock.StringResponse(`{
+			"artifacts": [
+				{"id": 11, "name": "test-results", "size_in_bytes": 512}
+			]
+		}`))
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/actions/artifacts/11/zip"),
+		httpmock.StringResponse(string(junitZip(t, `
+			<testsuites>
+				<testsuite name="pkg">
+					<testcase classname="pkg" name="TestPasses"></testcase>
+					<testcase classname="pkg" name="TestFails">
+						<failure message="expected 2, got 3"></failure>
+					</testcase>
+				</testsuite>
+			</testsuites>`))))
+
+	output, err := runCommand(reg, false, "1234 --tests")
+	if err != cmdutil.SilentError {
+		t.Fatalf("expected SilentError, got %v", err)
+	}
+
+	test.ExpectLines(t, output.String(),
+		`1 test out of 2 failed`,
+		`pkg.TestFails`,
+		`expected 2, got 3`)
+}
+
+func TestRunView_tests_noArtifacts(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/1234"),
+		httpmock.StringResponse(`{"id": 1234, "name": "CI", "status": "completed", "conclusion": "success"}`))
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/1234/artifacts"),
+		httpmock.StringResponse(`{"artifacts": []}`))
+
+	_, err := runCommand(reg, false, "1234 --tests")
+	if err == nil || err.Error() != "no JUnit test report artifacts found for this run" {
+		t.Fatalf("got error %v", err)
+	}
+}
+
+func junitZip(t *testing.T, xmlContents string) []byte {
+	t.Helper()
+	buf := &bytes.Buffer{}
+	zw := zip.NewWriter(buf)
+	f, err := zw.Create("junit.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.Write([]byte(xmlContents)); err != nil {
+		t.Fatal(err)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
@@ -0,0 +1,17 @@
+package cmdutil
+
+import (
+	"io"
+	"io/ioutil"
+)
+
+// ReadFile reads the contents of a file, or from stdin when filename is "-".
+func ReadFile(filename string, stdin io.ReadCloser) ([]byte, error) {
+	if filename == "-" {
+		b, err := ioutil.ReadAll(stdin)
+		_ = stdin.Close()
+		return b, err
+	}
+
+	return ioutil.ReadFile(filename)
+}
@@ -0,0 +1,99 @@
+package cmdutil
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// Exporter represents a request to output the results of a command as JSON
+// limited to a selection of fields.
+type Exporter struct {
+	fields []string
+}
+
+// Fields are the names of the fields to include in the output
+func (e *Exporter) Fields() []string {
+	return e.fields
+}
+
+// JSONField describes one field a command can output with `--json`
+type JSONField struct {
+	Name    string
+	Type    string
+	Example string
+}
+
+// field definitions are recorded on the command so help facilities such as
+// `gh fields` can list them without importing every command package
+const jsonFieldsAnnotation = "help:json-fields"
+
+// AddJSONFlags declares the `--json` flag on cmd and arranges for exportTarget
+// to be populated with the requested fields before the command runs. Requested
+// fields are validated against the field definitions.
+func AddJSONFlags(cmd *cobra.Command, exportTarget **Exporter, fieldDefs []JSONField) {
+	cmd.Flags().StringSlice("json", nil, "Output JSON with the specified `fields`")
+
+	allowedFields := make([]string, 0, len(fieldDefs))
+	rows := make([]string, 0, len(fieldDefs))
+	for _, def := range fieldDefs {
+		allowedFields = append(allowedFields, def.Name)
+		rows = append(rows, fmt.Sprintf("%s\t%s\t%s", def.Name, def.Type, def.Example))
+	}
+	if cmd.Annotations == nil {
+		cmd.Annotations = map[string]string{}
+	}
+	cmd.Annotations[jsonFieldsAnnotation] = strings.Join(rows, "\n")
+
+	oldPreRunE := cmd.PreRunE
+	cmd.PreRunE = func(c *cobra.Command, args []string) error {
+		if oldPreRunE != nil {
+			if err := oldPreRunE(c, args); err != nil {
+				return err
+			}
+		}
+
+		fields, err := c.Flags().GetStringSlice("json")
+		if err != nil {
+			return err
+		}
+		if len(fields) == 0 {
+			*exportTarget = nil
+			return nil
+		}
+
+		allowed := make(map[string]bool, len(allowedFields))
+		for _, f := range allowedFields {
+			allowed[f] = true
+		}
+		for _, f := range fields {
+			if !allowed[f] {
+				return &FlagError{Err: fmt.Errorf("unknown JSON field %q\nAvailable fields:\n  %s",
+					f, strings.Join(allowedFields, "\n  "))}
+			}
+		}
+
+		*exportTarget = &Exporter{fields: fields}
+		return nil
+	}
+}
+
+// JSONFieldsForCommand returns the `--json` field definitions declared on cmd,
+// or nil when cmd does not support JSON output
+func JSONFieldsForCommand(cmd *cobra.Command) []JSONField {
+	raw, ok := cmd.Annotations[jsonFieldsAnnotation]
+	if !ok {
+		return nil
+	}
+
+	fieldDefs := []JSONField{}
+	for _, row := range strings.Split(raw, "\n") {
+		parts := strings.SplitN(row, "\t", 3)
+		if len(parts) < 3 {
+			continue
+		}
+		fieldDefs = append(fieldDefs, JSONField{Name: parts[0], Type: parts[1], Example: parts[2]})
+	}
+	return fieldDefs
+}
@@ -0,0 +1,78 @@
+package cmdutil
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+func TestAddJSONFlags(t *testing.T) {
+	tests := []struct {
+		name       string
+		args       []string
+		wantErr    string
+		wantFields []string
+	}{
+		{
+			name:       "no JSON flag",
+			args:       []string{},
+			wantFields: nil,
+		},
+		{
+			name:       "valid fields",
+			args:       []string{"--json", "number,title"},
+			wantFields: []string{"number", "title"},
+		},
+		{
+			name:    "unknown field",
+			args:    []string{"--json", "number,wage"},
+			wantErr: "unknown JSON field \"wage\"\nAvailable fields:\n  number\n  title\n  url",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var exporter *Exporter
+			cmd := &cobra.Command{
+				RunE: func(*cobra.Command, []string) error { return nil },
+			}
+			cmd.SilenceErrors = true
+			cmd.SilenceUsage = true
+			AddJSONFlags(cmd, &exporter, []JSONField{
+				{Name: "number", Type: "number", Example: "123"},
+				{Name: "title", Type: "string", Example: `"A title"`},
+				{Name: "url", Type: "string", Example: `"https://example.com"`},
+			})
+			cmd.SetArgs(tt.args)
+
+			_, err := cmd.ExecuteC()
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("got error %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if tt.wantFields == nil {
+				if exporter != nil {
+					t.Errorf("expected nil exporter, got %v", exporter)
+				}
+				return
+			}
+			if exporter == nil {
+				t.Fatal("expected exporter, got nil")
+			}
+			fields := exporter.Fields()
+			if len(fields) != len(tt.wantFields) {
+				t.Fatalf("expected fields %v, got %v", tt.wantFields, fields)
+			}
+			for i, f := range tt.wantFields {
+				if fields[i] != f {
+					t.Errorf("expected fields %v, got %v", tt.wantFields, fields)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,132 @@
+// Package gh is a stable entry point to gh's authentication and API plumbing
+// for other Go programs.
+//
+// Unlike the rest of this module, the exported API of this package follows
+// semantic versioning: Go extensions can construct an authenticated client
+// here instead of re-implementing config parsing and token handling. The
+// package deliberately exposes only plain types so that callers never depend
+// on gh internals.
+package gh
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/context"
+	"github.com/cli/cli/git"
+	"github.com/cli/cli/internal/config"
+	"github.com/cli/cli/internal/ghinstance"
+)
+
+// Repository identifies a GitHub repository.
+type Repository struct {
+	Host  string
+	Owner string
+	Name  string
+}
+
+// Client gives access to gh's configuration and issues authenticated
+// requests to the GitHub API.
+type Client struct {
+	cfg  config.Config
+	http *http.Client
+}
+
+// NewClient loads gh configuration from its default location, respecting the
+// same environment variable overrides as the gh command itself, and returns a
+// client that authenticates API requests with the stored credentials.
+func NewClient() (*Client, error) {
+	cfg, err := config.ParseDefaultConfig()
+	if errors.Is(err, os.ErrNotExist) {
+		cfg = config.NewBlankConfig()
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to read configuration: %w", err)
+	}
+	cfg = config.InheritEnv(cfg)
+
+	httpClient := api.NewHTTPClient(
+		api.AddHeader("User-Agent", "GitHub CLI Go library"),
+		api.AddHeaderFunc("Authorization", func(req *http.Request) (string, error) {
+			hostname := ghinstance.NormalizeHostname(req.URL.Hostname())
+			if token, err := cfg.Get(hostname, "oauth_token"); err == nil && token != "" {
+				return fmt.Sprintf("token %s", token), nil
+			}
+			return "", nil
+		}),
+	)
+
+	return &Client{cfg: cfg, http: httpClient}, nil
+}
+
+// HTTPClient returns an http.Client that adds authentication to requests
+// aimed at GitHub hosts the user has logged into.
+func (c *Client) HTTPClient() *http.Client {
+	return c.http
+}
+
+// DefaultHost returns the GitHub hostname that commands operate on when the
+// current directory offers no better context: either the GH_HOST environment
+// variable or "github.com".
+func (c *Client) DefaultHost() string {
+	return ghinstance.OverridableDefault()
+}
+
+// ConfigValue looks up a setting in gh configuration. Pass an empty hostname
+// for settings that are not scoped to a host.
+func (c *Client) ConfigValue(hostname, key string) (string, error) {
+	return c.cfg.Get(hostname, key)
+}
+
+// REST performs a request against the GitHub REST API and parses the JSON
+// response into data.
+func (c *Client) REST(hostname string, method string, p string, body io.Reader, data interface{}) error {
+	return api.NewClientFromHTTP(c.http).REST(hostname, method, p, body, data)
+}
+
+// GraphQL performs a query or mutation against the GitHub GraphQL API and
+// parses the response into data.
+func (c *Client) GraphQL(hostname string, query string, variables map[string]interface{}, data interface{}) error {
+	return api.NewClientFromHTTP(c.http).GraphQL(hostname, query, variables, data)
+}
+
+// CurrentRepository determines the GitHub repository that the current working
+// directory is associated with by examining its git remotes, the same way gh
+// commands resolve their base repository.
+func (c *Client) CurrentRepository() (Repository, error) {
+	gitRemotes, err := git.Remotes()
+	if err != nil {
+		return Repository{}, err
+	}
+	remotes := context.TranslateRemotes(gitRemotes, git.ParseSSHConfig().Translator())
+
+	knownHosts := map[string]bool{
+		ghinstance.Default(): true,
+	}
+	if authenticatedHosts, err := c.cfg.Hosts(); err == nil {
+		for _, h := range authenticatedHosts {
+			knownHosts[h] = true
+		}
+	}
+
+	return pickCurrentRepository(remotes, knownHosts)
+}
+
+func pickCurrentRepository(remotes context.Remotes, knownHosts map[string]bool) (Repository, error) {
+	sort.Sort(remotes)
+	for _, r := range remotes {
+		if !knownHosts[r.RepoHost()] {
+			continue
+		}
+		return Repository{
+			Host:  r.RepoHost(),
+			Owner: r.RepoOwner(),
+			Name:  r.RepoName(),
+		}, nil
+	}
+	return Repository{}, errors.New("none of the git remotes point to a known GitHub host")
+}
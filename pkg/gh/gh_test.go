@@ -0,0 +1,37 @@
+package gh
+
+import (
+	"testing"
+
+	"github.com/cli/cli/context"
+	"github.com/cli/cli/git"
+	"github.com/cli/cli/internal/ghrepo"
+)
+
+func Test_pickCurrentRepository(t *testing.T) {
+	remotes := context.Remotes{
+		{
+			Remote: &git.Remote{Name: "origin"},
+			Repo:   ghrepo.NewWithHost("monalisa", "octo-cat", "example.org"),
+		},
+		{
+			Remote: &git.Remote{Name: "fork"},
+			Repo:   ghrepo.New("hubot", "octo-cat"),
+		},
+	}
+
+	knownHosts := map[string]bool{"github.com": true}
+
+	repo, err := pickCurrentRepository(remotes, knownHosts)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if repo.Host != "github.com" || repo.Owner != "hubot" || repo.Name != "octo-cat" {
+		t.Errorf("got repository %+v", repo)
+	}
+
+	_, err = pickCurrentRepository(remotes, map[string]bool{})
+	if err == nil || err.Error() != "none of the git remotes point to a known GitHub host" {
+		t.Errorf("got error %v", err)
+	}
+}
@@ -37,7 +37,7 @@ mainLoop:
 					break
 				}
 				for _, tf := range templates {
-					if strings.HasSuffix(tf.Name(), ".md") {
+					if isTemplateFile(tf.Name()) {
 						results = append(results, path.Join(dir, file.Name(), tf.Name()))
 					}
 				}
@@ -79,33 +79,155 @@ func FindLegacy(rootDir string, name string) *string {
 	return nil
 }
 
-// ExtractName returns the name of the template from YAML front-matter
-func ExtractName(filePath string) string {
-	contents, err := ioutil.ReadFile(filePath)
-	frontmatterBoundaries := detectFrontmatter(contents)
-	if err == nil && frontmatterBoundaries[0] == 0 {
-		templateData := struct {
-			Name string
-		}{}
-		if err := yaml.Unmarshal(contents[0:frontmatterBoundaries[1]], &templateData); err == nil && templateData.Name != "" {
-			return templateData.Name
+// isTemplateFile reports whether a file in a template directory is a
+// template: markdown templates and issue form YAML files count, but the
+// template chooser's config.yml does not
+func isTemplateFile(name string) bool {
+	if strings.HasSuffix(name, ".md") {
+		return true
+	}
+	if isIssueForm(name) {
+		base := strings.TrimSuffix(strings.TrimSuffix(name, ".yaml"), ".yml")
+		return !strings.EqualFold(base, "config")
+	}
+	return false
+}
+
+// isIssueForm reports whether a template file is an issue form, which is pure
+// YAML rather than markdown with YAML front-matter
+func isIssueForm(filePath string) bool {
+	return strings.HasSuffix(filePath, ".yml") || strings.HasSuffix(filePath, ".yaml")
+}
+
+// templateMetadata is what a template declares about itself, either in YAML
+// front-matter or, for issue forms, at the top level of the file
+type templateMetadata struct {
+	Name   string
+	Title  string
+	Labels labelList
+}
+
+// labelList accepts either a YAML list or a comma-separated string, both of
+// which appear in template metadata in the wild
+type labelList []string
+
+func (l *labelList) UnmarshalYAML(value *yaml.Node) error {
+	var list []string
+	if err := value.Decode(&list); err == nil {
+		*l = list
+		return nil
+	}
+	var scalar string
+	if err := value.Decode(&scalar); err != nil {
+		return err
+	}
+	for _, name := range strings.Split(scalar, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			*l = append(*l, name)
 		}
 	}
+	return nil
+}
+
+func extractMetadata(filePath string) templateMetadata {
+	meta := templateMetadata{}
+	contents, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return meta
+	}
+	if isIssueForm(filePath) {
+		_ = yaml.Unmarshal(contents, &meta)
+		return meta
+	}
+	if frontmatterBoundaries := detectFrontmatter(contents); frontmatterBoundaries[0] == 0 {
+		_ = yaml.Unmarshal(contents[0:frontmatterBoundaries[1]], &meta)
+	}
+	return meta
+}
+
+// ExtractName returns the name of the template from its metadata
+func ExtractName(filePath string) string {
+	if name := extractMetadata(filePath).Name; name != "" {
+		return name
+	}
 	return path.Base(filePath)
 }
 
-// ExtractContents returns the template contents without the YAML front-matter
+// ExtractTitle returns the default issue title from the template's metadata
+func ExtractTitle(filePath string) string {
+	return extractMetadata(filePath).Title
+}
+
+// ExtractLabels returns the labels the template applies to new issues
+func ExtractLabels(filePath string) []string {
+	return extractMetadata(filePath).Labels
+}
+
+// ExtractContents returns the template contents without the YAML
+// front-matter; issue forms are rendered as a markdown skeleton
 func ExtractContents(filePath string) []byte {
 	contents, err := ioutil.ReadFile(filePath)
 	if err != nil {
 		return []byte{}
 	}
+	if isIssueForm(filePath) {
+		return renderIssueForm(contents)
+	}
 	if frontmatterBoundaries := detectFrontmatter(contents); frontmatterBoundaries[0] == 0 {
 		return contents[frontmatterBoundaries[1]:]
 	}
 	return contents
 }
 
+// renderIssueForm approximates an issue form as markdown by turning each form
+// element into a section of the document, since the form itself can only be
+// filled out on the web
+func renderIssueForm(contents []byte) []byte {
+	form := struct {
+		Body []struct {
+			Type       string
+			Attributes struct {
+				Label       string
+				Description string
+				Value       string
+				Options     []yaml.Node
+			}
+		}
+	}{}
+	if err := yaml.Unmarshal(contents, &form); err != nil || len(form.Body) == 0 {
+		return contents
+	}
+
+	var out strings.Builder
+	for _, element := range form.Body {
+		switch element.Type {
+		case "markdown":
+			out.WriteString(strings.TrimRight(element.Attributes.Value, "\n"))
+			out.WriteString("\n\n")
+		case "input", "textarea", "dropdown":
+			fmt.Fprintf(&out, "### %s\n\n", element.Attributes.Label)
+			if element.Attributes.Description != "" {
+				fmt.Fprintf(&out, "<!-- %s -->\n\n", element.Attributes.Description)
+			}
+			if element.Attributes.Value != "" {
+				out.WriteString(strings.TrimRight(element.Attributes.Value, "\n"))
+				out.WriteString("\n\n")
+			}
+		case "checkboxes":
+			fmt.Fprintf(&out, "### %s\n\n", element.Attributes.Label)
+			for _, option := range element.Attributes.Options {
+				label := struct{ Label string }{}
+				if option.Decode(&label) != nil || label.Label == "" {
+					continue
+				}
+				fmt.Fprintf(&out, "- [ ] %s\n", label.Label)
+			}
+			out.WriteString("\n")
+		}
+	}
+	return []byte(strings.TrimRight(out.String(), "\n") + "\n")
+}
+
 var yamlPattern = regexp.MustCompile(`(?m)^---\r?\n(\s*\r?\n)?`)
 
 func detectFrontmatter(c []byte) []int {
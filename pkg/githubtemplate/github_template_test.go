@@ -385,3 +385,111 @@ Even more
 		})
 	}
 }
+
+func TestExtractTitleAndLabels(t *testing.T) {
+	tmpdir, err := ioutil.TempDir("", "gh-cli")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpdir)
+
+	tests := []struct {
+		name       string
+		fileName   string
+		prepare    string
+		wantTitle  string
+		wantLabels []string
+	}{
+		{
+			name:     "Front-matter with comma-separated labels",
+			fileName: "bug_report.md",
+			prepare: `---
+name: Bug Report
+title: "[Bug]: "
+labels: bug, needs triage
+---
+
+**Template contents**
+`,
+			wantTitle:  "[Bug]: ",
+			wantLabels: []string{"bug", "needs triage"},
+		},
+		{
+			name:     "Issue form with label list",
+			fileName: "bug_report.yml",
+			prepare: `name: Bug Report
+title: "[Bug]: "
+labels: [bug, needs triage]
+body:
+  - type: input
+    attributes:
+      label: Version
+`,
+			wantTitle:  "[Bug]: ",
+			wantLabels: []string{"bug", "needs triage"},
+		},
+		{
+			name:     "No metadata",
+			fileName: "plain.md",
+			prepare:  `**Template contents**`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filePath := path.Join(tmpdir, tt.fileName)
+			_ = ioutil.WriteFile(filePath, []byte(tt.prepare), 0600)
+			if got := ExtractTitle(filePath); got != tt.wantTitle {
+				t.Errorf("ExtractTitle() = %v, want %v", got, tt.wantTitle)
+			}
+			got := ExtractLabels(filePath)
+			if len(got) != len(tt.wantLabels) {
+				t.Fatalf("ExtractLabels() = %v, want %v", got, tt.wantLabels)
+			}
+			for i, l := range tt.wantLabels {
+				if got[i] != l {
+					t.Errorf("ExtractLabels() = %v, want %v", got, tt.wantLabels)
+				}
+			}
+		})
+	}
+}
+
+func TestExtractContents_issueForm(t *testing.T) {
+	tmpdir, err := ioutil.TempDir("", "gh-cli")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpdir)
+
+	filePath := path.Join(tmpdir, "feedback.yml")
+	prepare := `name: Feedback
+body:
+  - type: markdown
+    attributes:
+      value: Some introductory text
+  - type: textarea
+    attributes:
+      label: What happened?
+      description: Include any error output
+  - type: checkboxes
+    attributes:
+      label: Checklist
+      options:
+        - label: I searched for existing issues
+`
+	_ = ioutil.WriteFile(filePath, []byte(prepare), 0600)
+
+	want := `Some introductory text
+
+### What happened?
+
+<!-- Include any error output -->
+
+### Checklist
+
+- [ ] I searched for existing issues
+`
+	if got := ExtractContents(filePath); string(got) != want {
+		t.Errorf("ExtractContents() = %v, want %v", string(got), want)
+	}
+}
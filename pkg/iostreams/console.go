@@ -0,0 +1,11 @@
+//go:build !windows
+// +build !windows
+
+package iostreams
+
+import "os"
+
+// on non-Windows platforms, terminals interpret ANSI escape sequences natively
+func enableVirtualTerminalProcessing(f *os.File) error {
+	return nil
+}
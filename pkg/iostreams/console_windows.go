@@ -0,0 +1,26 @@
+//go:build windows
+// +build windows
+
+package iostreams
+
+import (
+	"os"
+
+	"golang.org/x/sys/windows"
+)
+
+// enableVirtualTerminalProcessing opts the native Windows console into
+// interpreting ANSI escape sequences. This succeeds on consoles backed by
+// ConPTY (Windows 10 1809 and later, including Windows Terminal); on older
+// consoles and on pipes it fails and the caller should fall back to
+// translating escape sequences.
+func enableVirtualTerminalProcessing(f *os.File) error {
+	stdout := windows.Handle(f.Fd())
+
+	var originalMode uint32
+	if err := windows.GetConsoleMode(stdout, &originalMode); err != nil {
+		return err
+	}
+
+	return windows.SetConsoleMode(stdout, originalMode|windows.ENABLE_VIRTUAL_TERMINAL_PROCESSING)
+}
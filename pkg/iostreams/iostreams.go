@@ -7,8 +7,10 @@ import (
 	"io/ioutil"
 	"os"
 	"os/exec"
+	"runtime"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/briandowns/spinner"
@@ -37,6 +39,8 @@ type IOStreams struct {
 	stderrIsTTY       bool
 
 	neverPrompt bool
+
+	minttyNoticeOnce sync.Once
 }
 
 func (s *IOStreams) ColorEnabled() bool {
@@ -93,9 +97,31 @@ func (s *IOStreams) CanPrompt() bool {
 		return false
 	}
 
+	if s.isMinttyPipe() {
+		s.minttyNoticeOnce.Do(func() {
+			fmt.Fprintln(s.ErrOut, "note: interactive prompts do not work in MinTTY. Run gh through winpty, or use cmd.exe or Windows Terminal instead.")
+		})
+		return false
+	}
+
 	return s.IsStdinTTY() && s.IsStdoutTTY()
 }
 
+// isMinttyPipe detects when gh runs under a MinTTY-based terminal such as Git
+// Bash on Windows without a winpty wrapper. MinTTY exposes itself to native
+// programs as a named pipe, so the Windows console API needed for prompting is
+// unavailable even though escape sequences render fine.
+func (s *IOStreams) isMinttyPipe() bool {
+	if runtime.GOOS != "windows" {
+		return false
+	}
+	f, ok := s.In.(*os.File)
+	if !ok {
+		return false
+	}
+	return isatty.IsCygwinTerminal(f.Fd())
+}
+
 func (s *IOStreams) SetNeverPrompt(v bool) {
 	s.neverPrompt = v
 }
@@ -149,11 +175,23 @@ func System() *IOStreams {
 	stdoutIsTTY := isTerminal(os.Stdout)
 	stderrIsTTY := isTerminal(os.Stderr)
 
+	// put the native console into ANSI mode so escape sequences work without
+	// translation; on consoles where this fails, translate them instead
+	vtEnabled := enableVirtualTerminalProcessing(os.Stdout) == nil &&
+		enableVirtualTerminalProcessing(os.Stderr) == nil
+
+	out := io.Writer(os.Stdout)
+	errOut := io.Writer(os.Stderr)
+	if !vtEnabled {
+		out = colorable.NewColorable(os.Stdout)
+		errOut = colorable.NewColorable(os.Stderr)
+	}
+
 	io := &IOStreams{
 		In:           os.Stdin,
 		originalOut:  os.Stdout,
-		Out:          colorable.NewColorable(os.Stdout),
-		ErrOut:       colorable.NewColorable(os.Stderr),
+		Out:          out,
+		ErrOut:       errOut,
 		colorEnabled: os.Getenv("NO_COLOR") == "" && stdoutIsTTY,
 	}
 
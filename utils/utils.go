@@ -13,13 +13,21 @@ import (
 	"github.com/cli/cli/pkg/browser"
 )
 
-// OpenInBrowser opens the url in a web browser based on OS and $BROWSER environment variable
+// OpenInBrowser opens the url in a web browser based on OS and $BROWSER environment variable.
+// When no browser can be launched in a headless session, the URL is printed out instead.
 func OpenInBrowser(url string) error {
 	browseCmd, err := browser.Command(url)
 	if err != nil {
 		return err
 	}
-	return run.PrepareCmd(browseCmd).Run()
+	if err := run.PrepareCmd(browseCmd).Run(); err != nil {
+		if browser.IsHeadless() {
+			fmt.Println(url)
+			return nil
+		}
+		return err
+	}
+	return nil
 }
 
 func RenderMarkdown(text string) (string, error) {